@@ -422,6 +422,8 @@ func (r MyStack) slicesHandler(resp http.ResponseWriter, req *http.Request) {
 		ReadOnly bool   `json:"readonly,omitempty"`
 		Nesting  bool   `json:"nesting,omitempty"`
 		Slices   []int  `json:"slices"`
+
+		Stats stackage.StackStats `json:"stats"`
 	}
 
 	// If method is POST or DELETE, just run the contents
@@ -440,14 +442,16 @@ func (r MyStack) slicesHandler(resp http.ResponseWriter, req *http.Request) {
 
 	// craft the landing page's contents
 	// to guide the user from there ...
+	cfg := stk.Config()
 	directory := mainDirectory{
-		ID:       stk.ID(),
+		ID:       cfg.ID,
 		Kind:     stk.Kind(),
-		Category: stk.Category(),
-		Capacity: stk.Cap(),
+		Category: cfg.Category,
+		Capacity: cfg.Cap,
 		Length:   stk.Len(),
-		ReadOnly: stk.IsReadOnly(),
+		ReadOnly: cfg.ReadOnly,
 		Nesting:  stk.IsNesting(),
+		Stats:    stk.Stats(true),
 	}
 
 	if directory.Length > 0 {
@@ -806,14 +810,15 @@ func sliceInfo(x any, idx int) (s slice) {
 	case stackage.Stack:
 		s.Value = stackValueHandler(tv)
 		s.Type = fmt.Sprintf("%T", tv)
+		cfg := tv.Config()
 		s.Kind = tv.Kind()
 		s.Length = tv.Len()
-		s.Capacity = tv.Cap()
+		s.Capacity = cfg.Cap
 		s.Nesting = tv.IsNesting()
-		s.ReadOnly = tv.IsReadOnly()
-		s.FIFO = tv.IsFIFO()
-		s.ID = tv.ID()
-		s.Category = tv.Category()
+		s.ReadOnly = cfg.ReadOnly
+		s.FIFO = cfg.FIFO
+		s.ID = cfg.ID
+		s.Category = cfg.Category
 
 	case rune:
 		s.Type = fmt.Sprintf("%T", tv)
@@ -0,0 +1,550 @@
+package stackage
+
+/*
+binary.go contains the encoding.BinaryMarshaler and BinaryUnmarshaler
+implementations extended by the Stack and Condition types.
+*/
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+)
+
+/*
+binaryVersion is the version of the envelope format produced by the
+MarshalBinary methods.
+*/
+const binaryVersion = 1
+
+/*
+binary slice tags, which describe the content of a binarySlice.
+*/
+const (
+	tagNil uint8 = iota
+	tagPrimitive
+	tagStack
+	tagCondition
+	tagBlob
+)
+
+/*
+binaryKinds maps each supported primitive [reflect.Kind] to the builtin
+type it represents. Named types (e.g.: type myInt int) are not present
+and are encoded as blobs.
+*/
+var binaryKinds = map[reflect.Kind]reflect.Type{
+	reflect.Bool:       reflect.TypeOf(false),
+	reflect.String:     reflect.TypeOf(``),
+	reflect.Int:        reflect.TypeOf(int(0)),
+	reflect.Int8:       reflect.TypeOf(int8(0)),
+	reflect.Int16:      reflect.TypeOf(int16(0)),
+	reflect.Int32:      reflect.TypeOf(int32(0)),
+	reflect.Int64:      reflect.TypeOf(int64(0)),
+	reflect.Uint:       reflect.TypeOf(uint(0)),
+	reflect.Uint8:      reflect.TypeOf(uint8(0)),
+	reflect.Uint16:     reflect.TypeOf(uint16(0)),
+	reflect.Uint32:     reflect.TypeOf(uint32(0)),
+	reflect.Uint64:     reflect.TypeOf(uint64(0)),
+	reflect.Float32:    reflect.TypeOf(float32(0)),
+	reflect.Float64:    reflect.TypeOf(float64(0)),
+	reflect.Complex64:  reflect.TypeOf(complex64(0)),
+	reflect.Complex128: reflect.TypeOf(complex128(0)),
+}
+
+/*
+binaryFlags are the cfgFlag bits preserved by the MarshalBinary methods.
+The read-only bit is recorded separately, and applied last.
+*/
+const binaryFlags = parens | cfold | nspad | lonce | negidx | fwdidx |
+	joinl | nnest | etrav | ueqty | umcfg | olock | vpush | kfold
+
+/*
+binaryEnvelope is the top-level gob-encoded value produced by the
+MarshalBinary methods. Only one of Stack or Cond is set.
+*/
+type binaryEnvelope struct {
+	Version int
+	Stack   *binaryStack
+	Cond    *binaryCondition
+}
+
+/*
+binaryConfig contains the presentation settings of a [Stack] or
+[Condition].
+*/
+type binaryConfig struct {
+	Opt      uint16
+	ReadOnly bool
+	Cached   bool
+	ID       string
+	Cat      string
+	Sym      string
+	Delim    string
+	Delims   []string
+	Enc      [][]string
+	Pad      *uint8
+	OpCtx    string
+}
+
+/*
+binaryStack contains an encoded [Stack].
+*/
+type binaryStack struct {
+	Config binaryConfig
+	Kind   uint8
+	FIFO   bool
+	Cap    int
+	Slices []binarySlice
+}
+
+/*
+binaryCondition contains an encoded [Condition].
+*/
+type binaryCondition struct {
+	Config  binaryConfig
+	Keyword string
+	KwValue *binarySlice
+	Comp    uint8 // ComparisonOperator, else zero
+	Op      *binarySlice
+	Exprs   []binarySlice
+	Multi   bool
+}
+
+/*
+binarySlice contains a single encoded value, as described by Tag.
+*/
+type binarySlice struct {
+	Tag   uint8
+	Kind  uint8
+	Int   int64
+	Uint  uint64
+	Float float64
+	Cmplx complex128
+	Str   string
+	Bool  bool
+	Stack *binaryStack
+	Cond  *binaryCondition
+	Blob  []byte
+}
+
+/*
+binaryBlobValue wraps a value of a type registered via [gob.Register].
+*/
+type binaryBlobValue struct {
+	V any
+}
+
+/*
+MarshalBinary implements the [encoding.BinaryMarshaler] interface. The
+receiver -- including its kind, capacity, ordering, presentation settings
+and all slices -- is encoded such that [Stack.UnmarshalBinary] reproduces
+an equivalent instance.
+
+Go primitives, [Stack] and [Condition] instances (and aliases of either)
+are supported natively, though aliases are reproduced as native types.
+All other slice values must be of a type registered via [gob.Register].
+An error identifying the index path of the first unsupported slice is
+returned otherwise.
+
+Closures (such as policies and hooks), logging, auxiliary data and slice
+metadata are not preserved.
+*/
+func (r Stack) MarshalBinary() (data []byte, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "embedded instance is nil")
+		return
+	}
+
+	if err = r.stack.cycleErr(); err != nil {
+		return
+	}
+
+	r.lock()
+	defer r.unlock()
+
+	var bs *binaryStack
+	if bs, err = r.stack.binary(nil); err == nil {
+		data, err = binaryEncode(binaryEnvelope{Version: binaryVersion, Stack: bs})
+	}
+
+	return
+}
+
+/*
+UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface. The
+receiver is replaced with the [Stack] encoded within data, as produced by
+[Stack.MarshalBinary]. An error is returned, and the receiver is left as-is,
+if data encodes an invalid kind, capacity or [ComparisonOperator].
+*/
+func (r *Stack) UnmarshalBinary(data []byte) (err error) {
+	var env binaryEnvelope
+	if env, err = binaryDecode(data); err == nil {
+		if env.Stack == nil {
+			err = errorf("binary data does not contain a %T", *r)
+		} else {
+			var S Stack
+			if S, err = unbinaryStack(env.Stack); err == nil {
+				*r = S
+			}
+		}
+	}
+
+	return
+}
+
+/*
+MarshalBinary implements the [encoding.BinaryMarshaler] interface. The
+receiver is encoded in the manner described by [Stack.MarshalBinary]. Any
+[Operator] other than a [ComparisonOperator] must be of a type registered
+via [gob.Register].
+*/
+func (r Condition) MarshalBinary() (data []byte, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "condition instance is nil")
+		return
+	}
+
+	if S, ok := r.condition.expressionStack(); ok && S.IsInit() {
+		if err = S.stack.cycleErr(); err != nil {
+			return
+		}
+	}
+
+	var bc *binaryCondition
+	if bc, err = r.condition.binary(nil); err == nil {
+		data, err = binaryEncode(binaryEnvelope{Version: binaryVersion, Cond: bc})
+	}
+
+	return
+}
+
+/*
+UnmarshalBinary implements the [encoding.BinaryUnmarshaler] interface. The
+receiver is replaced with the [Condition] encoded within data, as produced
+by [Condition.MarshalBinary].
+*/
+func (r *Condition) UnmarshalBinary(data []byte) (err error) {
+	var env binaryEnvelope
+	if env, err = binaryDecode(data); err == nil {
+		if env.Cond == nil {
+			err = errorf("binary data does not contain a %T", *r)
+		} else {
+			var C Condition
+			if C, err = unbinaryCondition(env.Cond); err == nil {
+				*r = C
+			}
+		}
+	}
+
+	return
+}
+
+/*
+binaryEncode gob-encodes the input envelope.
+*/
+func binaryEncode(env binaryEnvelope) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(env)
+	return buf.Bytes(), err
+}
+
+/*
+binaryDecode gob-decodes the input data as an envelope, verifying its
+version.
+*/
+func binaryDecode(data []byte) (env binaryEnvelope, err error) {
+	if err = gob.NewDecoder(bytes.NewReader(data)).Decode(&env); err == nil {
+		if env.Version != binaryVersion {
+			err = errorf("unsupported binary version %d", env.Version)
+		}
+	}
+
+	return
+}
+
+/*
+binary returns the encoded form of the receiver's configuration.
+*/
+func (r *nodeConfig) binary() (bc binaryConfig) {
+	bc = binaryConfig{
+		Opt:      uint16(r.opt & binaryFlags),
+		ReadOnly: r.positive(ronly),
+		Cached:   r.positive(scach),
+		ID:       r.id,
+		Cat:      r.cat,
+		Sym:      r.sym,
+		Delim:    r.ljc,
+		Delims:   append([]string{}, r.ljs...),
+		OpCtx:    r.occ,
+	}
+
+	for i := 0; i < len(r.enc); i++ {
+		bc.Enc = append(bc.Enc, append([]string{}, r.enc[i]...))
+	}
+
+	if r.pst != nil {
+		pst := uint8(*r.pst)
+		bc.Pad = &pst
+	}
+
+	return
+}
+
+/*
+applyBinary applies the encoded configuration (bc) to the receiver, with
+the exception of the read-only and string cache bits.
+*/
+func (r *nodeConfig) applyBinary(bc binaryConfig) {
+	r.opt |= cfgFlag(bc.Opt) & binaryFlags
+	r.id, r.cat, r.sym, r.ljc, r.occ = bc.ID, bc.Cat, bc.Sym, bc.Delim, bc.OpCtx
+	if len(bc.Delims) > 0 {
+		r.ljs = bc.Delims
+	}
+	if len(bc.Enc) > 0 {
+		r.enc = bc.Enc
+	}
+	if bc.Pad != nil {
+		r.setPaddingStyle(PaddingStyle(*bc.Pad))
+	}
+}
+
+/*
+binary returns the encoded form of the receiver, whose index path is
+indicated.
+*/
+func (r *stack) binary(path []int) (bs *binaryStack, err error) {
+	sc, _ := r.config()
+	bs = &binaryStack{
+		Config: sc.binary(),
+		Kind:   uint8(sc.typ),
+		FIFO:   sc.ord,
+		Cap:    -1,
+		Slices: make([]binarySlice, r.ulen()),
+	}
+	if c := r.cap(); c > 0 {
+		bs.Cap = c - 1
+	}
+
+	for i := 0; i < r.ulen() && err == nil; i++ {
+		bs.Slices[i], err = binarySliceOf((*r)[i+1], append(append([]int{}, path...), i))
+	}
+
+	return
+}
+
+/*
+binary returns the encoded form of the receiver, whose index path is
+indicated.
+*/
+func (r *condition) binary(path []int) (bc *binaryCondition, err error) {
+	bc = &binaryCondition{
+		Config:  r.cfg.binary(),
+		Keyword: r.kw,
+		Multi:   len(r.exv) > 0,
+	}
+
+	if r.kwv != nil {
+		var kwv binarySlice
+		if kwv, err = binarySliceOf(r.kwv, path); err != nil {
+			return
+		}
+		bc.KwValue = &kwv
+	}
+
+	if cop, ok := r.op.(ComparisonOperator); ok {
+		bc.Comp = uint8(cop)
+	} else if r.op != nil {
+		var op binarySlice
+		if op, err = binarySliceOf(r.op, path); err != nil {
+			return
+		}
+		bc.Op = &op
+	}
+
+	exprs := r.exv
+	if len(exprs) == 0 && r.ex != nil {
+		exprs = []any{r.ex}
+	}
+
+	bc.Exprs = make([]binarySlice, len(exprs))
+	for i := 0; i < len(exprs) && err == nil; i++ {
+		// condition expressions are transparent
+		// during traversal, thus path is reused.
+		bc.Exprs[i], err = binarySliceOf(exprs[i], path)
+	}
+
+	return
+}
+
+/*
+binarySliceOf returns the encoded form of x, whose index path is indicated,
+alongside an error, if any.
+*/
+func binarySliceOf(x any, path []int) (bs binarySlice, err error) {
+	if x == nil {
+		return
+	}
+
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		bs.Tag = tagStack
+		bs.Stack, err = S.stack.binary(path)
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		bs.Tag = tagCondition
+		bs.Cond, err = C.condition.binary(path)
+	} else if v := reflect.ValueOf(x); binaryKinds[v.Kind()] == v.Type() {
+		bs.Tag, bs.Kind = tagPrimitive, uint8(v.Kind())
+		switch {
+		case v.CanInt():
+			bs.Int = v.Int()
+		case v.CanUint():
+			bs.Uint = v.Uint()
+		case v.CanFloat():
+			bs.Float = v.Float()
+		case v.CanComplex():
+			bs.Cmplx = v.Complex()
+		case v.Kind() == reflect.Bool:
+			bs.Bool = v.Bool()
+		default:
+			bs.Str = v.String()
+		}
+	} else {
+		var buf bytes.Buffer
+		if err = gob.NewEncoder(&buf).Encode(binaryBlobValue{V: x}); err == nil {
+			bs.Tag, bs.Blob = tagBlob, buf.Bytes()
+		}
+	}
+
+	if err != nil && len(path) > 0 {
+		err = errorf("slice %s: %T: %v", pathString(path), x, err)
+	}
+
+	return
+}
+
+/*
+unbinaryStack returns the [Stack] encoded within bs. An error is returned
+if the kind or capacity encoded within bs is invalid.
+
+The capacity is recorded but not preallocated, as the encoded figure is not
+to be trusted to be of reasonable size.
+*/
+func unbinaryStack(bs *binaryStack) (S Stack, err error) {
+	if stackType(bs.Kind).stackKind() == KindInvalid {
+		err = errorf("invalid stack kind %d", bs.Kind)
+		return
+	} else if bs.Cap < -1 || (bs.Cap > 0 && bs.Cap < len(bs.Slices)) {
+		err = wrapErr(ErrCapacityViolation, "invalid capacity %d for %d slices", bs.Cap, len(bs.Slices))
+		return
+	}
+
+	S = Stack{newStack(stackType(bs.Kind), bs.FIFO)}
+	if bs.Cap > 0 {
+		sc, _ := S.stack.config()
+		sc.cap = bs.Cap + 1 // 1 for cfg slice offset
+	}
+
+	for i := 0; i < len(bs.Slices) && err == nil; i++ {
+		var x any
+		if x, err = bs.Slices[i].value(); err == nil {
+			*S.stack = append(*S.stack, x)
+		}
+	}
+
+	sc, _ := S.stack.config()
+	sc.applyBinary(bs.Config)
+	S.SetReadOnly(bs.Config.ReadOnly)
+
+	return
+}
+
+/*
+unbinaryCondition returns the [Condition] encoded within bc.
+*/
+func unbinaryCondition(bc *binaryCondition) (C Condition, err error) {
+	C = Condition{initCondition()}
+	C.condition.kw = bc.Keyword
+
+	if bc.KwValue != nil {
+		if C.condition.kwv, err = bc.KwValue.value(); err != nil {
+			return
+		}
+	}
+
+	if bc.Op != nil {
+		var op any
+		if op, err = bc.Op.value(); err != nil {
+			return
+		}
+		C.condition.op, _ = op.(Operator)
+	} else if cop := ComparisonOperator(bc.Comp); cop.Valid() {
+		C.condition.op = cop
+	} else if bc.Comp != 0 {
+		err = errorf("invalid comparison operator %d", bc.Comp)
+		return
+	}
+
+	for i := 0; i < len(bc.Exprs) && err == nil; i++ {
+		var ex any
+		if ex, err = bc.Exprs[i].value(); err == nil {
+			if i == 0 {
+				C.condition.ex = ex
+			}
+			if bc.Multi {
+				C.condition.exv = append(C.condition.exv, ex)
+			}
+		}
+	}
+
+	C.condition.cfg.applyBinary(bc.Config)
+	C.SetStringCache(bc.Config.Cached)
+	C.SetReadOnly(bc.Config.ReadOnly)
+
+	return
+}
+
+/*
+value returns the value encoded within the receiver.
+*/
+func (r binarySlice) value() (x any, err error) {
+	switch r.Tag {
+	case tagStack:
+		if r.Stack != nil {
+			x, err = unbinaryStack(r.Stack)
+		}
+	case tagCondition:
+		if r.Cond != nil {
+			x, err = unbinaryCondition(r.Cond)
+		}
+	case tagPrimitive:
+		typ, ok := binaryKinds[reflect.Kind(r.Kind)]
+		if !ok {
+			err = errorf("unsupported primitive kind %d", r.Kind)
+			return
+		}
+
+		v := reflect.New(typ).Elem()
+		switch {
+		case v.CanInt():
+			v.SetInt(r.Int)
+		case v.CanUint():
+			v.SetUint(r.Uint)
+		case v.CanFloat():
+			v.SetFloat(r.Float)
+		case v.CanComplex():
+			v.SetComplex(r.Cmplx)
+		case v.Kind() == reflect.Bool:
+			v.SetBool(r.Bool)
+		default:
+			v.SetString(r.Str)
+		}
+		x = v.Interface()
+	case tagBlob:
+		var bv binaryBlobValue
+		if err = gob.NewDecoder(bytes.NewReader(r.Blob)).Decode(&bv); err == nil {
+			x = bv.V
+		}
+	}
+
+	return
+}
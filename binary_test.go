@@ -0,0 +1,136 @@
+package stackage
+
+import (
+	"encoding/gob"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type binaryTestStruct struct {
+	Name  string
+	Count int
+}
+
+func (r binaryTestStruct) String() string {
+	return sprintf("%s:%d", r.Name, r.Count)
+}
+
+func init() {
+	gob.Register(binaryTestStruct{})
+	gob.Register(fakeOperator{})
+}
+
+func TestStack_MarshalBinary(t *testing.T) {
+	orig := And(5).SetID(`root`).SetParen(true).Push(
+		complex128(3+4i),
+		'r',
+		uint16(7),
+		nil,
+		Cond(`outer`, Ge, Or().SetSymbol(`|`).Push(`a`, 3.5, true)).SetEncap(`"`),
+		Cond(`fuzzy`, fakeOperator{Str: `~=`, Ctx: `approx`}, `jesse`).SetParen(true),
+		List().SetDelimiters(`, `, ` and `).SetNoPadding(true).Push(`x`, `y`, `z`),
+		binaryTestStruct{Name: `custom`, Count: 2},
+	).SetReadOnly(true)
+
+	data, err := orig.MarshalBinary()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var got Stack
+	if err = got.UnmarshalBinary(data); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if err = orig.IsEqual(got); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if want, gstr := orig.String(), got.String(); want != gstr {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, gstr)
+		return
+	} else if got.Cap() != 5 || got.ID() != `root` || !got.IsReadOnly() {
+		t.Errorf("%s failed: configuration not preserved", t.Name())
+		return
+	}
+
+	if r, _ := got.Index(1); r != 'r' {
+		t.Errorf("%s failed: want '%T', got '%T'", t.Name(), 'r', r)
+		return
+	}
+
+	// unregistered types identify the offending slice
+	type unregistered struct{ X int }
+	_, err = List().Push(`ok`, List().Push(unregistered{1})).MarshalBinary()
+	if err == nil || !strings.Contains(err.Error(), `slice [1][0]`) {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+	}
+}
+
+func TestStack_UnmarshalBinary_invalid(t *testing.T) {
+	for idx, env := range []binaryEnvelope{
+		{Version: binaryVersion, Stack: &binaryStack{Kind: 99, Cap: -1}},
+		{Version: binaryVersion, Stack: &binaryStack{Kind: uint8(and), Cap: -5}},
+		{Version: binaryVersion, Stack: &binaryStack{Kind: uint8(and), Cap: 1,
+			Slices: []binarySlice{{Tag: tagPrimitive, Kind: uint8(reflect.String), Str: `a`},
+				{Tag: tagPrimitive, Kind: uint8(reflect.String), Str: `b`}}}},
+		{Version: binaryVersion, Stack: &binaryStack{Kind: uint8(list), Cap: -1,
+			Slices: []binarySlice{{Tag: tagStack, Stack: &binaryStack{Kind: 0, Cap: -1}}}}},
+		{Version: binaryVersion, Stack: &binaryStack{Kind: uint8(list), Cap: -1,
+			Slices: []binarySlice{{Tag: tagCondition, Cond: &binaryCondition{Keyword: `k`, Comp: 99}}}}},
+	} {
+		data, err := binaryEncode(env)
+		if err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		}
+
+		var S Stack
+		if err = S.UnmarshalBinary(data); err == nil || S.IsInit() {
+			t.Errorf("%s failed [idx:%d]: expected error, got nil", t.Name(), idx)
+			return
+		}
+	}
+
+	// an oversized capacity is honored without being allocated
+	data, _ := binaryEncode(binaryEnvelope{Version: binaryVersion,
+		Stack: &binaryStack{Kind: uint8(and), Cap: 1 << 40}})
+
+	var S Stack
+	if err := S.UnmarshalBinary(data); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+	} else if S.Cap() != 1<<40 || cap(*S.stack) > 1 {
+		t.Errorf("%s failed: want capacity %d, got %d (%d allocated)", t.Name(), 1<<40, S.Cap(), cap(*S.stack))
+	}
+}
+
+func TestCondition_MarshalBinary(t *testing.T) {
+	orig := Cond(`keyword`, Ne, Not().Push(Cond(`inner`, Eq, 'c'))).
+		SetParen(true).
+		SetNoPadding(true)
+
+	data, err := orig.MarshalBinary()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var got Condition
+	if err = got.UnmarshalBinary(data); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = orig.IsEqual(got); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if want, gstr := orig.String(), got.String(); want != gstr {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, gstr)
+		return
+	}
+
+	var S Stack
+	if err = S.UnmarshalBinary(data); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+	}
+}
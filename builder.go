@@ -0,0 +1,217 @@
+package stackage
+
+/*
+builder.go contains the ConditionBuilder type and its fluent methods.
+*/
+
+import "errors"
+
+/*
+ConditionBuilder assembles a [Condition] by way of fluent methods, deferring
+all validation to the terminal [ConditionBuilder.Build] method. Unlike the
+[Cond] function and [Condition.Valid] method, which stop at the first problem
+encountered, the builder reports every problem at once.
+
+Instances should be created using the [NewCondition] function.
+*/
+type ConditionBuilder struct {
+	*conditionBuilder
+}
+
+/*
+conditionBuilder is the private embedded type to be circumscribed within
+instances of ConditionBuilder. The oerr and eerr fields retain the most
+recent problem, if any, perceived with the operator and expression values
+respectively, as neither would otherwise be stored.
+*/
+type conditionBuilder struct {
+	c    *condition
+	oerr error
+	eerr error
+}
+
+/*
+NewCondition initializes and returns a new instance of [ConditionBuilder],
+e.g.:
+
+	c, err := NewCondition().
+		Keyword(`age`).
+		Operator(Ge).
+		Expression(18).
+		Paren().
+		Build()
+*/
+func NewCondition() ConditionBuilder {
+	return ConditionBuilder{&conditionBuilder{c: initCondition()}}
+}
+
+/*
+IsInit returns a Boolean value indicative of whether the receiver was
+initialized by way of the [NewCondition] function.
+*/
+func (r ConditionBuilder) IsInit() bool {
+	return r.conditionBuilder != nil
+}
+
+/*
+Keyword assigns the keyword value, which is subject to the same rules
+as those described by [Condition.SetKeyword].
+*/
+func (r ConditionBuilder) Keyword(kw any) ConditionBuilder {
+	if r.IsInit() {
+		r.c.setKeyword(kw)
+	}
+	return r
+}
+
+/*
+Operator assigns the [Operator] value. An invalid [Operator] is retained
+as a problem to be reported by [ConditionBuilder.Build], replacing any
+previous such problem.
+*/
+func (r ConditionBuilder) Operator(op Operator) ConditionBuilder {
+	if r.IsInit() {
+		if r.oerr = r.c.checkOperator(op); r.oerr == nil {
+			r.c.setOperator(op)
+		}
+	}
+	return r
+}
+
+/*
+Expression assigns the expression value, which is subject to the same
+assertion logic as that used by [Condition.SetExpression]. A value that
+cannot be asserted is retained as a problem to be reported by
+[ConditionBuilder.Build], replacing any previous such problem.
+*/
+func (r ConditionBuilder) Expression(ex any) ConditionBuilder {
+	if r.IsInit() {
+		if _, ok := r.c.assertExpression(ex); ok {
+			r.eerr = nil
+			r.c.setExpression(ex)
+		} else if ex == nil {
+			r.eerr = errorf("expression value is nil")
+		} else {
+			r.eerr = errorf("%T expression value is not assertable", ex)
+		}
+	}
+	return r
+}
+
+/*
+Paren enables parenthetical encapsulation, as described by the
+[Condition.SetParen] method.
+*/
+func (r ConditionBuilder) Paren() ConditionBuilder {
+	if r.IsInit() {
+		r.c.setOpt(parens)
+	}
+	return r
+}
+
+/*
+Encap assigns the value encapsulation characters, as described by the
+[Condition.SetEncap] method.
+*/
+func (r ConditionBuilder) Encap(x ...any) ConditionBuilder {
+	if r.IsInit() {
+		r.c.cfg.setEncap(x...)
+	}
+	return r
+}
+
+/*
+NoPadding disables space padding, as described by the [Condition.SetNoPadding]
+method.
+*/
+func (r ConditionBuilder) NoPadding() ConditionBuilder {
+	if r.IsInit() {
+		r.c.setOpt(nspad)
+	}
+	return r
+}
+
+/*
+Category assigns the categorical label, as described by the
+[Condition.SetCategory] method.
+*/
+func (r ConditionBuilder) Category(cat string) ConditionBuilder {
+	if r.IsInit() {
+		r.c.setCategory(cat)
+	}
+	return r
+}
+
+/*
+ID assigns the identifier, as described by the [Condition.SetID] method.
+*/
+func (r ConditionBuilder) ID(id string) ConditionBuilder {
+	if r.IsInit() {
+		r.c.cfg.setID(id)
+	}
+	return r
+}
+
+/*
+Build validates the values assigned to the receiver and returns the
+resulting instance of [Condition] alongside an error. The keyword value
+must be non-zero, the [Operator] must be valid and the expression value
+must be assertable.
+
+All problems perceived are joined into the returned error, in which case
+the returned [Condition] is uninitialized. The receiver remains usable,
+and each successful execution returns a distinct [Condition].
+
+Expression values of the [Stack] and [Condition] types, as well as type
+aliases of either, are deep-cloned alike at the time of the build, as are
+any such values nested within them. Subsequent changes to the instances
+supplied via [ConditionBuilder.Expression] are not reflected within the
+returned [Condition], nor are changes to the returned [Condition] reflected
+within them. All other expression values are copied as-is.
+*/
+func (r ConditionBuilder) Build() (C Condition, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "condition builder instance is nil")
+		return
+	}
+
+	var errs []error
+	if len(r.c.kw) == 0 {
+		errs = append(errs, errorf("keyword value is zero"))
+	}
+
+	if r.oerr != nil {
+		errs = append(errs, r.oerr)
+	} else if r.c.op == nil {
+		errs = append(errs, r.c.checkOperator(nil))
+	}
+
+	if r.eerr != nil {
+		errs = append(errs, r.eerr)
+	} else if r.c.ex == nil {
+		errs = append(errs, errorf("expression value is nil"))
+	} else if isPending(r.c.ex) {
+		errs = append(errs, wrapErr(ErrPendingExpression,
+			"pending expressions are not permitted"))
+	}
+
+	if err = errors.Join(errs...); err == nil {
+		C = Condition{r.c.clone(make(map[*stack]*stack))}
+	}
+
+	return
+}
+
+/*
+MustBuild returns the [Condition] produced by [ConditionBuilder.Build]. A
+panic occurs if the build failed.
+
+This is a convenience method only, intended mainly for test code.
+*/
+func (r ConditionBuilder) MustBuild() Condition {
+	C, err := r.Build()
+	if err != nil {
+		panic(sprintf("stackage: condition build failed: %v", err))
+	}
+	return C
+}
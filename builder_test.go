@@ -0,0 +1,125 @@
+package stackage
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleNewCondition() {
+	c, err := NewCondition().
+		Keyword(`age`).
+		Operator(Ge).
+		Expression(18).
+		Paren().
+		Build()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(c)
+	// Output: ( age >= 18 )
+}
+
+func TestConditionBuilder(t *testing.T) {
+	b := NewCondition().
+		Keyword(`cn`).
+		Operator(Eq).
+		Expression(`Jesse`).
+		Encap(`"`).
+		Category(`name`).
+		ID(`builder`)
+
+	c, err := b.Build()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	want := `cn = "Jesse"`
+	if got := c.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if got := c.Category(); got != `name` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `name`, got)
+		return
+	}
+
+	if got := c.ID(); got != `builder` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `builder`, got)
+		return
+	}
+
+	// equivalence with Cond
+	if err = c.IsEqual(Cond(`cn`, Eq, `Jesse`)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// each build is distinct
+	if d := b.NoPadding().MustBuild(); d.Addr() == c.Addr() || c.String() != want {
+		t.Errorf("%s failed: builds share an instance", t.Name())
+		return
+	}
+
+	// Stack and Condition expressions are deep-cloned alike
+	inner := Cond(`sn`, Eq, `Coretta`)
+	S := And().Push(`x`, inner)
+	sb := NewCondition().Keyword(`cn`).Operator(Eq)
+	for idx, ex := range []any{inner, S} {
+		built := sb.Expression(ex).MustBuild()
+		want = built.String()
+		inner.SetExpression(`changed`)
+		S.Push(`y`)
+		if got := built.String(); got != want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, want, got)
+			return
+		}
+		inner.SetExpression(`Coretta`)
+	}
+
+	// all problems are reported
+	_, err = NewCondition().
+		Operator(ComparisonOperator(0)).
+		Expression(``).
+		Build()
+	if err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	}
+
+	for _, want := range []string{
+		`keyword value is zero`,
+		`operator value is bogus`,
+		`expression value is not assertable`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("%s failed: want '%s' within '%v'", t.Name(), want, err)
+			return
+		}
+	}
+
+	// a later valid value supersedes an earlier problem
+	if _, err = NewCondition().Keyword(`cn`).
+		Operator(ComparisonOperator(0)).Operator(Eq).
+		Expression(nil).Expression(`x`).Build(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	var z ConditionBuilder
+	if _, err = z.Keyword(`cn`).Build(); err == nil {
+		t.Errorf("%s failed: expected error for zero builder, got nil", t.Name())
+		return
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s failed: expected panic, got none", t.Name())
+		}
+	}()
+	NewCondition().Keyword(`cn`).MustBuild()
+}
@@ -1,7 +1,9 @@
 package stackage
 
 import (
+	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -15,11 +17,13 @@ occupy the cfg struct field of the *condition type, though
 not all config fields will apply.
 */
 type nodeConfig struct {
-	id  string             // optional identifier
+	id  string             // optional identifier; addrID = resolved upon read
+	idp string             // optional prefix for random identifiers
 	cat string             // optional categorical identifier
 	cap int                // optional stack capacity
 	evl Evaluator          // closure evaluator
 	ppf PushPolicy         // closure filterer
+	ppc PushPolicyContext  // stacks only: context-aware closure filterer
 	vpf ValidityPolicy     // closure validator
 	rpf PresentationPolicy // closure stringer
 	eqf EqualityPolicy     // closure equality
@@ -32,13 +36,52 @@ type nodeConfig struct {
 	err error              // error pertaining to the outer type state (Condition/Stack)
 	aux Auxiliary          // auxiliary admin-related object storage, user managed
 	mfn func(any) error    // marshal closure
-
-	typ stackType   // stacks only: defines the typ/kind of stack
-	sym string      // stacks only: user-controlled symbol char(s)
-	ljc string      // [list] stacks only: joining delim
-	mtx *sync.Mutex // stacks only: optional locking system
-	ldr *time.Time  // for lock duration; ephemeral, nil if not locked / non-locking
-	ord bool        // true = FIFO, false = LIFO (default); applies to stacks only
+	chf ChangeHook         // stacks only: change notifications
+	pst *PaddingStyle      // granular padding; nil = use nspad
+	spd *bool              // stacks only: operator word/symbol padding; nil = use pst or nspad
+	pop []Operator         // conditions only: permitted operators; nil = any
+	occ string             // required operator context; zero = any
+	opr OperatorRules      // keyword-specific permitted operators; nil = any
+	mxd int                // stacks only: maximum nesting depth; zero = unlimited
+	erh *errHistory        // error history; nil until accumulation is first enabled
+	msl int                // maximum string length; zero = unlimited
+	tmk string             // truncation marker; zero = defaultTruncationMarker
+	csc *atomic.Value      // conditions only: cached string (*string); nil if disabled
+
+	tsf map[reflect.Type]func(any) string // stacks only: type stringers
+	ops func(Operator) string             // conditions only: operator stringer; nil = use op.String
+
+	typ stackType            // stacks only: defines the typ/kind of stack
+	sym string               // stacks only: user-controlled symbol char(s)
+	sst map[stackType]string // stacks only: per-kind symbols for nested stacks
+	ljc string               // [list] stacks and conditions only: joining delim
+	ljs []string             // [list] stacks only: positional joining delims; nil = use ljc
+	mtx *sync.Mutex          // optional locking system; conditions use it for aux keys only
+	lst *lockStats           // stacks only: lock diagnostics; nil if non-locking
+	cnd *sync.Cond           // stacks only: broadcast upon unlock; nil if non-locking
+	ord bool                 // true = FIFO, false = LIFO (default); applies to stacks only
+	pri bool                 // stacks only: priority egress; Pop removes the least slice
+	mtk bool                 // stacks only: track per-slice metadata
+	inh bool                 // stacks only: nested stacks inherit presentation when rendered
+	apd bool                 // conditions only: pending expressions considered valid
+	phd string               // conditions only: pending expression placeholder; zero = "?"
+	epr bool                 // conditions only: parenthesize the expression value(s)
+	cel bool                 // conditions only: Len counts expression elements
+	ivm string               // conditions only: invalid marker; zero = badCond
+	pex bool                 // stacks only: Insert and Exchange are exempt from push policies
+	shc bool                 // stacks only: render Comment slices
+	ski bool                 // stacks only: omit invalid Condition slices when rendered
+	ucm bool                 // stacks only: include Comment slices upon Unmarshal
+	cmd *[2]string           // stacks only: Comment decoration; nil = defaultCommentDecoration
+	met []SliceMeta          // stacks only: per-slice metadata, aligned with user slices
+	unq bool                 // stacks only: refuse duplicate slices
+	uqc map[any]struct{}     // stacks only: index of primitive slices when unq; nil = stale
+	uqn int                  // stacks only: user length reflected by uqc
+	ttl time.Duration        // stacks only: slice time-to-live; zero = none
+	tte time.Time            // stacks only: time at which ttl was enabled
+	clk func() time.Time     // optional clock; nil = time.Now
+	ver *atomic.Uint64       // advanced upon each change; see nodeConfig.touch
+	own any                  // *stack or *condition bearing the receiver; see nodeConfig.logID
 }
 
 /*
@@ -49,9 +92,15 @@ type, and can be accessed using the [Stack.Auxiliary] method in similar
 fashion.
 
 The [Auxiliary] type extends four (4) methods: [Auxiliary.Get], [Auxiliary.Set],
-[Auxiliary.Len] and [Auxiliary.Unset]. These are purely for convenience.  Given
-that instances of this type can easily be cast to standard map[string]any by the
-user, the use of these methods is entirely optional.
+[Auxiliary.Len] and [Auxiliary.Unset], as well as the typed [Auxiliary.GetString],
+[Auxiliary.GetInt], [Auxiliary.GetBool] and [Auxiliary.GetStack] getters. These
+are purely for convenience. Given that instances of this type can easily be cast
+to standard map[string]any by the user, the use of these methods is entirely
+optional.
+
+Note that neither these methods nor direct map access are synchronized. For
+concurrent use, see the SetAuxKey, GetAuxKey and UnsetAuxKey methods extended
+by [Stack] and [Condition].
 
 The [Auxiliary] map instance is available to be leveraged in virtually
 any way deemed appropriate by the user. Its primary purpose is for
@@ -134,6 +183,68 @@ func (r Auxiliary) Unset(key string) Auxiliary {
 	return r
 }
 
+/*
+GetString returns the string value associated with key, alongside a
+Boolean value indicative of whether the value was found and was of the
+string type.
+*/
+func (r Auxiliary) GetString(key string) (value string, ok bool) {
+	var v any
+	if v, ok = r.Get(key); ok {
+		value, ok = v.(string)
+	}
+	return
+}
+
+/*
+GetInt returns the int value associated with key, alongside a Boolean
+value indicative of whether the value was found and was of the int type.
+*/
+func (r Auxiliary) GetInt(key string) (value int, ok bool) {
+	var v any
+	if v, ok = r.Get(key); ok {
+		value, ok = v.(int)
+	}
+	return
+}
+
+/*
+GetBool returns the Boolean value associated with key, alongside a Boolean
+value indicative of whether the value was found and was of the bool type.
+*/
+func (r Auxiliary) GetBool(key string) (value bool, ok bool) {
+	var v any
+	if v, ok = r.Get(key); ok {
+		value, ok = v.(bool)
+	}
+	return
+}
+
+/*
+GetStack returns the [Stack] value associated with key, alongside a
+Boolean value indicative of whether the value was found and was a [Stack]
+or [Stack]-alias instance. See also [ConvertStack].
+*/
+func (r Auxiliary) GetStack(key string) (value Stack, ok bool) {
+	var v any
+	if v, ok = r.Get(key); ok {
+		value, ok = ConvertStack(v)
+	}
+	return
+}
+
+/*
+setAuxKey is a private method called by [Stack.SetAuxKey] and
+[Condition.SetAuxKey]. The caller is expected to hold any lock.
+A new [Auxiliary] instance is allocated if needed.
+*/
+func (r *nodeConfig) setAuxKey(key string, value any) {
+	if r.aux == nil {
+		r.aux = make(Auxiliary, 0)
+	}
+	r.aux.Set(key, value)
+}
+
 /*
 cfgFlag contains left-shifted bit values that can represent
 one of several configuration "flag states".
@@ -159,6 +270,75 @@ const (
 
 type stackType uint8
 
+/*
+StackKind is the exported enumeration of [Stack] kinds. See the
+[Stack.KindOf] method and the [NewStack] function.
+*/
+type StackKind uint8
+
+/*
+StackKind constants define the possible kinds of [Stack] instances.
+KindInvalid is reported for uninitialized instances.
+*/
+const (
+	KindInvalid StackKind = iota // uninitialized or invalid
+	KindAnd                      // Boolean AND; see [And]
+	KindOr                       // Boolean OR; see [Or]
+	KindNot                      // Boolean NOT; see [Not]
+	KindList                     // simple list; see [List]
+	KindBasic                    // basic; see [Basic]
+)
+
+/*
+String returns the string representation of the receiver, which matches
+the [Stack.Kind] output of an unfolded [Stack] of the same kind that does
+not bear a symbol.
+*/
+func (r StackKind) String() string {
+	return r.stackType().String()
+}
+
+/*
+stackType returns the stackType equivalent of the receiver, or zero if
+the receiver is invalid.
+*/
+func (r StackKind) stackType() (typ stackType) {
+	switch r {
+	case KindAnd:
+		typ = and
+	case KindOr:
+		typ = or
+	case KindNot:
+		typ = not
+	case KindList:
+		typ = list
+	case KindBasic:
+		typ = basic
+	}
+
+	return
+}
+
+/*
+stackKind returns the [StackKind] equivalent of the receiver.
+*/
+func (r stackType) stackKind() (kind StackKind) {
+	switch r {
+	case and:
+		kind = KindAnd
+	case or:
+		kind = KindOr
+	case not:
+		kind = KindNot
+	case list:
+		kind = KindList
+	case basic:
+		kind = KindBasic
+	}
+
+	return
+}
+
 /*
 String is a stringer method that returns the string
 representation of the receiver.
@@ -193,6 +373,27 @@ func (r stack) stackType() stackType {
 	return sc.stackType()
 }
 
+/*
+encapv may (or may not) apply character encapsulation to the
+input/return value, depending on the receiver's stack type.
+*/
+func (r *nodeConfig) encapv(v string) (e string) {
+	if r.typ != basic {
+		e = encapValue(r.enc, v)
+	}
+	return
+}
+
+/*
+clock returns the current time per the clock in effect.
+*/
+func (r nodeConfig) clock() time.Time {
+	if r.clk != nil {
+		return r.clk()
+	}
+	return now()
+}
+
 func (r nodeConfig) isError() bool {
 	return r.err != nil
 }
@@ -203,6 +404,230 @@ func (r nodeConfig) getErr() error {
 
 func (r *nodeConfig) setErr(err error) {
 	r.err = err
+	if err != nil {
+		r.logEvent(LogLevel5, `error`, -1, err)
+		if r.erh != nil && r.erh.on {
+			r.erh.add(err)
+		}
+	}
+}
+
+/*
+release discards the [Auxiliary] map and all closures held by the receiver,
+such that any values they reference may be reclaimed.
+*/
+func (r *nodeConfig) release() {
+	r.aux = nil
+	r.evl, r.ppf, r.ppc, r.vpf, r.rpf, r.eqf = nil, nil, nil, nil, nil, nil
+	r.lss, r.umf, r.maf, r.mfn, r.chf = nil, nil, nil, nil, nil
+	r.tsf, r.ops = nil, nil
+}
+
+/*
+clone returns an independent copy of the receiver. Closures, as well as
+maps which are only ever replaced (and never modified in place), are
+shared with the receiver. Locking facilities, the string cache and the
+error history (if enabled) are allocated anew, and are not populated.
+*/
+func (r *nodeConfig) clone() *nodeConfig {
+	c := *r
+
+	if r.mtx != nil {
+		c.mtx = &sync.Mutex{}
+	}
+	if r.lst != nil {
+		c.lst = &lockStats{}
+		c.lst.warn.Store(r.lst.warn.Load())
+	}
+	if r.cnd != nil {
+		c.cnd = sync.NewCond(c.mtx)
+	}
+	if r.log != nil {
+		log := *r.log
+		c.log = &log
+	}
+	if r.csc != nil {
+		c.csc = new(atomic.Value)
+	}
+	c.ver = new(atomic.Uint64)
+	c.uqc = nil // rebuilt upon demand
+	if r.erh != nil {
+		c.erh = &errHistory{on: r.erh.on, size: r.erh.size}
+	}
+	if r.aux != nil {
+		c.aux = make(Auxiliary, len(r.aux))
+		for k, v := range r.aux {
+			c.aux[k] = v
+		}
+	}
+	if r.pst != nil {
+		pst := *r.pst
+		c.pst = &pst
+	}
+	if r.spd != nil {
+		spd := *r.spd
+		c.spd = &spd
+	}
+	if r.cmd != nil {
+		cmd := *r.cmd
+		c.cmd = &cmd
+	}
+
+	if r.enc != nil {
+		c.enc = make([][]string, len(r.enc))
+		for i := 0; i < len(r.enc); i++ {
+			c.enc[i] = append([]string{}, r.enc[i]...)
+		}
+	}
+	c.ljs = append([]string(nil), r.ljs...)
+	c.pop = append([]Operator(nil), r.pop...)
+	c.met = append([]SliceMeta(nil), r.met...)
+
+	return &c
+}
+
+/*
+history returns the error history of the receiver, allocating it first
+if needed.
+*/
+func (r *nodeConfig) history() *errHistory {
+	if r.erh == nil {
+		r.erh = &errHistory{size: defaultErrHistorySize}
+	}
+	return r.erh
+}
+
+/*
+setAccumulation sets the error accumulation state of the receiver per
+state, or toggles it if state is not provided.
+*/
+func (r *nodeConfig) setAccumulation(state ...bool) {
+	h := r.history()
+	if len(state) > 0 {
+		h.on = state[0]
+	} else {
+		h.on = !h.on
+	}
+}
+
+/*
+beginCall notes the name of the operation (op) underway, such that any
+error accumulated during the operation may be attributed to it.
+*/
+func (r *nodeConfig) beginCall(op string) {
+	if r != nil && r.erh != nil {
+		r.erh.op = op
+	}
+}
+
+/*
+endCall clears the operation name noted by nodeConfig.beginCall.
+*/
+func (r *nodeConfig) endCall() {
+	if r != nil && r.erh != nil {
+		r.erh.op = ``
+	}
+}
+
+/*
+ErrorRecord describes an error accumulated within the error history of a
+[Stack] or [Condition], as returned by the [Stack.Errs] and [Condition.Errs]
+methods. See [Stack.SetErrorAccumulation].
+*/
+type ErrorRecord struct {
+	Time time.Time // time at which the error was set
+	Op   string    // name of the operation underway, if instrumented; else zero
+	Err  error     // the error
+}
+
+/*
+Error returns the string message of the underlying error.
+*/
+func (r ErrorRecord) Error() string {
+	return r.Err.Error()
+}
+
+/*
+Unwrap returns the underlying error.
+*/
+func (r ErrorRecord) Unwrap() error {
+	return r.Err
+}
+
+const defaultErrHistorySize = 16
+
+/*
+errHistory is a bounded ring buffer of [ErrorRecord] instances.
+*/
+type errHistory struct {
+	on   bool          // accumulation enabled
+	size int           // maximum number of records
+	recs []ErrorRecord // ring storage, allocated up to size
+	next int           // position of the next record within recs
+	op   string        // operation underway; see nodeConfig.beginCall
+}
+
+/*
+add records err, replacing the oldest record once the ring is full.
+*/
+func (r *errHistory) add(err error) {
+	rec := ErrorRecord{Time: time.Now(), Op: r.op, Err: err}
+	if len(r.recs) < r.size {
+		r.recs = append(r.recs, rec)
+	} else {
+		r.recs[r.next] = rec
+	}
+	r.next = (r.next + 1) % r.size
+}
+
+/*
+list returns the records of the receiver, from oldest to newest.
+*/
+func (r *errHistory) list() (errs []error) {
+	if r == nil || len(r.recs) == 0 {
+		return
+	}
+
+	// the oldest record resides at next once
+	// the ring is full, else at zero (0).
+	var start int
+	if len(r.recs) == r.size {
+		start = r.next
+	}
+
+	errs = make([]error, len(r.recs))
+	for i := 0; i < len(r.recs); i++ {
+		errs[i] = r.recs[(start+i)%len(r.recs)]
+	}
+
+	return
+}
+
+/*
+resize sets the maximum number of records to n, retaining the most recent
+records which fit.
+*/
+func (r *errHistory) resize(n int) {
+	errs := r.list()
+	if len(errs) > n {
+		errs = errs[len(errs)-n:]
+	}
+
+	r.size, r.next = n, 0
+	r.recs = make([]ErrorRecord, 0, n)
+	for i := 0; i < len(errs); i++ {
+		r.recs = append(r.recs, errs[i].(ErrorRecord))
+	}
+	r.next = len(r.recs) % n
+}
+
+/*
+clear discards all records.
+*/
+func (r *errHistory) clear() {
+	if r != nil {
+		r.recs, r.next = nil, 0
+	}
 }
 
 /*
@@ -222,12 +647,12 @@ const (
 	ronly                      //   128 // stack is read-only
 	nnest                      //   256 // stack/condition does not allow stack/stack alias instances as slice members or expression value
 	etrav                      //   512 // enhanced traversal support (slices, int-keyed maps)
-	_                          //  1024
-	_                          //  2048
-	_                          //  4096
-	_                          //  8192
-	_                          // 16384
-	_                          // 32768
+	ueqty                      //  1024 // order-insensitive equality assertion for AND, OR and LIST stacks
+	umcfg                      //  2048 // include presentation settings in default unmarshal output
+	olock                      //  4096 // refuse reordering of FIFO stacks
+	scach                      //  8192 // cache the string representation of a condition
+	vpush                      // 16384 // validate stacks and conditions upon push
+	kfold                      // 32768 // case-insensitive keyword comparison during equality assertion
 )
 
 /*
@@ -238,10 +663,46 @@ func (r *nodeConfig) isZero() bool {
 	return r == nil
 }
 
+/*
+setID assigns id to the receiver. The `_random` keyword is replaced with a
+random identifier, bearing the receiver's ID prefix if set. The `_addr`
+keyword is stored as-is, and is resolved upon each read of the identifier.
+*/
 func (r *nodeConfig) setID(id string) {
+	switch lc(id) {
+	case `_random`:
+		if id = randomID(randIDSize); len(r.idp) > 0 {
+			id = r.idp + `-` + id
+		}
+	case addrID:
+		id = addrID
+	}
 	r.id = id
 }
 
+/*
+getID returns the identifier assigned to the receiver. If the `_addr`
+keyword was assigned, the current address (addr) is returned instead.
+*/
+func (r *nodeConfig) getID(addr string) string {
+	if r.id == addrID {
+		return addr
+	}
+	return r.id
+}
+
+/*
+logID returns the identifier of the instance which bears the receiver for
+use within log events, resolving the `_addr` keyword in the same manner as
+[Stack.ID] and [Condition.ID].
+*/
+func (r *nodeConfig) logID() (id string) {
+	if id = r.id; id == addrID {
+		id = ptrString(r.own)
+	}
+	return
+}
+
 func (r *nodeConfig) setCat(cat string) {
 	r.cat = cat
 }
@@ -307,6 +768,244 @@ func (r *nodeConfig) setOpt(x cfgFlag) (err error) {
 	return
 }
 
+/*
+MarshalSettings is a map[string]any type alias which conveys presentation
+settings during the default [Stack.Unmarshal] and [Stack.Marshal] operations.
+When present, it appears as the second element of an unmarshaled [Stack] or
+[Condition], immediately following the kind label.
+
+The following keys are recognized:
+
+  - "paren" (bool), as with [Stack.SetParen]
+  - "fold" (bool), as with [Stack.SetFold]
+  - "leadonce" (bool), as with [Stack.SetLeadOnce]
+  - "nopadding" (bool), as with [Stack.SetNoPadding]
+  - "symbol" (string), as with [Stack.SetSymbol]
+  - "delimiter" (string), as with [Stack.SetDelimiter]
+  - "encap" ([][]string), as with [Stack.SetEncap]
+  - "id" (string), as with [Stack.SetID]
+  - "category" (string), as with [Stack.SetCategory]
+
+Only those settings which differ from their defaults are included. See
+[Stack.SetUnmarshalWithConfig].
+*/
+type MarshalSettings map[string]any
+
+/*
+marshalSettingsFlags maps the Boolean [MarshalSettings] keys to their
+respective cfgFlag values.
+*/
+var marshalSettingsFlags = map[string]cfgFlag{
+	`paren`:     parens,
+	`fold`:      cfold,
+	`leadonce`:  lonce,
+	`nopadding`: nspad,
+}
+
+/*
+marshalSettings returns an instance of [MarshalSettings] describing the
+presentation settings of the receiver.
+*/
+func (r *nodeConfig) marshalSettings() MarshalSettings {
+	ms := make(MarshalSettings)
+	for key, flag := range marshalSettingsFlags {
+		if r.positive(flag) {
+			ms[key] = true
+		}
+	}
+
+	for key, val := range map[string]string{
+		`symbol`:    r.sym,
+		`delimiter`: r.ljc,
+		`id`:        r.id,
+		`category`:  r.cat,
+	} {
+		if len(val) > 0 {
+			ms[key] = val
+		}
+	}
+
+	if len(r.enc) > 0 {
+		enc := make([][]string, len(r.enc))
+		for i := 0; i < len(r.enc); i++ {
+			enc[i] = append([]string{}, r.enc[i]...)
+		}
+		ms[`encap`] = enc
+	}
+
+	return ms
+}
+
+/*
+applyMarshalSettings applies the input [MarshalSettings] to the receiver.
+Unrecognized keys, as well as values of an unexpected type, are ignored.
+*/
+func (r *nodeConfig) applyMarshalSettings(ms MarshalSettings) {
+	for key, flag := range marshalSettingsFlags {
+		if b, _ := ms[key].(bool); b {
+			r.setOpt(flag)
+		}
+	}
+
+	if sym, ok := ms[`symbol`].(string); ok && r.typ != list {
+		r.setSymbol(sym)
+	}
+	if delim, ok := ms[`delimiter`].(string); ok {
+		r.setListDelimiter(delim)
+	}
+	if id, ok := ms[`id`].(string); ok {
+		r.setID(id)
+	}
+	if cat, ok := ms[`category`].(string); ok {
+		r.setCat(cat)
+	}
+	if enc, ok := ms[`encap`].([][]string); ok {
+		for i := 0; i < len(enc); i++ {
+			r.setEncap(enc[i])
+		}
+	}
+}
+
+/*
+StackConfig is a read-only snapshot of the configuration of a [Stack],
+as returned by the [Stack.Config] method. Modifying an instance of this
+type has no effect upon the [Stack] from which it was obtained.
+*/
+type StackConfig struct {
+	ID              string               // see [Stack.ID]
+	IDPrefix        string               // see [Stack.IDPrefix]
+	Category        string               // see [Stack.Category]
+	Kind            StackKind            // see [Stack.KindOf]
+	Symbol          string               // see [Stack.SetSymbol]
+	Symbols         map[StackKind]string // see [Stack.Symbols]
+	Delimiter       string               // see [Stack.Delimiter]
+	Delimiters      []string             // see [Stack.Delimiters]
+	Encap           [][2]string          // see [Stack.EncapChars]
+	Cap             int                  // see [Stack.Cap]
+	FIFO            bool                 // see [Stack.IsFIFO]
+	Priority        bool                 // see [Stack.IsPriority]
+	LogLevels       string               // see [Stack.LogLevels]
+	OperatorContext string               // see [Stack.OperatorContext]
+	MaxStringLength int                  // see [Stack.MaxStringLength]
+	Paren           bool                 // see [Stack.IsParen]
+	Fold            bool                 // see [Stack.SetFold]
+	Padded          bool                 // see [Stack.IsPadded]
+	SymbolPadded    bool                 // see [Stack.IsSymbolPadded]
+	LeadOnce        bool                 // see [Stack.SetLeadOnce]
+	ReadOnly        bool                 // see [Stack.IsReadOnly]
+	NoNesting       bool                 // see [Stack.SetNoNesting]
+	NegativeIndices bool                 // see [Stack.NegativeIndices]
+	ForwardIndices  bool                 // see [Stack.ForwardIndices]
+	Mutex           bool                 // see [Stack.CanMutex]
+	Unique          bool                 // see [Stack.IsUnique]
+	TTL             time.Duration        // see [Stack.TTL]
+}
+
+/*
+ConditionConfig is a read-only snapshot of the configuration of a
+[Condition], as returned by the [Condition.Config] method. Modifying
+an instance of this type has no effect upon the [Condition] from which
+it was obtained.
+*/
+type ConditionConfig struct {
+	ID              string      // see [Condition.ID]
+	IDPrefix        string      // see [Condition.IDPrefix]
+	Category        string      // see [Condition.Category]
+	Delimiter       string      // see [Condition.ValueDelimiter]
+	Encap           [][2]string // see [Condition.EncapChars]
+	LogLevels       string      // see [Condition.LogLevels]
+	OperatorContext string      // see [Condition.OperatorContext]
+	MaxStringLength int         // see [Condition.MaxStringLength]
+	Paren           bool        // see [Condition.IsParen]
+	Fold            bool        // see [Condition.IsFolded]
+	Padded          bool        // see [Condition.IsPadded]
+	ReadOnly        bool        // see [Condition.IsReadOnly]
+	NoNesting       bool        // see [Condition.SetNoNesting]
+	StringCached    bool        // see [Condition.IsStringCached]
+	ExpressionParen bool        // see [Condition.IsExpressionParen]
+	CountElements   bool        // see [Condition.IsCountElements]
+}
+
+/*
+stackConfig returns a [StackConfig] snapshot of the receiver. The input
+addr is the address of the circumscribing [Stack], for use in resolving
+address-based IDs.
+*/
+func (r *nodeConfig) stackConfig(addr string) (snap StackConfig) {
+	snap = StackConfig{
+		ID:              r.getID(addr),
+		IDPrefix:        r.idp,
+		Category:        r.cat,
+		Kind:            r.typ.stackKind(),
+		Symbol:          r.sym,
+		Delimiter:       r.getListDelimiter(),
+		Encap:           r.encapChars(),
+		Cap:             -1,
+		FIFO:            r.ord,
+		Priority:        r.pri,
+		LogLevels:       r.log.lvl.String(),
+		OperatorContext: r.occ,
+		MaxStringLength: max(r.msl, 0),
+		Paren:           r.positive(parens),
+		Fold:            r.positive(cfold),
+		Padded:          !r.positive(nspad),
+		SymbolPadded:    r.symbolPadded(),
+		LeadOnce:        r.positive(lonce),
+		ReadOnly:        r.positive(ronly),
+		NoNesting:       r.positive(nnest),
+		NegativeIndices: r.positive(negidx),
+		ForwardIndices:  r.positive(fwdidx),
+		Mutex:           r.mtx != nil,
+		Unique:          r.unq,
+		TTL:             r.ttl,
+	}
+
+	if r.cap > 0 {
+		snap.Cap = r.cap - 1 // minus cfg slice
+	}
+
+	for typ, sym := range r.sst {
+		if snap.Symbols == nil {
+			snap.Symbols = make(map[StackKind]string)
+		}
+		snap.Symbols[typ.stackKind()] = sym
+	}
+
+	if len(r.ljs) > 0 {
+		snap.Delimiters = append([]string{}, r.ljs...)
+	} else if len(r.ljc) > 0 {
+		snap.Delimiters = []string{r.ljc}
+	}
+
+	return
+}
+
+/*
+conditionConfig returns a [ConditionConfig] snapshot of the receiver. The
+input addr is the address of the circumscribing [Condition], for use in
+resolving address-based IDs.
+*/
+func (r *nodeConfig) conditionConfig(addr string) ConditionConfig {
+	return ConditionConfig{
+		ID:              r.getID(addr),
+		IDPrefix:        r.idp,
+		Category:        r.cat,
+		Delimiter:       r.getListDelimiter(),
+		Encap:           r.encapChars(),
+		LogLevels:       r.log.lvl.String(),
+		OperatorContext: r.occ,
+		MaxStringLength: max(r.msl, 0),
+		Paren:           r.positive(parens),
+		Fold:            r.positive(cfold),
+		Padded:          !r.positive(nspad),
+		ReadOnly:        r.positive(ronly),
+		NoNesting:       r.positive(nnest),
+		StringCached:    r.positive(scach),
+		ExpressionParen: r.epr,
+		CountElements:   r.cel,
+	}
+}
+
 /*
 setEncap accepts input characters for use in controlled stack value
 encapsulation.
@@ -343,15 +1042,70 @@ func (r *nodeConfig) setEncap(x ...any) {
 	}
 }
 
+/*
+encapChars returns the L/R encapsulation pairs of the receiver in order
+of application. A single-character scheme is returned as a pair of the
+same character.
+*/
+func (r *nodeConfig) encapChars() (pairs [][2]string) {
+	for i := 0; i < len(r.enc); i++ {
+		switch len(r.enc[i]) {
+		case 0:
+			continue
+		case 1:
+			pairs = append(pairs, [2]string{r.enc[i][0], r.enc[i][0]})
+		default:
+			pairs = append(pairs, [2]string{r.enc[i][0], r.enc[i][1]})
+		}
+	}
+
+	return
+}
+
 /*
 setListDelimiter is a private method invoked by stack.setListDelimiter.
 */
 func (r *nodeConfig) setListDelimiter(x string) {
-	if r.typ == list {
+	if r.typ == list || r.typ == cond {
 		r.ljc = x
 	}
 }
 
+/*
+setListDelimiters is a private method invoked by stack.setListDelimiters.
+The first delimiter becomes the primary delimiter.
+*/
+func (r *nodeConfig) setListDelimiters(x []string) {
+	if r.typ == list {
+		r.ljc, r.ljs = ``, nil
+		if len(x) > 0 {
+			r.ljc = x[0]
+		}
+		if len(x) > 1 {
+			r.ljs = append([]string{}, x...)
+		}
+	}
+}
+
+/*
+listDelimiter returns the delimiter used to join the slices at user
+indices idx-1 and idx, of which there are L in total. The final join
+uses the last of the positional delimiters, if set, while all others
+are assigned positionally, repeating the second-to-last as needed.
+*/
+func (r nodeConfig) listDelimiter(idx, L int) string {
+	n := len(r.ljs)
+	if n == 0 || idx < 1 {
+		return r.ljc
+	} else if idx == L-1 {
+		return r.ljs[n-1]
+	} else if idx-1 < n-1 {
+		return r.ljs[idx-1]
+	}
+
+	return r.ljs[n-2]
+}
+
 /*
 getListDelimiter is a private method invoked by stack.getListDelimiter.
 */
@@ -359,6 +1113,138 @@ func (r nodeConfig) getListDelimiter() string {
 	return r.ljc
 }
 
+/*
+truncationMarker returns the string appended to truncated string
+representations.
+*/
+func (r nodeConfig) truncationMarker() string {
+	if len(r.tmk) == 0 {
+		return defaultTruncationMarker
+	}
+	return r.tmk
+}
+
+/*
+invalidMarker returns the string rendered in place of an invalid [Condition].
+*/
+func (r nodeConfig) invalidMarker() string {
+	if len(r.ivm) == 0 {
+		return badCond
+	}
+	return r.ivm
+}
+
+/*
+placeholder returns the string rendered in place of a [PendingExpression].
+*/
+func (r nodeConfig) placeholder() string {
+	if len(r.phd) == 0 {
+		return defaultPlaceholder
+	}
+	return r.phd
+}
+
+/*
+PaddingStyle is a bitmask type used to granularly control the padding of
+components during the string representation of [Condition] and [Stack]
+instances. See the [Condition.SetPaddingStyle] and [Stack.SetPaddingStyle]
+methods.
+
+When set, an instance of this type supersedes the legacy no-padding bit
+controlled by the [Condition.SetNoPadding] and [Stack.SetNoPadding]
+methods.
+*/
+type PaddingStyle uint8
+
+const (
+	PadBeforeOperator PaddingStyle = 1 << iota // Conditions only: pad between the keyword and operator
+	PadAfterOperator                           // Conditions only: pad between the operator and expression value
+	PadInsideParens                            // pad the inner side of each parenthetical character
+	PadAroundOperator                          // Stacks only: pad the operator word or symbol used to join slices
+)
+
+/*
+setPaddingStyle is a private method invoked by [Condition.SetPaddingStyle]
+and [Stack.SetPaddingStyle].
+*/
+func (r *nodeConfig) setPaddingStyle(style PaddingStyle) {
+	r.pst = &style
+}
+
+/*
+symbolPadded returns a Boolean value indicative of whether the operator
+word or symbol used to join slices is padded. An explicit state set via
+[Stack.SetSymbolPadding] prevails, followed by the [PadAroundOperator]
+bit of any [PaddingStyle], followed by the no-padding bit.
+*/
+func (r nodeConfig) symbolPadded() bool {
+	if r.spd != nil {
+		return *r.spd
+	} else if r.pst != nil {
+		return *r.pst&PadAroundOperator != 0
+	}
+
+	return !r.positive(nspad)
+}
+
+/*
+dropString discards the cached string representation, if any, held by
+the receiver, and advances its version (see nodeConfig.touch).
+*/
+func (r *nodeConfig) dropString() {
+	if r.csc != nil {
+		r.csc.Store((*string)(nil))
+	}
+	r.touch()
+}
+
+/*
+touch advances the version of the receiver, indicating that the contents
+of the instance which bears it have changed. Versions allow a [Cursor] to
+forgo verification of a path which has not changed since its last step.
+*/
+func (r *nodeConfig) touch() {
+	if r != nil && r.ver != nil {
+		r.ver.Add(1)
+	}
+}
+
+/*
+version returns the version of the receiver alongside a Boolean value
+indicative of whether the receiver is versioned. See nodeConfig.touch.
+*/
+func (r *nodeConfig) version() (v uint64, ok bool) {
+	if ok = r != nil && r.ver != nil; ok {
+		v = r.ver.Load()
+	}
+
+	return
+}
+
+/*
+paddingStyle returns the [PaddingStyle] instance set within the receiver
+alongside a Boolean value indicative of whether a style was set at all.
+*/
+func (r nodeConfig) paddingStyle() (style PaddingStyle, ok bool) {
+	if ok = r.pst != nil; ok {
+		style = *r.pst
+	}
+
+	return
+}
+
+/*
+padIf returns a single space character (ASCII #32) if do is true, else
+a zero string.
+*/
+func padIf(do bool) (pad string) {
+	if do {
+		pad = string(rune(32))
+	}
+
+	return
+}
+
 /*
 setStringSliceEncap is a private method called by nodeConfig.setEncap,
 and determines which encapsulation method to call based on the encap
@@ -444,8 +1330,82 @@ func (r *nodeConfig) setMutex() {
 	if r.valid() {
 		if r.mtx == nil {
 			r.mtx = &sync.Mutex{}
+			r.lst = &lockStats{}
+			r.cnd = sync.NewCond(r.mtx)
+		}
+	}
+}
+
+/*
+LockStats contains lock diagnostics for a [Stack] equipped with mutual
+exclusion locking features. See the [Stack.LockStats] method.
+*/
+type LockStats struct {
+	CurrentHoldDuration time.Duration // zero if not currently locked
+	TotalLocks          int64         // number of lock acquisitions
+	TotalWaitTime       time.Duration // cumulative time spent waiting to acquire the lock
+	MaxHoldTime         time.Duration // longest single hold
+}
+
+/*
+lockStats contains the counters used to produce a [LockStats] instance.
+All fields are accessed atomically, as they may be read while the lock
+is held by another goroutine.
+*/
+type lockStats struct {
+	total atomic.Int64 // lock acquisitions
+	wait  atomic.Int64 // cumulative wait (ns)
+	max   atomic.Int64 // longest hold (ns)
+	held  atomic.Int64 // acquisition time (unix ns); zero if unlocked
+	warn  atomic.Int64 // hold warning threshold (ns); zero if unset
+}
+
+/*
+locked records a lock acquisition, which was requested at start.
+*/
+func (r *lockStats) locked(start time.Time) {
+	acquired := now()
+	r.total.Add(1)
+	r.wait.Add(int64(acquired.Sub(start)))
+	r.held.Store(acquired.UnixNano())
+}
+
+/*
+unlocked records a lock release, returning the duration of the hold
+alongside a Boolean value indicative of whether the warning threshold,
+if set, was exceeded.
+*/
+func (r *lockStats) unlocked() (hold time.Duration, warn bool) {
+	if held := r.held.Swap(0); held != 0 {
+		hold = time.Duration(now().UnixNano() - held)
+		for {
+			max := r.max.Load()
+			if int64(hold) <= max || r.max.CompareAndSwap(max, int64(hold)) {
+				break
+			}
+		}
+
+		thr := r.warn.Load()
+		warn = thr > 0 && int64(hold) > thr
+	}
+
+	return
+}
+
+/*
+stats returns a [LockStats] snapshot of the receiver.
+*/
+func (r *lockStats) stats() (ls LockStats) {
+	if r != nil {
+		if held := r.held.Load(); held != 0 {
+			ls.CurrentHoldDuration = time.Duration(now().UnixNano() - held)
 		}
+		ls.TotalLocks = r.total.Load()
+		ls.TotalWaitTime = time.Duration(r.wait.Load())
+		ls.MaxHoldTime = time.Duration(r.max.Load())
 	}
+
+	return
 }
 
 /*
@@ -513,5 +1473,11 @@ func init() {
 		ronly:  `read_only`,
 		nnest:  `no_nest`,
 		etrav:  `enhanced_traversal`,
+		ueqty:  `unordered_equality`,
+		umcfg:  `unmarshal_config`,
+		olock:  `order_lock`,
+		scach:  `string_cache`,
+		vpush:  `validate_on_push`,
+		kfold:  `keyword_fold`,
 	}
 }
@@ -0,0 +1,217 @@
+package stackage
+
+/*
+comment.go contains the Comment type and the [Stack] methods which
+govern its presentation.
+*/
+
+/*
+Comment is a string type which may be pushed into a [Stack] in order to
+annotate its contents, such as for the benefit of human reviewers.
+
+By default, Comment slices are not rendered by [Stack.String]; see the
+[Stack.SetShowComments] method. Comment slices are disregarded by the
+[Stack.IsEqual] method, and are only included within the output of the
+default [Stack.Unmarshal] method if requested (see the method named
+[Stack.SetUnmarshalComments]).
+
+Comment slices are counted by [Stack.Len]. See [Stack.LenExcludingComments].
+*/
+type Comment string
+
+/*
+String returns the string form of the receiver, without decoration.
+*/
+func (r Comment) String() string {
+	return string(r)
+}
+
+/*
+defaultCommentDecoration contains the default leading and trailing
+sequences which surround a [Comment] when rendered.
+*/
+var defaultCommentDecoration = [2]string{`/* `, ` */`}
+
+/*
+isComment returns a Boolean value indicative of whether x is a [Comment].
+*/
+func isComment(x any) (is bool) {
+	_, is = x.(Comment)
+	return
+}
+
+/*
+SetShowComments enables (true) or disables (false) the rendering of the
+[Comment] slices of the receiver by [Stack.String] and [Stack.WriteTo].
+When enabled, each [Comment] is rendered at its position using the comment
+decoration in effect (see [Stack.SetCommentDecoration]), and without the
+operator or delimiter used to join other slices.
+
+This setting applies to the receiver's own [Comment] slices. Nested [Stack]
+instances consult their own setting, unless presentation inheritance was
+enabled within the receiver (see [Stack.SetInheritPresentation]).
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetShowComments(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			if len(state) > 0 {
+				sc.shc = state[0]
+			} else {
+				sc.shc = !sc.shc
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+IsShowingComments returns a Boolean value indicative of whether [Comment]
+slices are rendered by the receiver. See [Stack.SetShowComments].
+*/
+func (r Stack) IsShowingComments() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.shc
+	}
+	return
+}
+
+/*
+SetCommentDecoration assigns the leading (L) and trailing (R) sequences
+which surround each [Comment] rendered by the receiver. Zero values for
+both restore the default decoration, which renders a [Comment] in the
+manner of a C-style block comment, padded within.
+*/
+func (r Stack) SetCommentDecoration(L, R string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.cmd = nil
+			if len(L)+len(R) > 0 {
+				sc.cmd = &[2]string{L, R}
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+SetUnmarshalComments enables (true) or disables (false) the inclusion of
+the receiver's [Comment] slices within the output of the default [Stack.Unmarshal]
+method. By default, they are omitted. Nested [Stack] instances consult
+their own setting.
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetUnmarshalComments(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			if len(state) > 0 {
+				sc.ucm = state[0]
+			} else {
+				sc.ucm = !sc.ucm
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+LenExcludingComments returns the integer length of the receiver, less the
+number of [Comment] slices present. See also [Stack.Len].
+*/
+func (r Stack) LenExcludingComments() (n int) {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		n = r.stack.ulen() - r.stack.comments()
+	}
+	return
+}
+
+/*
+comments returns the number of [Comment] slices within the receiver.
+*/
+func (r stack) comments() (n int) {
+	for i := 1; i < r.len(); i++ {
+		if isComment(r[i]) {
+			n++
+		}
+	}
+	return
+}
+
+/*
+uncommentedIndices returns the user indices of the slices of the receiver
+which are not [Comment] slices, in order.
+*/
+func (r stack) uncommentedIndices() (idxs []int) {
+	idxs = make([]int, 0, r.ulen())
+	for i := 1; i < r.len(); i++ {
+		if !isComment(r[i]) {
+			idxs = append(idxs, i-1)
+		}
+	}
+	return
+}
+
+/*
+uncommented returns the receiver if it contains no [Comment] slices, else
+a copy -- sharing the receiver's configuration -- from which the [Comment]
+slices were removed.
+*/
+func (r *stack) uncommented() *stack {
+	if r.comments() == 0 {
+		return r
+	}
+
+	cp := stack{(*r)[0]}
+	for i := 1; i < r.len(); i++ {
+		if !isComment((*r)[i]) {
+			cp = append(cp, (*r)[i])
+		}
+	}
+
+	return &cp
+}
+
+/*
+commentString returns the decorated string form of the input [Comment]
+(c) if the receiver renders [Comment] slices, else a zero string.
+*/
+func (r stack) commentString(c Comment) (s string) {
+	if sc, _ := r.config(); sc.shc {
+		dec := defaultCommentDecoration
+		if sc.cmd != nil {
+			dec = *sc.cmd
+		}
+		s = dec[0] + string(c) + dec[1]
+	}
+	return
+}
+
+/*
+commentPadded returns a Boolean value indicative of whether [Comment]
+slices are padded when rendered within the receiver, which is the case
+whenever its join value is padded. Word operators are always padded.
+*/
+func (r stack) commentPadded() bool {
+	sc, _ := r.config()
+	if sc.spd == nil && sc.pst == nil && r.stackType() != list && len(r.getSymbol()) == 0 {
+		return true
+	}
+
+	return sc.symbolPadded()
+}
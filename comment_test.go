@@ -0,0 +1,70 @@
+package stackage
+
+import "testing"
+
+func TestComment(t *testing.T) {
+	S := And().Paren().Push(
+		Comment(`users`),
+		Cond(`cn`, Eq, `a`),
+		Comment(`mail check`),
+		Cond(`mail`, Eq, `b`),
+	)
+	twin := And().Paren().Push(Cond(`cn`, Eq, `a`), Cond(`mail`, Eq, `b`))
+
+	canonical := twin.String()
+	if got := S.String(); got != canonical {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), canonical, got)
+		return
+	} else if S.Len() != 4 || S.LenExcludingComments() != 2 {
+		t.Errorf("%s failed: want lengths 4 and 2, got %d and %d", t.Name(), S.Len(), S.LenExcludingComments())
+		return
+	} else if err := S.IsEqual(twin); err != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	want := `( /* users */ cn = a /* mail check */ AND mail = b )`
+	if got := S.SetShowComments(true).String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if err := twin.IsEqual(S); err != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	want = `( # users cn = a # mail check AND mail = b )`
+	if got := S.SetCommentDecoration(`# `, ``).String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if got := S.SetShowComments(false).String(); got != canonical {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), canonical, got)
+		return
+	}
+
+	// unmarshal omits comments unless requested
+	if slices, _ := S.Unmarshal(); len(slices) != 3 {
+		t.Errorf("%s failed: want 3 unmarshaled slices, got %d", t.Name(), len(slices))
+		return
+	} else if slices, _ = S.SetUnmarshalComments(true).Unmarshal(); len(slices) != 5 || slices[1] != Comment(`users`) {
+		t.Errorf("%s failed: want 5 unmarshaled slices, got %v", t.Name(), slices)
+		return
+	}
+
+	// comments are padded only as the join value is
+	for idx, tst := range []struct {
+		S    Stack
+		Want string
+	}{
+		{And().SetSymbol(`&`), `a /* c */ & b`},
+		{And().SetSymbol(`&`).SetNoPadding(true), `a/* c */&b`},
+		{And().SetSymbol(`&`).SetPaddingStyle(PadAroundOperator), `a /* c */ & b`},
+		{And().SetNoPadding(true), `a /* c */ AND b`},
+		{List().SetDelimiter(`,`).SetNoPadding(true), `a/* c */,b`},
+	} {
+		if got := tst.S.SetShowComments(true).Push(`a`, Comment(`c`), `b`).String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+		}
+	}
+}
@@ -4,6 +4,14 @@ package stackage
 cond.go contains Condition-related methods and functions.
 */
 
+import (
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
 /*
 Condition describes a single evaluative statement, i.e.:
 
@@ -59,8 +67,28 @@ of Condition.
 type condition struct {
 	cfg *nodeConfig
 	kw  string
+	kwv any // original keyword value, if not a string
 	op  Operator
-	ex  any // expression value
+	ex  any   // expression value
+	exv []any // multi-valued expression values
+}
+
+/*
+clone returns a deep copy of the receiver. See stack.clone.
+*/
+func (r *condition) clone(clones map[*stack]*stack) *condition {
+	c := *r
+	c.cfg = r.cfg.clone()
+	c.cfg.own = &c
+	c.ex = cloneValue(r.ex, clones)
+	if r.exv != nil {
+		c.exv = make([]any, len(r.exv))
+		for i := 0; i < len(r.exv); i++ {
+			c.exv[i] = cloneValue(r.exv[i], clones)
+		}
+	}
+
+	return &c
 }
 
 /*
@@ -90,6 +118,9 @@ func initCondition() (r *condition) {
 	r.cfg.log.lvl = logLevels(NoLogLevels)
 
 	r.cfg.typ = cond
+	r.cfg.mtx = &sync.Mutex{} // aux key access only
+	r.cfg.ver = new(atomic.Uint64)
+	r.cfg.own = r
 
 	return
 }
@@ -142,6 +173,53 @@ func (r Condition) Auxiliary() (aux Auxiliary) {
 	return
 }
 
+/*
+SetAuxKey associates key with value within the receiver's [Auxiliary]
+instance, which is allocated if needed. Unlike direct access to the map
+returned by [Condition.Auxiliary], which is unsynchronized, this method
+is safe for concurrent use alongside [Condition.GetAuxKey] and
+[Condition.UnsetAuxKey].
+*/
+func (r Condition) SetAuxKey(key string, value any) Condition {
+	if r.IsInit() {
+		r.condition.cfg.mtx.Lock()
+		defer r.condition.cfg.mtx.Unlock()
+		if !r.getState(ronly) {
+			r.condition.cfg.setAuxKey(key, value)
+		}
+	}
+	return r
+}
+
+/*
+GetAuxKey returns the value associated with key within the receiver's
+[Auxiliary] instance, alongside a presence-indicative Boolean value. This
+method is safe for concurrent use. See also [Condition.SetAuxKey].
+*/
+func (r Condition) GetAuxKey(key string) (value any, ok bool) {
+	if r.IsInit() {
+		r.condition.cfg.mtx.Lock()
+		defer r.condition.cfg.mtx.Unlock()
+		value, ok = r.condition.cfg.aux.Get(key)
+	}
+	return
+}
+
+/*
+UnsetAuxKey removes key from the receiver's [Auxiliary] instance, if
+found. This method is safe for concurrent use. See also [Condition.SetAuxKey].
+*/
+func (r Condition) UnsetAuxKey(key string) Condition {
+	if r.IsInit() {
+		r.condition.cfg.mtx.Lock()
+		defer r.condition.cfg.mtx.Unlock()
+		if !r.getState(ronly) {
+			r.condition.cfg.aux.Unset(key)
+		}
+	}
+	return r
+}
+
 /*
 auxiliary is a private method called by [Condition.Auxiliary].
 */
@@ -157,6 +235,8 @@ input argument.
 func (r Condition) SetKeyword(kw any) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
+			r.condition.cfg.logCall(`keyword`, 1)
+			defer r.condition.cfg.endCall()
 			r.condition.setKeyword(kw)
 		}
 	}
@@ -165,14 +245,34 @@ func (r Condition) SetKeyword(kw any) Condition {
 }
 
 func (r *condition) setKeyword(kw any) {
+	r.cfg.dropString()
 	switch tv := kw.(type) {
 	case string:
 		r.kw = tv
+		r.kwv = nil
 	default:
 		if meth := getStringer(tv); meth != nil {
 			r.kw = meth()
+			r.kwv = tv
+		}
+	}
+
+	if r.op != nil {
+		if err := r.cfg.opr.Check(r.kw, r.op); err != nil {
+			r.setErr(err)
 		}
 	}
+	r.cfg.logEvent(LogLevel3, `keyword`, -1, nil)
+}
+
+/*
+keywordValue returns the original keyword value, else the string form.
+*/
+func (r condition) keywordValue() any {
+	if r.kwv != nil {
+		return r.kwv
+	}
+	return r.kw
 }
 
 /*
@@ -182,1025 +282,2696 @@ specified [Operator]-qualifying input argument (op).
 func (r Condition) SetOperator(op Operator) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
+			r.condition.cfg.logCall(`operator`, 1)
+			defer r.condition.cfg.endCall()
 			r.condition.setOperator(op)
 		}
 	}
 	return r
 }
 
+/*
+Negate replaces the receiver's [Operator] with its logical negation, as
+determined by the [NegateOperator] function, and returns the receiver.
+
+If the operator has no known negation, the receiver is not modified and
+an error is set within the receiver. In such cases, consider enveloping
+the receiver within a [Not] [Stack] instead, which is what [Stack.Negate]
+does for such slices.
+*/
+func (r Condition) Negate() Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if neg, ok := NegateOperator(r.condition.op); ok {
+				r.condition.setOperator(neg)
+			} else {
+				r.condition.setErr(errorf("%T operator '%s' has no known negation",
+					r.condition.op, r.condition.op))
+			}
+		}
+	}
+	return r
+}
+
 func (r *condition) setOperator(op Operator) {
-	if len(op.Context()) > 0 && len(op.String()) > 0 {
+	if err := r.checkOperator(op); err != nil {
+		r.setErr(err)
+	} else {
 		r.op = op
+		r.cfg.dropString()
+		r.cfg.logEvent(LogLevel3, `operator`, -1, nil)
 	}
 }
 
 /*
-SetExpression sets the receiver's expression value(s) using the
-specified ex input argument.  See also the [Condition.Expression]
-method.
+SetPermittedOperators assigns the input [Operator] instances as the only
+operators permitted for use by the receiver. When set, the operator
+specified via [Condition.SetOperator] is rejected unless it appears within
+this allowlist, and [Condition.Valid] shall return an error under the same
+circumstances.
+
+Operators are matched by both their string and context values. Execution
+without any input operators removes the allowlist, permitting any valid
+[Operator].
 */
-func (r Condition) SetExpression(ex any) Condition {
+func (r Condition) SetPermittedOperators(ops ...Operator) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.condition.setExpression(ex)
+			r.condition.setPermittedOperators(ops...)
 		}
 	}
 	return r
 }
 
-func (r *condition) setExpression(ex any) {
-	if v, ok := r.assertConditionExpressionValue(ex); ok {
-		r.ex = v
+func (r *condition) setPermittedOperators(ops ...Operator) {
+	r.cfg.pop = nil
+	for i := 0; i < len(ops); i++ {
+		if ops[i] != nil {
+			r.cfg.pop = append(r.cfg.pop, ops[i])
+		}
 	}
 }
 
 /*
-SetEqualityPolicy sets or unsets the [EqualityPolicy] within the receiver
-instance.
-
-When fed an [EqualityPolicy], it shall override the package default mechanism
-beginning at the next call of [Condition.IsEqual].
+SetOperatorContext assigns the context value required of any [Operator]
+specified via [Condition.SetOperator]. When set, an [Operator] whose
+Context method returns any other value is rejected, and [Condition.Valid]
+shall return an error under the same circumstances.
 
-When fed zero (0) [EqualityPolicy] instances, or a value of nil, the previously
-specified instance will be removed, at which point the default behavior resumes.
+A zero string disables this check. See also [Stack.SetOperatorContext].
 */
-func (r Condition) SetEqualityPolicy(fn ...EqualityPolicy) Condition {
+func (r Condition) SetOperatorContext(ctx string) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			if len(fn) == 0 {
-				r.condition.cfg.eqf = nil
-			} else {
-				r.condition.cfg.eqf = fn[0]
-			}
+			r.condition.cfg.occ = ctx
 		}
 	}
-
 	return r
 }
 
 /*
-SetUnmarshaler sets or unsets the [Unmarshaler] within the receiver
-instance.
-
-When fed an [Unmarshaler], it shall override the package default mechanism
-beginning at the next call of [Condition.Unmarshal].
+SetOperatorRules assigns a copy of the input [OperatorRules] to the receiver,
+restricting the [Operator] permitted alongside the keyword in effect. When
+set, an [Operator] specified via [Condition.SetOperator] is rejected unless
+permitted, and [Condition.Valid] shall return an error under the same
+circumstances. A keyword specified via [Condition.SetKeyword] is assigned
+regardless, though an error is set within the receiver if the [Operator]
+already in effect is not permitted alongside it.
 
-When fed zero (0) [Unmarshaler] instances, or a value of nil, the previously
-specified instance will be removed, at which point the default behavior resumes.
+Rules set within the receiver take precedence over any set within a [Stack]
+into which the receiver is pushed (see [Stack.SetOperatorRules]). A nil map
+removes the rules.
 */
-func (r Condition) SetUnmarshaler(fn ...Unmarshaler) Condition {
+func (r Condition) SetOperatorRules(rules OperatorRules) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			if len(fn) == 0 {
-				r.condition.cfg.umf = nil
-			} else {
-				r.condition.cfg.umf = fn[0]
+			r.condition.cfg.opr = rules.clone()
+			if err := r.condition.cfg.opr.Check(r.condition.kw,
+				r.condition.op); err != nil {
+				r.condition.setErr(err)
 			}
 		}
 	}
-
 	return r
 }
 
 /*
-Unmarshal returns an instance of []any containing the unmarshaled instance
-of the receiver. This can be used for use in deep inspections of [Condition]
-or [Condition]-alias instances.
-
-Those instances that contain complex nested [Stack] or [Stack]-alias instances
-specified as the [Condition.Expression] will have those instances unmarshaled
-by way of the [Stack.Unmarshal] method.
+OperatorRules returns a copy of the [OperatorRules] set within the receiver,
+if any. See also the [Condition.SetOperatorRules] method.
+*/
+func (r Condition) OperatorRules() (rules OperatorRules) {
+	if r.IsInit() {
+		rules = r.condition.cfg.opr.clone()
+	}
+	return
+}
 
-All other type instances used for the [Condition.Expression] are added to the
-return value as-is in all cases, even if nil.
+/*
+SetOperatorStringer assigns the provided function (fn) as the means by which
+the [Operator] of the receiver is rendered during string representation, in
+place of its String method. This allows individual instances to suit target
+grammars without the need for custom [Operator] types. Specifying nil removes
+the function.
 
-Note that the underlying configuration within any [Condition] or [Condition]-alias
-instance is lost during the transfer, thus the return value cannot easily
-be used in the reverse context, meaning it cannot aid in marshaling a new
-[Condition] or [Condition]-alias instance under ordinary circumstances.
+This is a presentation-only setting: [Condition.IsEqual], [Condition.Operator]
+and other facilities continue to use the String method of the [Operator]. Two
+instances bearing the same [Operator] are therefore considered equal, however
+each renders it. See also [SetComparisonOperatorStrings], which affects all
+instances as well as equality assertion.
 */
-func (r Condition) Unmarshal() (slice []any, err error) {
+func (r Condition) SetOperatorStringer(fn func(Operator) string) Condition {
 	if r.IsInit() {
-		if fn := r.condition.cfg.umf; fn != nil {
-			// use the user-authored closure unmarshaler
-			slice, err = fn()
-		} else {
-			// use default unmarshaler
-			slice, err = r.condition.unmarshalDefault()
+		if !r.getState(ronly) {
+			r.condition.cfg.ops = fn
+			r.condition.cfg.dropString()
 		}
 	}
-
-	return
+	return r
 }
 
 /*
-unmarshalDefault is a private method called by Condition.Unmarshal.
+opString returns the string representation of the [Operator] of the
+receiver, by way of the function set via [Condition.SetOperatorStringer]
+if defined.
 */
-func (r condition) unmarshalDefault() (slice []any, err error) {
-	var nexpr any
-	if s, ok := stackTypeAliasConverter(r.ex); ok {
-		nexpr, err = s.Unmarshal() // unmarshaled stack/stack-alias
-	} else {
-		nexpr = r.ex // orig
+func (r condition) opString() string {
+	if r.cfg.ops != nil {
+		return r.cfg.ops(r.op)
 	}
+	return r.op.String()
+}
 
-	slice = []any{
-		`CONDITION`,
-		r.kw,
-		r.op,
-		nexpr,
+/*
+OperatorContext returns the operator context value set within the receiver,
+if any. See also the [Condition.SetOperatorContext] method.
+*/
+func (r Condition) OperatorContext() (ctx string) {
+	if r.IsInit() {
+		ctx = r.condition.cfg.occ
 	}
-
 	return
 }
 
 /*
-IsEqual returns a Boolean value indicative of the outcome of a recursive
-comparison of all values found within the receiver and input value o.
-
-The parameters of this process are described within the [Stack.IsEqual]
-notes.
-*/
-func (r Condition) IsEqual(o any) (err error) {
-	if r.IsInit() {
-		// handle condition/condition-alias assertion
-		// and exit immediately if it fails due to a
-		// bad type, or uninitialized input for o.
-		if s, ok := conditionTypeAliasConverter(o); ok {
-			if fn := r.condition.cfg.eqf; fn != nil {
-				// use the user-authored closure assertion
-				err = fn(r, o)
-			} else {
-				// use default assertion
-				err = r.condition.isEqual(s.condition)
+checkOperator returns an error if the input [Operator] is unsuitable for
+use by the receiver.
+
+Any [Operator] bearing non-zero string and context values is acceptable,
+except for [ComparisonOperator] values not defined by this package, any
+[Operator] bearing a context other than that required by the receiver (if
+set), any [Operator] not present within the receiver's allowlist (if set),
+as well as any [Operator] not permitted alongside the receiver's keyword
+(if rules are set). See [Condition.SetOperatorContext],
+[Condition.SetPermittedOperators] and [Condition.SetOperatorRules].
+*/
+func (r *condition) checkOperator(op Operator) (err error) {
+	if op == nil {
+		err = errorf("operator value is nil")
+	} else if len(op.Context()) == 0 || len(op.String()) == 0 {
+		err = errorf("%T operator value is zero", op)
+	} else if cop, ok := op.(ComparisonOperator); ok && !cop.Valid() {
+		err = errorf("operator value is bogus")
+	} else if r.cfg != nil && len(r.cfg.occ) > 0 && op.Context() != r.cfg.occ {
+		err = operatorContextErr(op, r.cfg.occ)
+	} else if r.cfg != nil && len(r.cfg.pop) > 0 {
+		err = errorf("%T operator '%s' is not permitted", op, op)
+		for i := 0; i < len(r.cfg.pop) && err != nil; i++ {
+			if op.String() == r.cfg.pop[i].String() &&
+				op.Context() == r.cfg.pop[i].Context() {
+				err = nil
 			}
 		}
 	}
 
+	if err == nil && r.cfg != nil {
+		err = r.cfg.opr.Check(r.kw, op)
+	}
+
 	return
 }
 
-func (r *condition) isEqual(o *condition) error {
-	if r.kw != o.kw {
-		return errorf("Condition keyword mismatch")
-	}
-
-	if r.op.String() != o.op.String() {
-		return errorf("Condition operator mismatch")
+/*
+SetExpression sets the receiver's expression value(s) using the
+specified ex input argument.  See also the [Condition.Expression]
+method.
+*/
+func (r Condition) SetExpression(ex any) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.logCall(`expression`, 1)
+			defer r.condition.cfg.endCall()
+			r.condition.setExpression(ex)
+		}
 	}
+	return r
+}
 
-	if r.op.Context() != o.op.Context() {
-		return errorf("Condition operator (context) mismatch")
+func (r *condition) setExpression(ex any) {
+	if v, ok := r.assertExpression(ex); ok {
+		r.ex = v
+		r.exv = nil
+		r.cfg.dropString()
+		r.cfg.logEvent(LogLevel3, `expression`, -1, nil)
 	}
-
-	iexpr := r.ex
-	jexpr := o.ex
-
-	return valuesEqual(iexpr, jexpr)
 }
 
 /*
-SetLogLevel enables the specified [LogLevel] instance(s), thereby
-instructing the logging subsystem to accept events for submission
-and transcription to the underlying logger.
+AddExpressionValue appends one (1) or more expression values to the
+receiver. Each value is subject to the same checks performed by the
+[Condition.SetExpression] method, including any [PushPolicy].
 
-Users may also sum the desired bit values manually, and cast the
-product as a [LogLevel]. For example, if STATE (4), DEBUG (8) and
-TRACE (32) logging were desired, entering LogLevel(44) would be
-the same as specifying LogLevel3, LogLevel4 and LogLevel6 in
-variadic fashion.
+When more than one (1) value is present, the receiver shall be
+considered multi-valued, and the string representation of its
+expression shall consist of all values joined using the delimiter
+set via [Condition.SetValueDelimiter]. See also the [Condition.Expressions]
+method.
 */
-func (r Condition) SetLogLevel(l ...any) Condition {
+func (r Condition) AddExpressionValue(x ...any) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.condition.setLogLevel(l...)
+			r.condition.cfg.logCall(`add_expression_value`, len(x))
+			defer r.condition.cfg.endCall()
+			r.condition.addExpressionValue(x...)
 		}
 	}
 	return r
 }
 
-func (r *condition) setLogLevel(l ...any) {
-	r.cfg.log.shift(l...)
+/*
+addExpressionValue is a private method called by [Condition.AddExpressionValue].
+*/
+func (r *condition) addExpressionValue(x ...any) {
+	r.cfg.dropString()
+	for i := 0; i < len(x); i++ {
+		v, ok := r.assertExpression(x[i])
+		if !ok {
+			continue
+		}
+
+		if r.ex == nil {
+			r.ex = v
+		} else {
+			if len(r.exv) == 0 {
+				r.exv = []any{r.ex}
+			}
+			r.exv = append(r.exv, v)
+		}
+	}
 }
 
 /*
-LogLevels returns the string representation of a comma-delimited list
-of all active [LogLevel] values within the receiver.
+assertExpression executes the [PushPolicy], if set, followed by the default
+assertion logic, upon the input expression value (ex).
 */
-func (r Condition) LogLevels() (l string) {
-	if r.IsInit() {
-		l = r.condition.logLevels()
+func (r *condition) assertExpression(ex any) (v any, ok bool) {
+	if isPending(ex) {
+		// placeholders are not subject to
+		// policies, nor nesting rules.
+		return ex, true
 	}
-	return
-}
 
-func (r condition) logLevels() (l string) {
-	return r.cfg.log.lvl.String()
+	if meth := r.cfg.ppf; meth != nil {
+		// use the user-provided function to
+		// verify the expression value before
+		// the default assertion logic runs.
+		if err := meth(ex); err != nil {
+			r.setErr(err)
+			return
+		}
+	}
+
+	return r.assertConditionExpressionValue(ex)
 }
 
 /*
-UnsetLogLevel disables the specified [LogLevel] instance(s), thereby
-instructing the logging subsystem to discard events submitted for
-transcription to the underlying logger.
+Expressions returns all expression values stored within the receiver in
+the order in which they were added. A receiver that is not multi-valued
+shall return a single slice containing the [Condition.Expression] value,
+if set.
 */
-func (r Condition) UnsetLogLevel(l ...any) Condition {
+func (r Condition) Expressions() (exs []any) {
 	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.condition.unsetLogLevel(l...)
+		if len(r.condition.exv) > 0 {
+			exs = make([]any, len(r.condition.exv))
+			copy(exs, r.condition.exv)
+		} else if r.condition.ex != nil {
+			exs = []any{r.condition.ex}
 		}
 	}
-	return r
-}
-
-func (r *condition) unsetLogLevel(l ...any) {
-	r.cfg.log.unshift(l...)
+	return
 }
 
 /*
-SetLogger assigns the specified logging facility to the receiver
-instance.
-
-Logging is available but is set to discard all events by default.
-
-An active logging subsystem within the receiver supercedes the
-default package logger.
-
-The following types/values are permitted:
-
-  - string: `none`, `off`, `null`, `discard` will turn logging off
-  - string: `stdout` will set basic STDOUT logging
-  - string: `stderr` will set basic STDERR logging
-  - int: 0 will turn logging off
-  - int: 1 will set basic STDOUT logging
-  - int: 2 will set basic STDERR logging
-  - *[log.Logger]: user-defined *[log.Logger] instance will be set; it should not be nil
+SetValueDelimiter assigns the delimiter used to join the values of a
+multi-valued receiver during string representation. Acceptable input
+types are string and rune. See also the [Condition.AddExpressionValue]
+method.
 
-Case is not significant in the string matching process.
+If unset, a comma (,) is used.
 */
-func (r Condition) SetLogger(logger any) Condition {
+func (r Condition) SetValueDelimiter(x any) Condition {
 	if r.IsInit() {
-		r.condition.setLogger(logger)
+		if !r.getState(ronly) {
+			r.condition.cfg.setListDelimiter(assertListDelimiter(x))
+			r.condition.cfg.dropString()
+		}
 	}
-
 	return r
 }
 
 /*
-Err returns the error residing within the receiver, or nil
-if no error condition has been declared.
-
-This method will be particularly useful for users who do
-not care for fluent-style operations and would instead
-prefer to operate in a more procedural fashion.
-
-Note that a chained sequence of method calls of this type
-shall potentially obscure error conditions along the way,
-as each successive method may happily overwrite any error
-instance already present.
+ValueDelimiter returns the delimiter string value currently set within
+the receiver instance. See also the [Condition.SetValueDelimiter] method.
 */
-func (r Condition) Err() (err error) {
+func (r Condition) ValueDelimiter() (delim string) {
 	if r.IsInit() {
-		err = r.condition.getErr()
+		delim = r.condition.cfg.getListDelimiter()
 	}
 	return
 }
 
 /*
-SetErr sets the underlying error value within the receiver
-to the assigned input value err, whether nil or not.
-
-This method may be most valuable to users who have chosen
-to extend this type by aliasing, and wish to control the
-handling of error conditions in another manner.
+SetPushPolicy assigns the provided [PushPolicy] closure function
+to the receiver, thereby enabling protection against undesired
+expression values. The provided function shall be executed by
+the [Condition.SetExpression] method prior to the default value
+assertion logic. Should the function return a non-nil error, the
+expression value is rejected and the error is written to the
+receiver for inspection via [Condition.Err].
 
-This may be used regardless of [Condition.IsReadOnly] status.
+Specifying nil shall disable this capability if enabled.
 */
-func (r Condition) SetErr(err error) Condition {
+func (r Condition) SetPushPolicy(ppol PushPolicy) Condition {
 	if r.IsInit() {
-		r.condition.setErr(err)
+		if !r.getState(ronly) {
+			r.condition.cfg.ppf = ppol
+		}
 	}
+
 	return r
 }
 
 /*
-setErr assigns an error instance, whether nil or not, to
-the underlying receiver configuration.
+SetEqualityPolicy sets or unsets the [EqualityPolicy] within the receiver
+instance.
+
+When fed an [EqualityPolicy], it shall override the package default mechanism
+beginning at the next call of [Condition.IsEqual].
+
+When fed zero (0) [EqualityPolicy] instances, or a value of nil, the previously
+specified instance will be removed, at which point the default behavior resumes.
 */
-func (r *condition) setErr(err error) {
-	r.cfg.setErr(err)
+func (r Condition) SetEqualityPolicy(fn ...EqualityPolicy) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if len(fn) == 0 {
+				r.condition.cfg.eqf = nil
+			} else {
+				r.condition.cfg.eqf = fn[0]
+			}
+		}
+	}
+
+	return r
 }
 
 /*
-error returns the underlying error instance, whether nil or not.
+SetUnmarshaler sets or unsets the [Unmarshaler] within the receiver
+instance.
+
+When fed an [Unmarshaler], it shall override the package default mechanism
+beginning at the next call of [Condition.Unmarshal].
+
+When fed zero (0) [Unmarshaler] instances, or a value of nil, the previously
+specified instance will be removed, at which point the default behavior resumes.
 */
-func (r condition) getErr() error {
-	return r.cfg.getErr()
+func (r Condition) SetUnmarshaler(fn ...Unmarshaler) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if len(fn) == 0 {
+				r.condition.cfg.umf = nil
+			} else {
+				r.condition.cfg.umf = fn[0]
+			}
+		}
+	}
+
+	return r
 }
 
 /*
-assertConditionExpressionValue returns a string value alongside a
-success-indicative Boolean value. This method is used during the
-set-execution of the receiver, and is designed to prevent unwanted
-types from being assigned as the expression value (ex).
-*/
-func (r *condition) assertConditionExpressionValue(x any) (X any, ok bool) {
+SetMarshaler sets or unsets the [Marshaler] within the receiver instance.
 
-	switch tv := x.(type) {
-	case string:
-		if len(tv) > 0 {
-			X = tv
+When fed a [Marshaler], it shall override the package default mechanism
+beginning at the next call of [Condition.Marshal].
+
+When fed zero (0) [Marshaler] instances, or a value of nil, the previously
+specified instance will be removed, at which point the default behavior resumes.
+*/
+func (r Condition) SetMarshaler(fn ...Marshaler) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if len(fn) == 0 {
+				r.condition.cfg.maf = nil
+			} else {
+				r.condition.cfg.maf = fn[0]
+			}
 		}
-	default:
-		X = r.defaultAssertionExpressionHandler(x)
 	}
 
-	ok = X != nil && !r.cfg.isError()
-	return
+	return r
 }
 
 /*
-defaultAssertionExpressionHandler is the catch-all private method called
-by condition.assertConditionExpressionValue.
+Marshal returns an error following an attempt to read the variadic 'in'
+value(s) into the receiver instance. The appropriate input for this method
+is the output produced by [Condition.Unmarshal], e.g.:
+
+	[]any{`CONDITION`, keyword, operator, expression}
+
+A nested []any expression value is marshaled into a [Stack] (or [Condition])
+by way of the same mechanism used by [Stack.Marshal]. An uninitialized
+receiver is initialized automatically.
+
+This method is intended for generalized use, and may be overridden using
+the [Condition.SetMarshaler] method.
 */
-func (r condition) defaultAssertionExpressionHandler(x any) (X any) {
-	// no push policy, so we'll see if the basic
-	// guidelines were satisfied, at least ...
-	if _, ok := stackTypeAliasConverter(x); ok {
-		if r.positive(nnest) {
-			return
-		}
+func (r *Condition) Marshal(in ...any) (err error) {
+	if len(in) == 0 {
+		err = wrapErr(ErrMarshalInput, "Empty marshaler input")
+		return
+	} else if !r.IsInit() {
+		r.Init()
+	} else if r.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot marshal", *r)
+		return
+	}
 
-		// a user-created type alias of Stack
-		// was converted back to Stack without
-		// any issues ...
-		X = x
+	if fn := r.condition.cfg.maf; fn != nil {
+		// use the user-authored closure marshaler
+		err = fn(in...)
 	} else {
-		X = x
+		// use default marshaler
+		err = r.condition.marshalDefault(in, false)
 	}
 
 	return
 }
 
 /*
-setCategory assigns the provided categorical label string value
-to the underlying configuration.
+marshalDefault is a private method called by [Condition.Marshal] and
+extractConditionValues. The input is read into the receiver, and any
+[MarshalSettings] instance following the label is applied.
 */
-func (r *condition) setCategory(cat string) {
-	r.cfg.cat = cat
+func (r *condition) marshalDefault(in []any, strict bool) (err error) {
+	// De-envelope needlessly enveloped value
+	in = deenvelopeSingleStack(in)
+
+	if lab, ok := in[0].(string); !ok || uc(lab) != `CONDITION` {
+		err = wrapErr(ErrMarshalInput, "Cannot marshal without condition label")
+		return
+	}
+
+	// extract any settings, which
+	// follow the label.
+	var ms MarshalSettings
+	if len(in) == 5 {
+		if ms, _ = in[1].(MarshalSettings); ms != nil {
+			in = append([]any{in[0]}, in[2:]...)
+		}
+	}
+
+	if len(in) != 4 {
+		err = wrapErr(ErrMarshalInput, "Malformed condition input; want 4 elements, got %d", len(in))
+		return
+	}
+
+	var op Operator
+	if O, ok := in[2].(Operator); ok {
+		op = O
+	}
+
+	var ex any = in[3]
+	if E, ok := in[3].([]any); ok {
+		var xm Stack
+		var xn Condition
+		if xm, xn, err = marshalDefault(E, strict); xm.IsInit() {
+			ex = xm
+		} else if xn.IsInit() {
+			ex = xn
+		}
+	}
+
+	r.setKeyword(in[1]) // string or stringer; see Cond
+	r.setOperator(op)
+	r.setExpression(ex)
+
+	if ms != nil {
+		r.cfg.applyMarshalSettings(ms)
+	}
+
+	return
 }
 
 /*
-getCat returns the categorical label string value assigned to
-the underlying configuration, or a zero string if unset.
+Unmarshal returns an instance of []any containing the unmarshaled instance
+of the receiver. This can be used for use in deep inspections of [Condition]
+or [Condition]-alias instances.
+
+Those instances that contain complex nested [Stack] or [Stack]-alias instances
+specified as the [Condition.Expression] will have those instances unmarshaled
+by way of the [Stack.Unmarshal] method.
+
+All other type instances used for the [Condition.Expression] are added to the
+return value as-is in all cases, even if nil.
+
+Note that the underlying configuration within any [Condition] or [Condition]-alias
+instance is lost during the transfer, though the keyword, [Operator] and
+expression value(s) may be marshaled into a new [Condition] instance by way
+of the [Condition.Marshal] method.
 */
-func (r condition) getCat() string {
-	return r.cfg.cat
+func (r Condition) Unmarshal() (slice []any, err error) {
+	if r.IsInit() {
+		if fn := r.condition.cfg.umf; fn != nil {
+			// use the user-authored closure unmarshaler
+			slice, err = fn()
+		} else {
+			// use default unmarshaler
+			slice, err = r.condition.unmarshalDefault(false)
+		}
+	}
+
+	return
 }
 
 /*
-SetCategory assigns the provided string to the receiver internal category
-value. This allows for a means of identifying a particular kind of [Condition]
-in the midst of many.
+unmarshalDefault is a private method called by Condition.Unmarshal. If
+cfg is true, the presentation settings of the receiver (and of any [Stack]
+expression) are included. See [Stack.SetUnmarshalWithConfig].
 */
-func (r Condition) SetCategory(cat string) Condition {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.condition.setCategory(cat)
+func (r condition) unmarshalDefault(cfg bool) (slice []any, err error) {
+	var nexpr any
+	if len(r.exv) > 0 {
+		// multi-valued expression
+		nexprs := make([]any, len(r.exv))
+		for i := 0; i < len(r.exv) && err == nil; i++ {
+			nexprs[i], err = unmarshalExpression(r.exv[i], cfg)
 		}
+		nexpr = nexprs
+	} else {
+		nexpr, err = unmarshalExpression(r.ex, cfg)
 	}
-	return r
+
+	slice = []any{`CONDITION`}
+	if cfg {
+		slice = append(slice, r.cfg.marshalSettings())
+	}
+	slice = append(slice, r.keywordValue(), r.op, nexpr)
+
+	return
 }
 
 /*
-Category returns the categorical label string value assigned to the receiver, if
-set, else a zero string.
+unmarshalExpression returns the unmarshaled form of the expression value (x)
+if it is a [Stack] or [Stack]-alias, else x is returned as-is. If cfg is true,
+presentation settings are included.
 */
-func (r Condition) Category() (cat string) {
-	if r.IsInit() {
-		cat = r.condition.getCat()
+func unmarshalExpression(x any, cfg bool) (nexpr any, err error) {
+	if s, ok := stackTypeAliasConverter(x); ok {
+		if sc, _ := s.config(); cfg && sc != nil && sc.umf == nil {
+			nexpr, err = s.stack.unmarshalDefault(cfg)
+		} else {
+			nexpr, err = s.Unmarshal() // unmarshaled stack/stack-alias
+		}
+	} else {
+		nexpr = x // orig
 	}
+
 	return
 }
 
 /*
-Cond returns an instance of [Condition] bearing the provided component values.
-This is intended to be used in situations where a [Condition] instance can be
-created in one shot.
+IsEqual returns a Boolean value indicative of the outcome of a recursive
+comparison of all values found within the receiver and input value o.
+
+The parameters of this process are described within the [Stack.IsEqual]
+notes.
 */
-func Cond(kw any, op Operator, ex any) (c Condition) {
-	c = Condition{newCondition(kw, op, ex)}
-	if err := c.Valid(); err != nil {
-		c.SetErr(err)
+func (r Condition) IsEqual(o any) (err error) {
+	if r.IsInit() {
+		// handle condition/condition-alias assertion
+		// and exit immediately if it fails due to a
+		// bad type, or uninitialized input for o.
+		if s, ok := conditionTypeAliasConverter(o); ok {
+			if fn := r.condition.cfg.eqf; fn != nil {
+				// use the user-authored closure assertion
+				err = fn(r, o)
+			} else {
+				// use default assertion
+				err = r.condition.isEqual(s.condition, false)
+			}
+		}
 	}
+
 	return
 }
 
+func (r *condition) isEqual(o *condition, fold bool) error {
+	fold = fold || r.positive(kfold)
+	if !r.matchesKeyword(o.kw, fold) {
+		return wrapErr(ErrEqualityMismatch, "Condition keyword mismatch")
+	}
+
+	if r.op.String() != o.op.String() {
+		return wrapErr(ErrEqualityMismatch, "Condition operator mismatch")
+	}
+
+	if r.op.Context() != o.op.Context() {
+		return wrapErr(ErrEqualityMismatch, "Condition operator (context) mismatch")
+	}
+
+	if len(r.exv) != len(o.exv) {
+		return wrapErr(ErrEqualityMismatch, "Condition expression value count mismatch")
+	}
+
+	for i := 0; i < len(r.exv); i++ {
+		if err := foldEqual(r.exv[i], o.exv[i], fold); err != nil {
+			return err
+		}
+	}
+
+	iexpr := r.ex
+	jexpr := o.ex
+
+	return foldEqual(iexpr, jexpr, fold)
+}
+
 /*
-Init will [re-]initialize the receiver's contents and return them to an unset,
-but assignable, state. This is a destructive method: the embedded pointer within
-the receiver instance shall be totally annihilated.
+SetFoldKeywordComparison sets the keyword folding bit within the receiver.
+When set, the keywords of the receiver and of the [Condition] with which
+it is compared by [Condition.IsEqual] are compared case-insensitively. The
+same applies to any [Condition] instances nested within the expressions of
+either.
 
-This method is niladic and fluent in nature. No input is required, and the only
-element returned is the receiver itself.
+By default, keywords are compared case-sensitively. See also the
+[Stack.SetFoldKeywordComparison] method.
 
-This method may be useful in situations where a [Condition] will be assembled in a
-"piecemeal" fashion (i.e.: incrementally), or if a [Condition] instance is slated to
-be repurposed for use elsewhere (possibly in a repetative manner).
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the folding bit (i.e.: true->false and
+false->true)
 */
-func (r *Condition) Init() Condition {
-	*r = Condition{condition: initCondition()}
-	return *r
+func (r Condition) SetFoldKeywordComparison(state ...bool) Condition {
+	r.setState(kfold, state...)
+	return r
 }
 
 /*
-Free frees the receiver instance entirely, including the underlying
-configuration. An error is returned if the instance is read-only and
-cannot be freed.
+MatchesKeyword returns a Boolean value indicative of whether the input
+keyword (kw) matches the keyword of the receiver. A Boolean input value
+of true results in a case-insensitive comparison, while false results in
+a case-sensitive comparison. If no Boolean input value is specified, the
+state of the bit set via [Condition.SetFoldKeywordComparison] is used.
 */
-func (r *Condition) Free() (err error) {
+func (r Condition) MatchesKeyword(kw string, fold ...bool) (match bool) {
+	if r.IsInit() {
+		f := r.getState(kfold)
+		if len(fold) > 0 {
+			f = fold[0]
+		}
+		match = r.condition.matchesKeyword(kw, f)
+	}
+	return
+}
+
+/*
+matchesKeyword is a private method called by [Condition.MatchesKeyword]
+and condition.isEqual.
+*/
+func (r *condition) matchesKeyword(kw string, fold bool) bool {
+	if fold {
+		return eq(r.kw, kw)
+	}
+	return r.kw == kw
+}
+
+/*
+SetLogLevel enables the specified [LogLevel] instance(s), thereby
+instructing the logging subsystem to accept events for submission
+and transcription to the underlying logger.
+
+Users may also sum the desired bit values manually, and cast the
+product as a [LogLevel]. For example, if STATE (4), DEBUG (8) and
+TRACE (32) logging were desired, entering LogLevel(44) would be
+the same as specifying LogLevel3, LogLevel4 and LogLevel6 in
+variadic fashion.
+*/
+func (r Condition) SetLogLevel(l ...any) Condition {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.condition = nil
-			return
+			r.condition.setLogLevel(l...)
 		}
-		err = errorf("%T is read-only; cannot free", r)
 	}
+	return r
+}
+
+func (r *condition) setLogLevel(l ...any) {
+	r.cfg.log.shift(l...)
+}
+
+/*
+LogLevels returns the string representation of a comma-delimited list
+of all active [LogLevel] values within the receiver.
+*/
+func (r Condition) LogLevels() (l string) {
+	if r.IsInit() {
+		l = r.condition.logLevels()
+	}
+	return
+}
+
+func (r condition) logLevels() (l string) {
+	return r.cfg.log.lvl.String()
+}
+
+/*
+UnsetLogLevel disables the specified [LogLevel] instance(s), thereby
+instructing the logging subsystem to discard events submitted for
+transcription to the underlying logger.
+*/
+func (r Condition) UnsetLogLevel(l ...any) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.unsetLogLevel(l...)
+		}
+	}
+	return r
+}
+
+func (r *condition) unsetLogLevel(l ...any) {
+	r.cfg.log.unshift(l...)
+}
+
+/*
+SetLogger assigns the specified logging facility to the receiver
+instance.
+
+Logging is available but is set to discard all events by default.
+
+An active logging subsystem within the receiver supercedes the
+default package logger.
+
+The following types/values are permitted:
+
+  - string: `none`, `off`, `null`, `discard` will turn logging off
+  - string: `stdout` will set basic STDOUT logging
+  - string: `stderr` will set basic STDERR logging
+  - int: 0 will turn logging off
+  - int: 1 will set basic STDOUT logging
+  - int: 2 will set basic STDERR logging
+  - *[log.Logger]: user-defined *[log.Logger] instance will be set; it should not be nil
+  - *[slog.Logger]: user-defined *[slog.Logger] instance will be set, receiving structured events
+  - [slog.Handler]: user-defined [slog.Handler] instance will be wrapped in a new *[slog.Logger]
+
+Case is not significant in the string matching process.
+
+Structured events bear the id, kind, op (operation name), index and
+error (if any) fields, and use the operation name as the message.
+*/
+func (r Condition) SetLogger(logger any) Condition {
+	if r.IsInit() {
+		r.condition.setLogger(logger)
+	}
+
+	return r
+}
+
+/*
+Err returns the error residing within the receiver, or nil
+if no error condition has been declared.
+
+This method will be particularly useful for users who do
+not care for fluent-style operations and would instead
+prefer to operate in a more procedural fashion.
+
+Note that a chained sequence of method calls of this type
+shall potentially obscure error conditions along the way,
+as each successive method may happily overwrite any error
+instance already present.
+*/
+func (r Condition) Err() (err error) {
+	if r.IsInit() {
+		err = r.condition.getErr()
+	}
+	return
+}
+
+/*
+SetErr sets the underlying error value within the receiver
+to the assigned input value err, whether nil or not.
+
+This method may be most valuable to users who have chosen
+to extend this type by aliasing, and wish to control the
+handling of error conditions in another manner.
+
+This may be used regardless of [Condition.IsReadOnly] status.
+*/
+func (r Condition) SetErr(err error) Condition {
+	if r.IsInit() {
+		r.condition.setErr(err)
+	}
+	return r
+}
+
+/*
+SetErrorAccumulation enables (true) or disables (false) the accumulation
+of errors within the receiver, as described by [Stack.SetErrorAccumulation].
+The history is retrieved using [Condition.Errs].
+
+Execution without a Boolean input value will *TOGGLE* the current state
+of accumulation.
+*/
+func (r Condition) SetErrorAccumulation(state ...bool) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.setAccumulation(state...)
+		}
+	}
+	return r
+}
+
+/*
+IsAccumulatingErrors returns a Boolean value indicative of whether error
+accumulation is enabled within the receiver.
+*/
+func (r Condition) IsAccumulatingErrors() (is bool) {
+	if r.IsInit() {
+		is = r.condition.cfg.erh != nil && r.condition.cfg.erh.on
+	}
+	return
+}
+
+/*
+SetErrorHistorySize assigns the maximum number of errors retained within
+the error history of the receiver, as described by [Stack.SetErrorHistorySize].
+*/
+func (r Condition) SetErrorHistorySize(n int) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if n <= 0 {
+				n = defaultErrHistorySize
+			}
+			r.condition.cfg.history().resize(n)
+		}
+	}
+	return r
+}
+
+/*
+Errs returns the errors accumulated within the receiver, from oldest to
+newest. Each error is an instance of [ErrorRecord].
+*/
+func (r Condition) Errs() (errs []error) {
+	if r.IsInit() {
+		errs = r.condition.cfg.erh.list()
+	}
+	return
+}
+
+/*
+ClearErrs discards all errors accumulated within the receiver. The error
+returned by [Condition.Err] is not affected.
+
+This may be used regardless of [Condition.IsReadOnly] status.
+*/
+func (r Condition) ClearErrs() Condition {
+	if r.IsInit() {
+		r.condition.cfg.erh.clear()
+	}
+	return r
+}
+
+/*
+setErr assigns an error instance, whether nil or not, to
+the underlying receiver configuration.
+*/
+func (r *condition) setErr(err error) {
+	r.cfg.setErr(err)
+}
+
+/*
+error returns the underlying error instance, whether nil or not.
+*/
+func (r condition) getErr() error {
+	return r.cfg.getErr()
+}
+
+/*
+assertConditionExpressionValue returns a string value alongside a
+success-indicative Boolean value. This method is used during the
+set-execution of the receiver, and is designed to prevent unwanted
+types from being assigned as the expression value (ex).
+*/
+func (r *condition) assertConditionExpressionValue(x any) (X any, ok bool) {
+
+	switch tv := x.(type) {
+	case string:
+		if len(tv) > 0 {
+			X = tv
+		}
+	default:
+		X = r.defaultAssertionExpressionHandler(x)
+	}
+
+	ok = X != nil && !r.cfg.isError()
+	return
+}
+
+/*
+defaultAssertionExpressionHandler is the catch-all private method called
+by condition.assertConditionExpressionValue.
+*/
+func (r condition) defaultAssertionExpressionHandler(x any) (X any) {
+	// no push policy, so we'll see if the basic
+	// guidelines were satisfied, at least ...
+	if _, ok := stackTypeAliasConverter(x); ok {
+		if r.positive(nnest) {
+			return
+		}
+
+		// a user-created type alias of Stack
+		// was converted back to Stack without
+		// any issues ...
+		X = x
+	} else {
+		X = x
+	}
+
+	return
+}
+
+/*
+setCategory assigns the provided categorical label string value
+to the underlying configuration.
+*/
+func (r *condition) setCategory(cat string) {
+	r.cfg.cat = cat
+}
+
+/*
+getCat returns the categorical label string value assigned to
+the underlying configuration, or a zero string if unset.
+*/
+func (r condition) getCat() string {
+	return r.cfg.cat
+}
+
+/*
+SetCategory assigns the provided string to the receiver internal category
+value. This allows for a means of identifying a particular kind of [Condition]
+in the midst of many.
+*/
+func (r Condition) SetCategory(cat string) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.setCategory(cat)
+		}
+	}
+	return r
+}
+
+/*
+Category returns the categorical label string value assigned to the receiver, if
+set, else a zero string.
+*/
+func (r Condition) Category() (cat string) {
+	if r.IsInit() {
+		cat = r.condition.getCat()
+	}
+	return
+}
+
+/*
+Cond returns an instance of [Condition] bearing the provided component values.
+This is intended to be used in situations where a [Condition] instance can be
+created in one shot.
+*/
+func Cond(kw any, op Operator, ex any) (c Condition) {
+	c = Condition{newCondition(kw, op, ex)}
+	if err := c.Valid(); err != nil {
+		c.SetErr(err)
+	}
+	return
+}
+
+/*
+Init will [re-]initialize the receiver's contents and return them to an unset,
+but assignable, state. This is a destructive method: the embedded pointer within
+the receiver instance shall be totally annihilated.
+
+This method is niladic and fluent in nature. No input is required, and the only
+element returned is the receiver itself.
+
+This method may be useful in situations where a [Condition] will be assembled in a
+"piecemeal" fashion (i.e.: incrementally), or if a [Condition] instance is slated to
+be repurposed for use elsewhere (possibly in a repetative manner).
+*/
+func (r *Condition) Init() Condition {
+	*r = Condition{condition: initCondition()}
+	return *r
+}
+
+/*
+Free frees the receiver instance entirely, including the underlying
+configuration. An error is returned if the instance is read-only and
+cannot be freed.
+
+The [Auxiliary] map and all closures (policies, stringers and the like)
+held by the configuration are released, such that any values they
+reference may be reclaimed. Note that this affects all references to
+the same underlying instance. See also [Stack.FreeDeep].
+*/
+func (r *Condition) Free() (err error) {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.release()
+			r.condition = nil
+			return
+		}
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot free", r)
+	}
+
+	return
+}
+
+/*
+SetID assigns the provided string value (or lack thereof) to the receiver.
+This is optional, and is usually only needed in complex [Condition] structures
+in which "labeling" certain components may be advantageous. It has no effect
+on an evaluation, nor should a name ever cause a validity check to fail.
+
+If the string `_random` is provided, a 24-character alphanumeric string is
+randomly generated using math/rand and assigned as the ID. If a prefix was
+set using [Condition.SetIDPrefix], the ID shall take the form "prefix-<random>".
+
+If the string `_addr` is provided, the ID shall always reflect the current
+pointer address of the receiver, as returned by [Condition.Addr].
+*/
+func (r Condition) SetID(id string) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.setID(id)
+		}
+	}
+	return r
+}
+
+/*
+SetIDPrefix assigns the provided prefix to the receiver, which shall be
+applied to any random ID subsequently generated through the use of the
+`_random` keyword with [Condition.SetID]. Specifying a zero string removes
+the prefix. IDs already assigned are not modified.
+*/
+func (r Condition) SetIDPrefix(prefix string) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.idp = prefix
+		}
+	}
+	return r
+}
+
+/*
+IDPrefix returns the prefix applied to random IDs generated for the
+receiver, if set. See [Condition.SetIDPrefix].
+*/
+func (r Condition) IDPrefix() (prefix string) {
+	if r.IsInit() {
+		prefix = r.condition.cfg.idp
+	}
+	return
+}
+
+/*
+Config returns a [ConditionConfig] snapshot of the receiver's
+configuration. See [Stack.Config].
+*/
+func (r Condition) Config() (snap ConditionConfig) {
+	if r.IsInit() {
+		snap = r.condition.cfg.conditionConfig(r.Addr())
+	}
+	return
+}
+
+/*
+Len returns a "perceived" abstract length relating to the content (or lack
+thereof) assigned to the receiver instance:
+
+  - An uninitialized or zero instance returns zero (0)
+  - An initialized instance with no [Condition.Expression] assigned (nil) returns zero (0)
+  - A [Stack] or [Stack] type alias assigned as the [Condition.Expression] shall impose its own length as the return value (even if zero (0))
+
+A multi-valued receiver (see [Condition.AddExpressionValue]) shall return the
+number of values present.
+
+All other type instances assigned as an [Condition.Expression] shall result in a
+return of one (1); this includes slice types, maps, arrays and any other
+type that supports multiple values, unless element counting is enabled. See
+[Condition.SetCountElements].
+
+This capability was added to this type to mirror that of the [Stack] type in
+order to allow additional functionality to be added to the [Interface] interface.
+*/
+func (r Condition) Len() int {
+	if !r.IsInit() {
+		return 0
+	}
+
+	if r.Expression() == nil {
+		return 0
+	}
+
+	if n := len(r.condition.exv); n > 0 {
+		return n
+	}
+
+	if stk, ok := r.ExpressionAsStack(); ok {
+		return stk.Len()
+	}
+
+	if r.condition.cfg.cel {
+		return r.condition.expressionLen()
+	}
+
+	return 1
+}
+
+/*
+SetCountElements sets the element counting setting within the receiver.
+When enabled, [Condition.Len] returns the number of elements present
+within a slice, array or map expression value, rather than one (1). The
+length of all other expression values is unaffected.
+
+This setting is disabled by default. See also [Condition.ExpressionLen].
+
+A Boolean input value explicitly sets the state as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state (i.e.: true->false and false->true)
+*/
+func (r Condition) SetCountElements(state ...bool) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			cfg := r.condition.cfg
+			if len(state) > 0 {
+				cfg.cel = state[0]
+			} else {
+				cfg.cel = !cfg.cel
+			}
+		}
+	}
+	return r
+}
+
+/*
+IsCountElements returns a Boolean value indicative of whether element
+counting is enabled within the receiver. See [Condition.SetCountElements].
+*/
+func (r Condition) IsCountElements() (is bool) {
+	if r.IsInit() {
+		is = r.condition.cfg.cel
+	}
+	return
+}
+
+/*
+ExpressionLen returns the length of the receiver's expression value as
+[Condition.Len] does when element counting is enabled, regardless of
+whether it is. See [Condition.SetCountElements].
+*/
+func (r Condition) ExpressionLen() (n int) {
+	if r.IsInit() {
+		n = r.condition.expressionLen()
+	}
+	return
+}
+
+/*
+expressionLen is a private method called by [Condition.Len] and
+[Condition.ExpressionLen]. Pointers to slices, arrays and maps are
+dereferenced.
+*/
+func (r condition) expressionLen() int {
+	if r.ex == nil {
+		return 0
+	} else if n := len(r.exv); n > 0 {
+		return n
+	} else if stk, ok := r.expressionStack(); ok {
+		return stk.Len()
+	}
+
+	if _, rv, rk := derefPtr(assertReflect(r.ex)); sliceOrArrayKind(rk) || rk == reflect.Map {
+		return rv.Len()
+	}
+
+	return 1
+}
+
+/*
+Addr returns the string representation of the pointer
+address for the receiver. This may be useful for logging
+or debugging operations.
+
+Note: this method calls [fmt.Sprintf].
+*/
+func (r Condition) Addr() (addr string) {
+	if r.IsInit() {
+		p := r.condition
+		addr = sprintf("%p", p)
+	}
+	return
+}
+
+/*
+Name returns the name of the receiver instance, if set, else a zero string
+will be returned. The presence or lack of a name has no effect on any of
+the receiver's mechanics, and is strictly for convenience.
+*/
+func (r Condition) ID() (id string) {
+	if r.IsInit() {
+		id = r.condition.cfg.getID(r.Addr())
+	}
+	return
+}
+
+/*
+IsInit will verify that the internal pointer instance of the receiver has
+been properly initialized. This method executes a preemptive execution of
+the [Condition.IsZero] method.
+*/
+func (r Condition) IsInit() (is bool) {
+	if !r.IsZero() {
+		is = r.condition.isInit()
+	}
+	return
+}
+
+/*
+isInit is a private method called by Condition.IsInit.
+*/
+func (r *condition) isInit() bool {
+	return r.cfg != nil && r.cfg.typ == cond
+}
+
+/*
+free is a private method called by stack.freeDeep. The expression value(s)
+of the receiver are cleared, and its configuration released and discarded,
+thus rendering it uninitialized.
+*/
+func (r *condition) free() {
+	r.ex, r.exv = nil, nil
+	r.cfg.release()
+	*r = condition{}
+}
+
+/*
+IsZero returns a Boolean value indicative of whether the receiver is nil,
+or unset.
+*/
+func (r Condition) IsZero() bool {
+	return r.condition == nil
+}
+
+/*
+Valid returns an instance of error, identifying any serious issues perceived
+with the state of the receiver.
+
+Non-serious (interim) errors such as denied pushes, capacity violations, etc.,
+are not shown by this method.
+
+If a [ValidityPolicy] was set within the receiver, it shall be executed here.
+If no [ValidityPolicy] was specified, only elements pertaining to basic viability
+are checked.
+*/
+func (r Condition) Valid() (err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "condition instance is nil")
+		return
+	}
+
+	// if a validitypolicy was provided, use it
+	if r.condition.cfg.vpf != nil {
+		err = r.condition.cfg.vpf(r)
+		return
+	}
+
+	// no validity policy was provided, just check
+	// what we can.
+
+	// verify keyword
+	if kw := r.Keyword(); len(kw) == 0 {
+		err = errorf("keyword value is zero")
+		return
+	}
+
+	// verify operator
+	if err = r.condition.checkOperator(r.Operator()); err != nil {
+		return
+	}
+
+	// verify expression value
+	if ex := r.Expression(); ex == nil {
+		err = errorf("expression value is nil")
+	} else if isPending(ex) && !r.condition.cfg.apd {
+		err = wrapErr(ErrPendingExpression, "pending expressions are not permitted")
+	}
+
+	return
+}
+
+/*
+Evaluate uses the [Evaluator] closure function to apply the value (x)
+to the receiver in order to conduct a matching/assertion test or
+analysis for some reason. This is entirely up to the user.
+
+An expression value is returned alongside an error.
+
+If an instance of [Evaluator] was not assigned to the [Condition] prior
+to execution of this method, a best-effort comparison of a single value
+(x) against the [Condition.Expression] value is conducted using the
+[ComparisonOperator] in effect, and a Boolean result is returned:
+
+  - [Eq] and [Ne] assert equality, as with [Stack.IsEqual]
+  - [Lt], [Le], [Gt] and [Ge] compare string values lexicographically
+  - All operators compare number primitives numerically, though complex
+    numbers may only be compared using [Eq] and [Ne]
+
+For example, evaluating 21 against a [Condition] of "age >= 18" returns
+true. An error is returned if the values are incomparable, if the
+expression is a [Stack] or [Condition], or if the [Operator] is not a
+[ComparisonOperator], in which case an [Evaluator] must be set.
+*/
+func (r Condition) Evaluate(x ...any) (ev any, err error) {
+	if r.IsInit() {
+		if r.IsPending() {
+			err = wrapErr(ErrPendingExpression, "cannot evaluate %T", r)
+		} else if r.cfg.evl != nil {
+			ev, err = r.cfg.evl(x...)
+		} else {
+			ev, err = r.condition.evaluateDefault(x...)
+		}
+	}
+
+	return
+}
+
+/*
+evaluateDefault is a private method called by [Condition.Evaluate] when
+no [Evaluator] has been set.
+*/
+func (r condition) evaluateDefault(x ...any) (ev bool, err error) {
+	cop, ok := r.op.(ComparisonOperator)
+	if !ok {
+		err = errorf("%T operator '%s' requires an Evaluator; see Condition.SetEvaluator", r.op, r.op)
+		return
+	} else if len(x) != 1 {
+		err = errorf("default evaluation requires exactly one (1) value; got %d", len(x))
+		return
+	}
+
+	if _, ok = r.expressionStack(); ok {
+		err = errorf("cannot evaluate %T expression without an Evaluator", r.ex)
+		return
+	} else if _, ok = conditionTypeAliasConverter(r.ex); ok {
+		err = errorf("cannot evaluate %T expression without an Evaluator", r.ex)
+		return
+	}
+
+	var c int
+	if isNumberPrimitive(x[0]) && isNumberPrimitive(r.ex) {
+		c, err = compareNumbers(x[0], r.ex, cop != Eq && cop != Ne)
+	} else if cop == Eq || cop == Ne {
+		if valuesEqual(x[0], r.ex) != nil {
+			c = 1
+		}
+	} else if xs, xok := x[0].(string); xok && isStringPrimitive(r.ex) {
+		c = scmp(xs, r.ex.(string))
+	} else {
+		err = errorf("incomparable types %T and %T for operator '%s'", x[0], r.ex, cop)
+	}
+
+	if err == nil {
+		switch cop {
+		case Eq:
+			ev = c == 0
+		case Ne:
+			ev = c != 0
+		case Lt:
+			ev = c < 0
+		case Le:
+			ev = c <= 0
+		case Gt:
+			ev = c > 0
+		case Ge:
+			ev = c >= 0
+		}
+	}
+
+	return
+}
+
+/*
+SetEvaluator assigns the instance of [Evaluator] to the receiver. This
+will allow the [Condition.Evaluate] method to return a more meaningful
+result.
+
+Specifying nil shall disable this capability if enabled.
+*/
+func (r Condition) SetEvaluator(x Evaluator) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.evl = x
+		}
+	}
+	return r
+}
+
+/*
+SetValidityPolicy assigns the instance of [ValidityPolicy] to the receiver.
+This will allow the [Condition.Valid] method to return a more meaningful
+result.
+
+Specifying nil shall disable this capability if enabled.
+*/
+func (r Condition) SetValidityPolicy(x ValidityPolicy) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.vpf = x
+		}
+	}
+
+	return r
+}
+
+/*
+SetPresentationPolicy assigns the instance of [PresentationPolicy] to the receiver.
+This will allow the user to leverage their own "stringer" method for automatic
+use when the [Condition.String] method is called.
+
+Specifying nil shall disable this capability if enabled.
+*/
+func (r Condition) SetPresentationPolicy(x PresentationPolicy) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.rpf = x
+			r.condition.cfg.dropString()
+		}
+	}
+
+	return r
+}
+
+/*
+SetEncap accepts input characters for use in controlled condition value
+encapsulation. Acceptable input types are:
+
+A single string value will be used for both L and R encapsulation.
+
+An instance of []string with two (2) values will be used for L and R
+encapsulation using the first and second slice values respectively.
+
+An instance of []string with only one (1) value is identical to the act of
+providing a single string value, in that both L and R will use the one value.
+*/
+func (r Condition) SetEncap(x ...any) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.setEncap(x...)
+			r.condition.cfg.dropString()
+		}
+	}
+	return r
+}
+
+/*
+Deprecated: Use [Condition.SetEncap].
+*/
+func (r Condition) Encap(x ...any) Condition {
+	return r.SetEncap(x...)
+}
+
+/*
+IsEncap returns a Boolean value indicative of whether value encapsulation
+characters have been set within the receiver.
+*/
+func (r Condition) IsEncap() (is bool) {
+	if r.IsInit() {
+		is = len(r.condition.getEncap()) > 0
+	}
+	return
+}
+
+func (r *condition) getEncap() [][]string {
+	return r.cfg.enc
+}
+
+/*
+EncapChars returns the value encapsulation character pairs configured
+within the receiver, in order of application. See [Stack.EncapChars].
+*/
+func (r Condition) EncapChars() (pairs [][2]string) {
+	if r.IsInit() {
+		pairs = r.condition.cfg.encapChars()
+	}
+	return
+}
+
+/*
+SetNoNesting sets the no-nesting bit within the receiver. If
+set to true, the receiver shall ignore any [Stack] or [Stack]
+type alias instance when assigned using the [Condition.SetExpression]
+method. In such a case, only primitives, etc., shall be honored during
+the [Condition.SetExpression] operation.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the nesting bit (i.e.: true->false and
+false->true)
+*/
+func (r Condition) SetNoNesting(state ...bool) Condition {
+	r.setState(nnest, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Condition.SetNoNesting].
+*/
+func (r Condition) NoNesting(state ...bool) Condition {
+	return r.SetNoNesting(state...)
+}
+
+/*
+CanNest returns a Boolean value indicative of whether
+the no-nesting bit is unset, thereby allowing a [Stack]
+or [Stack] type alias instance to be set as the value.
+
+See also the [Condition.IsNesting] method.
+*/
+func (r Condition) CanNest() (can bool) {
+	if r.IsInit() {
+		can = !r.getState(nnest)
+	}
+	return
+}
+
+/*
+IsNesting returns a Boolean value indicative of whether the
+underlying expression value is either a [Stack] or [Stack] type
+alias. If true, this indicates the expression value descends
+into another hierarchical (nested) context.
+*/
+func (r Condition) IsNesting() (is bool) {
+	if r.IsInit() {
+		is = r.condition.isNesting()
+	}
+	return
+}
+
+/*
+isNesting is a private method called by Condition.IsNesting.
+*/
+func (r condition) isNesting() bool {
+	// If convertible is true, we know the
+	// instance (tv) is a stack alias.
+	_, convertible := r.expressionStack()
+	return convertible
+}
+
+/*
+Depth returns the maximum nesting depth of the expression value of the
+receiver. A receiver bearing an expression value that is not a [Stack]
+(or alias thereof) returns zero (0), while one bearing such a value
+returns one (1) more than the [Stack.Depth] of that value.
+
+As with [Stack.Depth], instances encountered more than once along a
+single path, such as those which contain themselves, are not descended
+again.
+*/
+func (r Condition) Depth() (d int) {
+	if r.IsInit() {
+		d = sliceDepth(r, make(map[*stack]bool))
+	}
+	return
+}
+
+/*
+NestedConditions returns all [Condition] instances found anywhere beneath
+the expression value of the receiver in depth-first order, including those
+residing within nested [Stack] instances and within the expression values
+of other nested [Condition] instances. Instances of [Condition] aliases are
+converted.
+
+Instances encountered more than once along a single path, such as those
+which contain themselves, are returned but not descended again.
+*/
+func (r Condition) NestedConditions() (conds []Condition) {
+	if r.IsInit() {
+		if S, ok := r.ExpressionAsStack(); ok && S.IsInit() {
+			conds = S.stack.nestedConditions(make(map[*stack]bool), conds)
+		}
+	}
+	return
+}
+
+/*
+IsFIFO returns a Boolean value indicative of whether the underlying
+receiver instance's [Condition.Expression] value represents a [Stack]
+(or [Stack] type alias) instance which exhibits First-In-First-Out
+behavior as it pertains to the act of appending and truncating the
+receiver's slices.
+
+A value of false implies that no such [Stack] instance is set as the
+expression, OR that the [Stack] exhibits Last-In-Last-Out behavior,
+which is the default ingress/egress scheme imposed upon instances
+of this type.
+*/
+func (r Condition) IsFIFO() (is bool) {
+	if r.IsInit() {
+		is = r.condition.isFIFO()
+	}
+	return
+}
+
+/*
+isFIFO is a private method called by the Condition.IsFIFO method.
+*/
+func (r condition) isFIFO() (result bool) {
+
+	if stk, ok := r.expressionStack(); ok {
+		result = stk.IsFIFO()
+	}
+
+	return
+}
+
+/*
+SetParen sets the string-encapsulation bit for parenthetical
+expression within the receiver. The receiver shall undergo
+parenthetical encapsulation ( (...) ) during the string
+representation process. Individual string values shall not
+be encapsulated in parenthesis, only the whole (current)
+[Stack] instance.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the encapsulation bit (i.e.: true->false
+and false->true)
+*/
+func (r Condition) SetParen(state ...bool) Condition {
+	r.setState(parens, state...)
+	return r
+}
+
+/*
+SetExpressionParen sets the expression parenthetical setting within the
+receiver. When enabled, the expression value -- or, if multi-valued, the
+complete list of expression values -- is enclosed within parentheses
+during string representation, regardless of the parenthetical state of
+a [Stack] expression value, e.g.:
+
+	keyword = ( a AND b )
+
+Parentheses are applied once. Those of a sole parenthetical [Stack]
+expression value are omitted in favor of the receiver's own, which are
+padded per [Condition.SetNoPadding] or [Condition.SetPaddingStyle].
+
+Note that regardless of this setting, the parentheses of a [Stack]
+expression value are not padded internally if the receiver is not.
+
+A Boolean input value explicitly sets the state as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state (i.e.: true->false and false->true)
+*/
+func (r Condition) SetExpressionParen(state ...bool) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			cfg := r.condition.cfg
+			if len(state) > 0 {
+				cfg.epr = state[0]
+			} else {
+				cfg.epr = !cfg.epr
+			}
+			cfg.dropString()
+		}
+	}
+	return r
+}
+
+/*
+IsExpressionParen returns a Boolean value indicative of whether the
+expression value(s) of the receiver are enclosed within parentheses
+during string representation. See [Condition.SetExpressionParen].
+*/
+func (r Condition) IsExpressionParen() (is bool) {
+	if r.IsInit() {
+		is = r.condition.cfg.epr
+	}
+	return
+}
+
+/*
+Deprecated: Use [Condition.SetParen].
+*/
+func (r Condition) Paren(state ...bool) Condition {
+	return r.SetParen(state...)
+}
+
+/*
+SetFold sets the case-folding bit within the receiver. When set, an
+alphabetic [Operator] string, such as "approx", shall have its case
+folded during string representation in the manner described by the
+[Stack.SetFold] method. Symbolic operators, such as those of the
+[ComparisonOperator] type, are not affected.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the case-folding bit (i.e.: true->false
+and false->true)
+*/
+func (r Condition) SetFold(state ...bool) Condition {
+	r.setState(cfold, state...)
+	return r
+}
+
+/*
+IsFolded returns a Boolean value indicative of whether the case-folding
+bit is set within the receiver. See also the [Condition.SetFold] method.
+*/
+func (r Condition) IsFolded() bool {
+	return r.getState(cfold)
+}
+
+/*
+SetStringCache sets the string caching bit within the receiver. When
+set, the string representation produced by [Condition.String] is
+retained and returned by subsequent calls until a change is made via
+a setter of the receiver, such as [Condition.SetKeyword],
+[Condition.SetOperator], [Condition.SetExpression], [Condition.SetEncap],
+[Condition.SetParen] or [Condition.SetNoPadding].
+
+This is chiefly useful for receivers whose expression is a large [Stack]
+that would otherwise be re-stringified upon every call.
+
+Note that changes made to a [Stack] expression value directly, such as
+through [Stack.Push], are NOT detected, as the receiver has no knowledge
+of them. The cache may be discarded in such cases by re-submitting the
+expression via [Condition.SetExpression], or by disabling and re-enabling
+the cache.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the caching bit (i.e.: true->false and
+false->true)
+*/
+func (r Condition) SetStringCache(state ...bool) Condition {
+	r.setState(scach, state...)
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if !r.getState(scach) {
+				r.condition.cfg.csc = nil
+			} else if r.condition.cfg.csc == nil {
+				r.condition.cfg.csc = new(atomic.Value)
+			}
+		}
+	}
+	return r
+}
+
+/*
+IsStringCached returns a Boolean value indicative of whether the string
+caching bit is set within the receiver. See also the
+[Condition.SetStringCache] method.
+*/
+func (r Condition) IsStringCached() bool {
+	return r.getState(scach)
+}
+
+/*
+IsParen returns a Boolean value indicative of whether the
+receiver is parenthetical.
+*/
+func (r Condition) IsParen() bool {
+	return r.getState(parens)
+}
+
+/*
+SetReadOnly sets the receiver bit 'ronly' to a positive state.
+This will prevent any writes to the receiver or its underlying
+configuration.
+*/
+func (r Condition) SetReadOnly(state ...bool) Condition {
+	r.setState(ronly, state...)
+	return r
+}
+
+/*
+IsReadOnly returns a Boolean value indicative of whether the
+receiver is set as read-only.
+*/
+func (r Condition) IsReadOnly() bool {
+	return r.getState(ronly)
+}
+
+/*
+SetReadOnlyDeep behaves as [Condition.SetReadOnly] does, except that the
+resulting state is also applied recursively to a [Stack] or [Stack] type
+alias expression, as well as to all [Stack] and [Condition] instances
+nested within it.
+
+When no Boolean input value is supplied, the receiver's own state is
+toggled and the outcome is propagated throughout.
+
+See also [Stack.SetReadOnlyDeep] and [Condition.IsReadOnlyDeep].
+*/
+func (r Condition) SetReadOnlyDeep(state ...bool) Condition {
+	if r.IsInit() {
+		ro := !r.getState(ronly)
+		if len(state) > 0 {
+			ro = state[0]
+		}
+
+		r.setState(ronly, ro)
+		if S, ok := r.condition.expressionStack(); ok && S.IsInit() {
+			S.stack.setReadOnlyDeep(ro, visitSet{S.stack: true})
+		}
+	}
+	return r
+}
+
+/*
+IsReadOnlyDeep returns a Boolean value indicative of whether the receiver,
+and any [Stack] expression -- including everything nested within it --
+are all set as read-only.
+*/
+func (r Condition) IsReadOnlyDeep() bool {
+	if !r.getState(ronly) {
+		return false
+	}
+
+	S, ok := r.condition.expressionStack()
+	return !ok || !S.IsInit() || S.stack.isReadOnlyDeep(visitSet{S.stack: true})
+}
+
+func (r Condition) getState(cf cfgFlag) (state bool) {
+	if r.IsInit() {
+		state = r.condition.positive(cf)
+	}
+	return
+}
+
+func (r Condition) setState(cf cfgFlag, state ...bool) {
+	if r.IsInit() {
+		if !r.getState(ronly) || cf == ronly {
+			if len(state) > 0 {
+				if state[0] {
+					r.condition.setOpt(cf)
+				} else {
+					r.condition.unsetOpt(cf)
+				}
+			} else {
+				r.condition.toggleOpt(cf)
+			}
+			r.condition.cfg.dropString()
+		}
+	}
+}
+
+func (r *condition) setLogger(logger any) {
+	r.cfg.log.setLogger(logger)
+}
+
+func (r *condition) toggleOpt(cf cfgFlag) {
+	r.cfg.toggleOpt(cf)
+}
+
+func (r *condition) setOpt(cf cfgFlag) {
+	r.cfg.setOpt(cf)
+}
+
+func (r *condition) unsetOpt(cf cfgFlag) {
+	r.cfg.unsetOpt(cf)
+}
 
-	return
+func (r *condition) positive(cf cfgFlag) bool {
+	return r.cfg.positive(cf)
 }
 
 /*
-SetID assigns the provided string value (or lack thereof) to the receiver.
-This is optional, and is usually only needed in complex [Condition] structures
-in which "labeling" certain components may be advantageous. It has no effect
-on an evaluation, nor should a name ever cause a validity check to fail.
+SetNoPadding sets the no-space-padding bit within the receiver.
+String values within the receiver shall not be padded using
+a single space character (ASCII #32).
 
-If the string `_random` is provided, a 24-character alphanumeric string is
-randomly generated using math/rand and assigned as the ID.
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the quotation bit (i.e.: true->false and
+false->true)
 */
-func (r Condition) SetID(id string) Condition {
+func (r Condition) SetNoPadding(state ...bool) Condition {
+	r.setState(nspad, state...)
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			if lc(id) == `_random` {
-				id = randomID(randIDSize)
-			} else if lc(id) == `_addr` {
-				id = r.Addr()
-			}
-
-			r.condition.cfg.setID(id)
+			r.condition.cfg.pst = nil
 		}
 	}
 	return r
 }
 
 /*
-Len returns a "perceived" abstract length relating to the content (or lack
-thereof) assigned to the receiver instance:
+SetPaddingStyle assigns the [PaddingStyle] bitmask to the receiver, which
+shall control padding of individual components during string representation
+in place of the all-or-nothing [Condition.SetNoPadding] method. The bits
+honored are [PadBeforeOperator], [PadAfterOperator] and [PadInsideParens].
 
-  - An uninitialized or zero instance returns zero (0)
-  - An initialized instance with no [Condition.Expression] assigned (nil) returns zero (0)
-  - A [Stack] or [Stack] type alias assigned as the [Condition.Expression] shall impose its own length as the return value (even if zero (0))
-
-All other type instances assigned as an [Condition.Expression] shall result in a
-return of one (1); this includes slice types, maps, arrays and any other
-type that supports multiple values.
+For example, a style of [PadAfterOperator] alone produces "keyword>= value".
 
-This capability was added to this type to mirror that of the [Stack] type in
-order to allow additional functionality to be added to the [Interface] interface.
+A subsequent execution of [Condition.SetNoPadding] discards the style,
+restoring the legacy padding behavior.
 */
-func (r Condition) Len() int {
-	if !r.IsInit() {
-		return 0
-	}
-
-	if r.Expression() == nil {
-		return 0
+func (r Condition) SetPaddingStyle(style PaddingStyle) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.setPaddingStyle(style)
+			r.condition.cfg.dropString()
+		}
 	}
+	return r
+}
 
-	if stk, ok := stackTypeAliasConverter(r.Expression()); ok {
-		return stk.Len()
-	}
+/*
+Deprecated: Use [Condition.SetNoPadding].
+*/
+func (r Condition) NoPadding(state ...bool) Condition {
+	return r.SetNoPadding(state...)
+}
 
-	return 1
+/*
+IsPadded returns a Boolean value indicative of whether the
+receiver pads its contents with a SPACE char (ASCII #32).
+*/
+func (r Condition) IsPadded() (is bool) {
+	return !r.getState(nspad)
 }
 
 /*
-Addr returns the string representation of the pointer
-address for the receiver. This may be useful for logging
-or debugging operations.
+Expression returns the expression value stored within the receiver, or
+nil if unset. A valid receiver instance MUST always possess a non-nil
+expression value.
 
-Note: this method calls [fmt.Sprintf].
+If the receiver is multi-valued, the first value is returned. See the
+[Condition.Expressions] method to obtain all values.
 */
-func (r Condition) Addr() (addr string) {
+func (r Condition) Expression() (ex any) {
 	if r.IsInit() {
-		p := r.condition
-		addr = sprintf("%p", p)
+		ex = r.condition.ex
 	}
 	return
 }
 
 /*
-Name returns the name of the receiver instance, if set, else a zero string
-will be returned. The presence or lack of a name has no effect on any of
-the receiver's mechanics, and is strictly for convenience.
+ExpressionAsStack returns the expression value stored within the receiver
+as a native [Stack] alongside a Boolean value indicative of success. [Stack]
+type aliases are converted, as with [ConvertStack]. A false Boolean value
+is returned if the expression is not a [Stack] or [Stack] type alias.
 */
-func (r Condition) ID() (id string) {
+func (r Condition) ExpressionAsStack() (S Stack, ok bool) {
 	if r.IsInit() {
-		id = r.condition.cfg.id
+		S, ok = r.condition.expressionStack()
 	}
 	return
 }
 
 /*
-IsInit will verify that the internal pointer instance of the receiver has
-been properly initialized. This method executes a preemptive execution of
-the [Condition.IsZero] method.
+expressionStack is a private method called by [Condition.ExpressionAsStack].
 */
-func (r Condition) IsInit() (is bool) {
-	if !r.IsZero() {
-		is = r.condition.isInit()
-	}
-	return
+func (r condition) expressionStack() (Stack, bool) {
+	return stackTypeAliasConverter(r.ex)
 }
 
 /*
-isInit is a private method called by Condition.IsInit.
+defragged is a private method called by stack.defragReport, and writes
+the defragmented [Stack] expression value (S) back to the receiver, as
+with [Condition.SetExpression]. If the receiver is multi-valued, only
+the first value is replaced.
 */
-func (r *condition) isInit() bool {
-	return r.cfg.typ == cond
+func (r *condition) defragged(S Stack) {
+	if r.positive(ronly) {
+		return
+	} else if len(r.exv) == 0 {
+		r.setExpression(S)
+		return
+	}
+
+	r.ex = S
+	r.exv[0] = S
+	r.cfg.dropString()
 }
 
 /*
-IsZero returns a Boolean value indicative of whether the receiver is nil,
-or unset.
+ExpressionAsCondition returns the expression value stored within the
+receiver as a native [Condition] alongside a Boolean value indicative of
+success. [Condition] type aliases are converted, as with [ConvertCondition].
+A false Boolean value is returned if the expression is not a [Condition] or
+[Condition] type alias.
 */
-func (r Condition) IsZero() bool {
-	return r.condition == nil
+func (r Condition) ExpressionAsCondition() (C Condition, ok bool) {
+	if r.IsInit() {
+		C, ok = conditionTypeAliasConverter(r.condition.ex)
+	}
+	return
 }
 
 /*
-Valid returns an instance of error, identifying any serious issues perceived
-with the state of the receiver.
-
-Non-serious (interim) errors such as denied pushes, capacity violations, etc.,
-are not shown by this method.
-
-If a [ValidityPolicy] was set within the receiver, it shall be executed here.
-If no [ValidityPolicy] was specified, only elements pertaining to basic viability
-are checked.
+SetExpressionStack behaves as [Condition.SetExpression] does when supplied
+with a [Stack], except that an error is returned -- rather than the input
+being silently ignored -- if the receiver is read-only, if the [Stack] is
+not initialized, if nesting has been disabled within the receiver (see
+[Condition.SetNoNesting]) or if the [Stack] was otherwise refused.
 */
-func (r Condition) Valid() (err error) {
+func (r Condition) SetExpressionStack(S Stack) (err error) {
 	if !r.IsInit() {
-		err = errorf("condition instance is nil")
-		return
-	}
-
-	// if a validitypolicy was provided, use it
-	if r.condition.cfg.vpf != nil {
-		err = r.condition.cfg.vpf(r)
-		return
-	}
-
-	// no validity policy was provided, just check
-	// what we can.
-
-	// verify keyword
-	if kw := r.Keyword(); len(kw) == 0 {
-		err = errorf("keyword value is zero")
-		return
-	}
-
-	// verify comparison operator
-	if cop := r.Operator(); cop != nil {
-		if assert, ok := cop.(ComparisonOperator); ok {
-			if !(1 <= int(assert) && int(assert) <= 6) {
-				err = errorf("operator value is bogus")
-				return
+		err = wrapErr(ErrNotInitialized, "condition instance is nil")
+	} else if r.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot set expression", r)
+	} else if !S.IsInit() {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+	} else if r.getState(nnest) {
+		err = errorf("%T does not permit nesting; cannot set %T expression", r, S)
+	} else {
+		r.condition.setExpression(S)
+		if X, ok := r.condition.expressionStack(); !ok || X.stack != S.stack {
+			err = errorf("%T expression refused", S)
+			if cause := r.condition.getErr(); cause != nil {
+				err = errorf("%T expression refused: %v", S, cause)
 			}
 		}
 	}
 
-	// verify expression value
-	if r.Expression() == nil {
-		err = errorf("expression value is nil")
-	}
-
 	return
 }
 
 /*
-Evaluate uses the [Evaluator] closure function to apply the value (x)
-to the receiver in order to conduct a matching/assertion test or
-analysis for some reason. This is entirely up to the user.
-
-An expression value is returned alongside an error. Note that if an
-instance of [Evaluator] was not assigned to the [Condition] prior to
-execution of this method, the return value shall always be false.
+Operator returns the [Operator] interface type instance found within the
+receiver.
 */
-func (r Condition) Evaluate(x ...any) (ev any, err error) {
+func (r Condition) Operator() (op Operator) {
 	if r.IsInit() {
-		if err = errorf("No func/meth found"); r.cfg.evl != nil {
-			ev, err = r.cfg.evl(x...)
-		}
+		op = r.condition.op
 	}
-
 	return
 }
 
 /*
-SetEvaluator assigns the instance of [Evaluator] to the receiver. This
-will allow the [Condition.Evaluate] method to return a more meaningful
-result.
-
-Specifying nil shall disable this capability if enabled.
+ComparisonOperator returns the [ComparisonOperator] assigned to the receiver
+alongside a Boolean value of true. If the receiver's [Operator] is not one
+(1) of the package-provided [ComparisonOperator] constants -- such as when
+a user-defined [Operator] is in use -- zero and false are returned.
 */
-func (r Condition) SetEvaluator(x Evaluator) Condition {
+func (r Condition) ComparisonOperator() (cop ComparisonOperator, ok bool) {
 	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.condition.cfg.evl = x
+		if cop, ok = r.condition.op.(ComparisonOperator); !ok || !cop.Valid() {
+			cop, ok = nco, false
 		}
 	}
-	return r
+	return
 }
 
 /*
-SetValidityPolicy assigns the instance of [ValidityPolicy] to the receiver.
-This will allow the [Condition.Valid] method to return a more meaningful
-result.
+IsEquality returns a Boolean value indicative of whether the receiver's
+[Operator] is [Eq] or [Ne].
+*/
+func (r Condition) IsEquality() bool {
+	cop, _ := r.ComparisonOperator()
+	return cop == Eq || cop == Ne
+}
 
-Specifying nil shall disable this capability if enabled.
+/*
+IsOrdering returns a Boolean value indicative of whether the receiver's
+[Operator] is [Lt], [Le], [Gt] or [Ge].
 */
-func (r Condition) SetValidityPolicy(x ValidityPolicy) Condition {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.condition.cfg.vpf = x
-		}
+func (r Condition) IsOrdering() bool {
+	cop, _ := r.ComparisonOperator()
+	return cop == Lt || cop == Le || cop == Gt || cop == Ge
+}
+
+/*
+OperatorIs returns a Boolean value indicative of whether the receiver's
+[Operator] matches op, as determined by the String and Context methods of
+each. This allows user-defined [Operator] types which represent the same
+operator to be regarded as matching, regardless of their underlying types.
+*/
+func (r Condition) OperatorIs(op Operator) (is bool) {
+	if r.IsInit() && r.condition.op != nil && op != nil {
+		is = r.condition.op.String() == op.String() &&
+			r.condition.op.Context() == op.Context()
 	}
+	return
+}
 
-	return r
+/*
+Keyword returns the Keyword interface type instance found within the
+receiver.
+*/
+func (r Condition) Keyword() (kw string) {
+	if r.IsInit() {
+		kw = r.condition.kw
+	}
+	return
 }
 
 /*
-SetPresentationPolicy assigns the instance of [PresentationPolicy] to the receiver.
-This will allow the user to leverage their own "stringer" method for automatic
-use when the [Condition.String] method is called.
+KeywordValue returns the keyword value originally provided to the receiver,
+such as via [Cond] or [Condition.SetKeyword]. If the keyword was provided as
+a non-string type bearing a String method, that instance is returned as-is.
+Otherwise, the string keyword is returned, as with [Condition.Keyword].
 
-Specifying nil shall disable this capability if enabled.
+A nil value is returned if the receiver is uninitialized.
 */
-func (r Condition) SetPresentationPolicy(x PresentationPolicy) Condition {
+func (r Condition) KeywordValue() (kw any) {
 	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.condition.cfg.rpf = x
-		}
+		kw = r.condition.keywordValue()
 	}
-
-	return r
+	return
 }
 
 /*
-SetEncap accepts input characters for use in controlled condition value
-encapsulation. Acceptable input types are:
+String is a stringer method that returns the string representation
+of the receiver instance. It will only function if the receiver is
+in good standing, and passes validity checks. Otherwise, the marker
+set via [Condition.SetInvalidMarker] is returned.
 
-A single string value will be used for both L and R encapsulation.
+Note that if the underlying expression value is not a known type,
+such as a [Stack] or a Go primitive, this method may be uncertain
+as to what it should do. A bogus string may be returned.
 
-An instance of []string with two (2) values will be used for L and R
-encapsulation using the first and second slice values respectively.
+In such a case, it may be necessary to subvert the default string
+representation behavior demonstrated by instances of this type in
+favor of a custom instance of the [PresentationPolicy] closure type
+for maximum control.
+*/
+func (r Condition) String() (s string) {
+	return r.string(nil)
+}
 
-An instance of []string with only one (1) value is identical to the act of
-providing a single string value, in that both L and R will use the one value.
+/*
+string is a private method called by [Condition.String] and during the
+string representation of a [Stack]. See stack.render regarding seen.
 */
-func (r Condition) SetEncap(x ...any) Condition {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.condition.cfg.setEncap(x...)
-		}
+func (r Condition) string(seen visitSet) (s string) {
+	if r.renderable() {
+		s = r.condition.cachedString(seen)
+	} else if r.IsInit() {
+		s = r.condition.cfg.invalidMarker()
 	}
-	return r
+	return
 }
 
 /*
-Deprecated: Use [Condition.SetEncap].
+renderable returns a Boolean value indicative of whether the receiver
+is valid for the purpose of string representation. Pending expressions
+render whether or not they are permitted.
 */
-func (r Condition) Encap(x ...any) Condition {
-	return r.SetEncap(x...)
+func (r Condition) renderable() bool {
+	err := r.Valid()
+	return err == nil || errors.Is(err, ErrPendingExpression)
 }
 
 /*
-IsEncap returns a Boolean value indicative of whether value encapsulation
-characters have been set within the receiver.
+cachedString returns the cached string representation of the receiver,
+if enabled via [Condition.SetStringCache], else the product of a fresh
+call of condition.string.
 */
-func (r Condition) IsEncap() (is bool) {
-	if r.IsInit() {
-		is = len(r.condition.getEncap()) > 0
+func (r condition) cachedString(seen visitSet) string {
+	if r.cfg.csc == nil {
+		return r.string(seen)
 	}
-	return
-}
 
-func (r *condition) getEncap() [][]string {
-	return r.cfg.enc
+	if p, _ := r.cfg.csc.Load().(*string); p != nil {
+		return *p
+	}
+
+	s := r.string(seen)
+	r.cfg.csc.Store(&s)
+	return s
 }
 
 /*
-SetNoNesting sets the no-nesting bit within the receiver. If
-set to true, the receiver shall ignore any [Stack] or [Stack]
-type alias instance when assigned using the [Condition.SetExpression]
-method. In such a case, only primitives, etc., shall be honored during
-the [Condition.SetExpression] operation.
+string is a stringer method that returns the string representation
+of the receiver instance. See stack.render regarding seen.
+*/
+func (r condition) string(seen visitSet) string {
+	if r.cfg.rpf != nil {
+		return r.cfg.rpf(r)
+	} else if seen == nil && r.cfg.msl > 0 {
+		// only the receiver's own String call
+		// is subject to truncation.
+		return r.truncatedString(r.cfg.msl, r.cfg.truncationMarker())
+	}
 
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the nesting bit (i.e.: true->false and
-false->true)
+	// begin default presentation
+	// handler ...
+	open, head, clos := r.stringParts()
+	return open + head + r.valuesString(seen) + clos
+}
+
+/*
+valuesString returns the string representation of the expression value(s)
+of the receiver, joined using the value delimiter if multi-valued. See
+stack.render regarding seen.
 */
-func (r Condition) SetNoNesting(state ...bool) Condition {
-	r.setState(nnest, state...)
-	return r
+func (r condition) valuesString(seen visitSet) (val string) {
+	if len(r.exv) > 0 {
+		delim := r.valueDelimiter()
+		vals := make([]string, len(r.exv))
+		for i := 0; i < len(r.exv); i++ {
+			vals[i] = r.valueString(r.exv[i], seen)
+		}
+		val = join(vals, delim)
+	} else {
+		val = r.valueString(r.ex, seen)
+	}
+
+	return
 }
 
 /*
-Deprecated: Use [Condition.SetNoNesting].
+hasTypeStringer returns a Boolean value indicative of whether a package-wide
+type stringer was registered for the type of x.
 */
-func (r Condition) NoNesting(state ...bool) Condition {
-	return r.SetNoNesting(state...)
+func hasTypeStringer(x any) (has bool) {
+	_, has = typeStringer(nil, x)
+	return
 }
 
 /*
-CanNest returns a Boolean value indicative of whether
-the no-nesting bit is unset, thereby allowing a [Stack]
-or [Stack] type alias instance to be set as the value.
-
-See also the [Condition.IsNesting] method.
+valueDelimiter returns the string value used to join multiple expression
+values during string representation.
 */
-func (r Condition) CanNest() (can bool) {
-	if r.IsInit() {
-		can = !r.getState(nnest)
+func (r condition) valueDelimiter() string {
+	delim := r.cfg.getListDelimiter()
+	if len(delim) == 0 {
+		delim = `,`
 	}
-	return
+	return delim
 }
 
 /*
-IsNesting returns a Boolean value indicative of whether the
-underlying expression value is either a [Stack] or [Stack] type
-alias. If true, this indicates the expression value descends
-into another hierarchical (nested) context.
+stringParts returns the opening parenthetical characters (if any), the
+keyword and operator (head) and the closing parenthetical characters (if
+any) which surround the expression value(s) of the receiver during string
+representation.
 */
-func (r Condition) IsNesting() (is bool) {
-	if r.IsInit() {
-		is = r.condition.isNesting()
+func (r condition) stringParts() (open, head, clos string) {
+	// Padding defaults to the legacy nspad bit
+	// unless a PaddingStyle was set.
+	lpad := padIf(!r.cfg.positive(nspad))
+	bpad, apad := lpad, lpad
+	if style, ok := r.cfg.paddingStyle(); ok {
+		bpad = padIf(style&PadBeforeOperator != 0)
+		apad = padIf(style&PadAfterOperator != 0)
+	}
+
+	op := r.opString()
+	if r.cfg.positive(cfold) && isAlpha(op) {
+		op = foldValue(true, op)
+	}
+
+	ppad := r.parenPad()
+	head = r.kw + bpad + op + apad
+	if r.cfg.epr {
+		head += `(` + ppad
+		clos = ppad + `)`
+	}
+	if r.cfg.positive(parens) {
+		open, clos = `(`+ppad, clos+ppad+`)`
 	}
+
 	return
 }
 
 /*
-isNesting is a private method called by Condition.IsNesting.
+parenPad returns the padding to be applied inside the parentheses of the
+receiver, as well as those applied per [Condition.SetExpressionParen].
 */
-func (r condition) isNesting() bool {
-	// If convertible is true, we know the
-	// instance (tv) is a stack alias.
-	_, convertible := stackTypeAliasConverter(r.ex)
-	return convertible
+func (r condition) parenPad() string {
+	if style, ok := r.cfg.paddingStyle(); ok {
+		return padIf(style&PadInsideParens != 0)
+	}
+
+	return padIf(!r.cfg.positive(nspad))
 }
 
 /*
-IsFIFO returns a Boolean value indicative of whether the underlying
-receiver instance's [Condition.Expression] value represents a [Stack]
-(or [Stack] type alias) instance which exhibits First-In-First-Out
-behavior as it pertains to the act of appending and truncating the
-receiver's slices.
+stackExpression returns the view of the [Stack] expression value (S) to
+be rendered, alongside the parenthetical characters to be written around
+it in place of those of S.
 
-A value of false implies that no such [Stack] instance is set as the
-expression, OR that the [Stack] exhibits Last-In-Last-Out behavior,
-which is the default ingress/egress scheme imposed upon instances
-of this type.
+A parenthetical S is padded inside its parentheses only if the receiver
+would be as well. If the receiver parenthesizes its sole expression value
+per [Condition.SetExpressionParen], the parentheses of S are omitted, as
+they would be redundant. In either case a transient, non-parenthetical
+view of S is returned. Otherwise, S is returned as-is.
 */
-func (r Condition) IsFIFO() (is bool) {
-	if r.IsInit() {
-		is = r.condition.isFIFO()
+func (r condition) stackExpression(S *stack) (view *stack, L, R string) {
+	view = S
+	if !S.positive(parens) || S.stackType() == basic || S.getPresentationPolicy() != nil {
+		return
+	}
+
+	// unless the receiver's own parentheses
+	// suffice, apply the effective padding.
+	if !r.cfg.epr || len(r.exv) > 0 {
+		spad := S.parenPad()
+		pad := padIf(len(spad) > 0 && len(r.parenPad()) > 0)
+		if pad == spad {
+			return
+		}
+		L, R = `(`+pad, pad+`)`
 	}
+
+	sc, _ := S.config()
+	tmp := *sc
+	tmp.opt &^= parens
+
+	v := append(stack{&tmp}, (*S)[1:]...)
+	view = &v
+
 	return
 }
 
 /*
-isFIFO is a private method called by the Condition.IsFIFO method.
+renderStack writes the string representation of the [Stack] expression
+value (S) to w by way of condition.stackExpression. See stack.render
+regarding seen.
 */
-func (r condition) isFIFO() (result bool) {
+func (r condition) renderStack(w io.Writer, S *stack, seen visitSet) (err error) {
+	view, L, R := r.stackExpression(S)
+	if view != S {
+		// the view is transient, thus S itself
+		// must be tracked for cycle detection.
+		if seen == nil {
+			seen = make(visitSet)
+		} else if seen[S] {
+			_, err = io.WriteString(w, recursiveSentinel)
+			return
+		}
+		seen[S] = true
+		defer delete(seen, S)
+	}
 
-	if stk, ok := stackTypeAliasConverter(r.ex); ok {
-		result = stk.IsFIFO()
+	if _, err = io.WriteString(w, L); err == nil {
+		if err = view.render(w, seen); err == nil {
+			_, err = io.WriteString(w, R)
+		} else if te, ok := err.(*truncatedError); ok && len(L) > 0 {
+			te.tail += R
+		}
 	}
 
 	return
 }
 
 /*
-SetParen sets the string-encapsulation bit for parenthetical
-expression within the receiver. The receiver shall undergo
-parenthetical encapsulation ( (...) ) during the string
-representation process. Individual string values shall not
-be encapsulated in parenthesis, only the whole (current)
-[Stack] instance.
-
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the encapsulation bit (i.e.: true->false
-and false->true)
+truncatedString is a private method called by condition.string when a
+maximum string length has been set. Expression values are written by way
+of a truncator, with [Stack] expressions rendered piecemeal, until the
+maximum (n) has been reached. In that case, marker is appended, followed
+by the closing characters of any parenthetical [Stack] expression and of
+the receiver itself, if they were opened.
 */
-func (r Condition) SetParen(state ...bool) Condition {
-	r.setState(parens, state...)
-	return r
+func (r condition) truncatedString(n int, marker string) string {
+	builder := newStringBuilder()
+	tr := &truncator{w: &builder, rem: n}
+
+	open, head, clos := r.stringParts()
+	_, err := io.WriteString(tr, open+head)
+	opened := err == nil
+
+	vals := r.exv
+	if len(vals) == 0 {
+		vals = []any{r.ex}
+	}
+
+	for i := 0; i < len(vals) && err == nil; i++ {
+		if i > 0 {
+			_, err = io.WriteString(tr, r.valueDelimiter())
+		}
+
+		if err != nil {
+			break
+		} else if S, ok := vals[i].(Stack); ok && S.IsInit() && !hasTypeStringer(vals[i]) {
+			L, R := encapParts(r.cfg.enc)
+			if _, err = io.WriteString(tr, L); err == nil {
+				if err = r.renderStack(tr, S.stack, nil); err == nil {
+					_, err = io.WriteString(tr, R)
+				}
+			}
+		} else {
+			_, err = io.WriteString(tr, r.valueString(vals[i], nil))
+		}
+	}
+
+	if te, ok := err.(*truncatedError); ok {
+		builder.WriteString(marker + te.tail)
+		if !opened {
+			return builder.String()
+		}
+	}
+
+	builder.WriteString(clos)
+	return builder.String()
 }
 
 /*
-Deprecated: Use [Condition.SetParen].
+expressionString returns the raw string representation of an individual
+expression value (x). See stack.render regarding seen.
 */
-func (r Condition) Paren(state ...bool) Condition {
-	return r.SetParen(state...)
+func expressionString(x any, seen visitSet) string {
+	if str, ok := typeStringer(nil, x); ok {
+		return str
+	} else if S, ok := x.(Stack); ok && S.IsInit() {
+		return S.stack.string(seen)
+	} else if meth := getStringer(x); meth != nil {
+		return meth()
+	}
+
+	return primitiveStringer(x)
 }
 
 /*
-IsParen returns a Boolean value indicative of whether the
-receiver is parenthetical.
+valueString returns the encapsulated string representation of the
+expression value x, or the placeholder if x is [PendingExpression].
 */
-func (r Condition) IsParen() bool {
-	return r.getState(parens)
+func (r condition) valueString(x any, seen visitSet) string {
+	if isPending(x) {
+		return r.cfg.placeholder()
+	} else if S, ok := x.(Stack); ok && S.IsInit() && !hasTypeStringer(x) {
+		builder := newStringBuilder()
+		_ = r.renderStack(&builder, S.stack, seen)
+		return encapValue(r.cfg.enc, builder.String())
+	}
+
+	return encapValue(r.cfg.enc, expressionString(x, seen))
 }
 
 /*
-SetReadOnly sets the receiver bit 'ronly' to a positive state.
-This will prevent any writes to the receiver or its underlying
-configuration.
+pendingType is the type of the [PendingExpression] placeholder.
 */
-func (r Condition) SetReadOnly(state ...bool) Condition {
-	r.setState(ronly, state...)
-	return r
+type pendingType struct{}
+
+/*
+String returns the default placeholder string.
+*/
+func (r pendingType) String() string {
+	return defaultPlaceholder
 }
 
+const defaultPlaceholder = `?`
+
 /*
-IsReadOnly returns a Boolean value indicative of whether the
-receiver is set as read-only.
+PendingExpression is a placeholder value which may be set as the expression
+of a [Condition] -- such as via [Condition.SetExpression] or [Cond] -- during
+piecemeal assembly, before the actual expression value is known.
+
+Such a [Condition] renders the placeholder in place of its expression (see
+[Condition.SetPlaceholder]), is only considered valid if pending expressions
+were permitted via [Condition.SetAllowPending], and may not be evaluated:
+[Condition.Evaluate] returns an error which wraps [ErrPendingExpression].
+
+The placeholder is not subject to any [PushPolicy] set within the [Condition].
 */
-func (r Condition) IsReadOnly() bool {
-	return r.getState(ronly)
+var PendingExpression pendingType
+
+/*
+isPending returns a Boolean value indicative of whether x is the
+[PendingExpression] placeholder.
+*/
+func isPending(x any) (is bool) {
+	_, is = x.(pendingType)
+	return
 }
 
-func (r Condition) getState(cf cfgFlag) (state bool) {
+/*
+IsPending returns a Boolean value indicative of whether the expression of
+the receiver is the [PendingExpression] placeholder.
+*/
+func (r Condition) IsPending() (is bool) {
 	if r.IsInit() {
-		state = r.condition.positive(cf)
+		is = isPending(r.condition.ex)
 	}
 	return
 }
 
-func (r Condition) setState(cf cfgFlag, state ...bool) {
+/*
+SetPlaceholder assigns the string rendered in place of a [PendingExpression]
+during the string representation of the receiver. A zero string restores the
+default placeholder, which is a question mark (?).
+*/
+func (r Condition) SetPlaceholder(ph string) Condition {
 	if r.IsInit() {
-		if !r.getState(ronly) || cf == ronly {
-			if len(state) > 0 {
-				if state[0] {
-					r.condition.setOpt(cf)
-				} else {
-					r.condition.unsetOpt(cf)
-				}
-			} else {
-				r.condition.toggleOpt(cf)
-			}
+		if !r.getState(ronly) {
+			r.condition.cfg.phd = ph
+			r.condition.cfg.dropString()
 		}
 	}
+	return r
 }
 
-func (r *condition) setLogger(logger any) {
-	r.cfg.log.setLogger(logger)
-}
+/*
+SetMaxStringLength assigns the maximum length, in bytes, of the string
+representation produced by [Condition.String]. Once the maximum is reached,
+the output is terminated using the truncation marker (see the method
+[Condition.SetTruncationMarker]), followed by the closing characters of any
+parenthetical [Stack] expression and of the receiver, if opened.
 
-func (r *condition) toggleOpt(cf cfgFlag) {
-	r.cfg.toggleOpt(cf)
-}
+[Stack] expressions are rendered piecemeal, and are truncated between their
+slices as described by [Stack.SetMaxStringLength]. All other values are never
+cut partway; a value which would exceed the maximum is omitted altogether.
 
-func (r *condition) setOpt(cf cfgFlag) {
-	r.cfg.setOpt(cf)
-}
+The maximum applies only to the receiver's own [Condition.String] call, and
+not to the representation of the receiver as a slice of a [Stack].
 
-func (r *condition) unsetOpt(cf cfgFlag) {
-	r.cfg.unsetOpt(cf)
+A zero or negative value, which is the default, disables truncation.
+*/
+func (r Condition) SetMaxStringLength(n int) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.msl = n
+			r.condition.cfg.dropString()
+		}
+	}
+	return r
 }
 
-func (r *condition) positive(cf cfgFlag) bool {
-	return r.cfg.positive(cf)
+/*
+MaxStringLength returns the maximum string length set within the receiver,
+or zero (0) if unlimited. See [Condition.SetMaxStringLength].
+*/
+func (r Condition) MaxStringLength() (n int) {
+	if r.IsInit() {
+		n = max(r.condition.cfg.msl, 0)
+	}
+	return
 }
 
 /*
-SetNoPadding sets the no-space-padding bit within the receiver.
-String values within the receiver shall not be padded using
-a single space character (ASCII #32).
+SetTruncationMarker assigns the string value used to terminate truncated
+string representations of the receiver. See [Condition.SetMaxStringLength].
 
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the quotation bit (i.e.: true->false and
-false->true)
+A zero string restores the default marker, which is an ellipsis (…).
 */
-func (r Condition) SetNoPadding(state ...bool) Condition {
-	r.setState(nspad, state...)
+func (r Condition) SetTruncationMarker(marker string) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.condition.cfg.tmk = marker
+			r.condition.cfg.dropString()
+		}
+	}
 	return r
 }
 
 /*
-Deprecated: Use [Condition.SetNoPadding].
+TruncationMarker returns the string value used to terminate truncated
+string representations of the receiver. See [Condition.SetTruncationMarker].
 */
-func (r Condition) NoPadding(state ...bool) Condition {
-	return r.SetNoPadding(state...)
+func (r Condition) TruncationMarker() (marker string) {
+	if r.IsInit() {
+		marker = r.condition.cfg.truncationMarker()
+	}
+	return
 }
 
 /*
-IsPadded returns a Boolean value indicative of whether the
-receiver pads its contents with a SPACE char (ASCII #32).
-*/
-func (r Condition) IsPadded() (is bool) {
-	return !r.getState(nspad)
-}
+SetInvalidMarker assigns the string value returned by [Condition.String] in
+place of the string representation of the receiver while it is invalid, as
+judged by [Condition.Valid], such as when it lacks an expression value. See
+also [Stack.SetSkipInvalid].
 
-/*
-Expression returns the expression value(s) stored within the receiver, or
-nil if unset. A valid receiver instance MUST always possess a non-nil
-expression value.
+A zero string restores the default marker, which is "<invalid_condition>".
 */
-func (r Condition) Expression() (ex any) {
+func (r Condition) SetInvalidMarker(marker string) Condition {
 	if r.IsInit() {
-		ex = r.condition.ex
+		if !r.getState(ronly) {
+			r.condition.cfg.ivm = marker
+		}
 	}
-	return
+	return r
 }
 
 /*
-Operator returns the [Operator] interface type instance found within the
-receiver.
+InvalidMarker returns the string value rendered in place of the receiver
+while it is invalid. See [Condition.SetInvalidMarker].
 */
-func (r Condition) Operator() (op Operator) {
+func (r Condition) InvalidMarker() (marker string) {
 	if r.IsInit() {
-		op = r.condition.op
+		marker = r.condition.cfg.invalidMarker()
 	}
 	return
 }
 
 /*
-Keyword returns the Keyword interface type instance found within the
-receiver.
+Placeholder returns the string rendered in place of a [PendingExpression]
+during the string representation of the receiver. See also the
+[Condition.SetPlaceholder] method.
 */
-func (r Condition) Keyword() (kw string) {
+func (r Condition) Placeholder() (ph string) {
 	if r.IsInit() {
-		kw = r.condition.kw
+		ph = r.condition.cfg.placeholder()
 	}
 	return
 }
 
 /*
-String is a stringer method that returns the string representation
-of the receiver instance. It will only function if the receiver is
-in good standing, and passes validity checks.
-
-Note that if the underlying expression value is not a known type,
-such as a [Stack] or a Go primitive, this method may be uncertain
-as to what it should do. A bogus string may be returned.
+SetAllowPending permits (true) or forbids (false) the [PendingExpression]
+placeholder as a valid expression for the receiver, as judged by the
+[Condition.Valid] method. By default, pending expressions are forbidden.
 
-In such a case, it may be necessary to subvert the default string
-representation behavior demonstrated by instances of this type in
-favor of a custom instance of the [PresentationPolicy] closure type
-for maximum control.
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
 */
-func (r Condition) String() (s string) {
-	if err := r.Valid(); err == nil {
-		s = r.condition.string()
+func (r Condition) SetAllowPending(state ...bool) Condition {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if len(state) > 0 {
+				r.condition.cfg.apd = state[0]
+			} else {
+				r.condition.cfg.apd = !r.condition.cfg.apd
+			}
+		}
 	}
-	return
+	return r
 }
 
 /*
-string is a stringer method that returns the string representation
-of the receiver instance.
+ConditionCaster is an optional interface which may be implemented by
+[Condition] type aliases. When implemented, the CastCondition method is
+used to obtain the native [Condition] in place of reflection-based
+conversion, such as during string representation and equality assertion.
 */
-func (r condition) string() string {
-	if r.cfg.rpf != nil {
-		return r.cfg.rpf(r)
-	}
-
-	// begin default presentation
-	// handler ...
-	var raw string
-	if meth := getStringer(r.ex); meth != nil {
-		raw = meth()
-	} else {
-		raw = primitiveStringer(r.ex)
-	}
-
-	val := encapValue(r.cfg.enc, raw)
-	var pad string = string(rune(32))
-	if r.cfg.positive(nspad) {
-		pad = ``
-	}
+type ConditionCaster interface {
+	CastCondition() Condition
+}
 
-	s := r.kw + pad + r.op.String() + pad + val
-	if r.cfg.positive(parens) {
-		s = `(` + pad + s + pad + `)`
-	}
+/*
+RegisterConditionAlias registers the type of the provided example, which
+may be an instance of -- or pointer to -- a [Condition] type alias, such
+that subsequent conversions of values of that type forgo the reflective
+convertibility check. A Boolean value indicative of whether the type is
+a [Condition] type alias is returned. Types which are not are refused.
 
-	return s
+Registration is optional: unregistered aliases are converted as always.
+This function is safe for concurrent use.
+*/
+func RegisterConditionAlias(example any) bool {
+	return registerAlias(&conditionAliases, example, nativeCondition)
 }
 
 /*
@@ -1212,6 +2983,8 @@ a Boolean value of true.
 
 If the input value is a [Condition]-alias, it is converted to a native
 [Condition] instance and returned alongside a Boolean value of true.
+Aliases which implement [ConditionCaster], or whose types were registered
+using the [RegisterConditionAlias] function, are converted most efficiently.
 
 Any other scenario returns a zero [Condition] alongside a Boolean value
 of false.
@@ -1220,25 +2993,49 @@ func ConvertCondition(in any) (Condition, bool) {
 	return conditionTypeAliasConverter(in)
 }
 
+/*
+ConvertConditions converts each input value as [ConvertCondition] does,
+returning the successfully converted instances in the order of input. The
+indices of input values which could not be converted are returned as well,
+alongside a Boolean value which is true only if all values were converted.
+*/
+func ConvertConditions(in ...any) (conds []Condition, failed []int, ok bool) {
+	conds = make([]Condition, 0, len(in))
+	for i := 0; i < len(in); i++ {
+		if C, cok := conditionTypeAliasConverter(in[i]); cok {
+			conds = append(conds, C)
+		} else {
+			failed = append(failed, i)
+		}
+	}
+	ok = len(failed) == 0
+
+	return
+}
+
 /*
 conditionTypeAliasConverter attempts to convert any (u) back to a bonafide instance
 of Condition. This will only work if input value u is a type alias of Condition. An
 instance of Condition is returned along with a success-indicative Boolean value.
 */
 func conditionTypeAliasConverter(u any) (C Condition, converted bool) {
-	if u != nil {
+	switch tv := u.(type) {
+	case Condition:
 		// If it isn't a Condition alias, but is a
 		// genuine Condition, just pass it back
 		// with a thumbs-up ...
-		if co, isCond := u.(Condition); isCond {
-			C = co
-			converted = isCond
+		C, converted = tv, true
+	case ConditionCaster:
+		C = tv.CastCondition()
+		converted = !C.IsZero()
+	default:
+		if !isAliasCandidate(u) {
 			return
 		}
 
 		a, v, _ := derefPtr(typOf(u), valOf(u))
-		b := typOf(Condition{}) // target (dest) type
-		if a.ConvertibleTo(b) {
+		b := nativeCondition // target (dest) type
+		if aliasConvertible(&conditionAliases, a, b) {
 			X := v.Convert(b).Interface()
 			if assert, ok := X.(Condition); ok {
 				if !assert.IsZero() {
@@ -2,6 +2,7 @@ package stackage
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -564,6 +565,71 @@ func TestCondition_IsParen(t *testing.T) {
 	}
 }
 
+func TestCondition_SetExpressionParen(t *testing.T) {
+	for idx, tst := range []struct {
+		CondNoPad  bool
+		StackNoPad bool
+		ExprParen  bool
+		Want       string
+	}{
+		{false, false, false, `keyword = ( a AND b )`},
+		{false, true, false, `keyword = (a AND b)`},
+		{true, false, false, `keyword=(a AND b)`},
+		{true, true, false, `keyword=(a AND b)`},
+		{false, false, true, `keyword = ( a AND b )`},
+		{false, true, true, `keyword = ( a AND b )`},
+		{true, false, true, `keyword=(a AND b)`},
+		{true, true, true, `keyword=(a AND b)`},
+	} {
+		S := And().SetParen(true).SetNoPadding(tst.StackNoPad).Push(`a`, `b`)
+		c := Cond(`keyword`, Eq, S).
+			SetNoPadding(tst.CondNoPad).
+			SetExpressionParen(tst.ExprParen)
+
+		if got := c.String(); got != tst.Want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		} else if c.IsExpressionParen() != tst.ExprParen {
+			t.Errorf("%s[%d] failed: want '%t', got '%t'", t.Name(), idx,
+				tst.ExprParen, c.IsExpressionParen())
+			return
+		}
+
+		// the stack expression itself is unaffected
+		if got, want := S.IsParen(), true; got != want {
+			t.Errorf("%s[%d] failed: want '%t', got '%t'", t.Name(), idx, want, got)
+			return
+		}
+	}
+
+	// non-parenthetical stacks and scalars are parenthesized, once
+	c := Cond(`keyword`, Eq, And().Push(`a`, `b`)).SetExpressionParen(true)
+	if got, want := c.String(), `keyword = ( a AND b )`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	c = Cond(`keyword`, Eq, `value`).SetExpressionParen().SetParen(true)
+	if got, want := c.String(), `( keyword = ( value ) )`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// toggle
+	if c.SetExpressionParen(); c.IsExpressionParen() {
+		t.Errorf("%s failed: want 'false', got 'true'", t.Name())
+		return
+	}
+
+	// truncation closes the expression parentheses
+	c = Cond(`keyword`, Eq, And().SetParen(true).Push(`a`, `b`, `cdefgh`)).
+		SetExpressionParen(true).
+		SetMaxStringLength(18)
+	if got, want := c.String(), `keyword = ( a AND… )`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
 func TestCondition_NoNesting(t *testing.T) {
 	var c Condition = Cond(`myKeyword`, Eq, `temporary_value`)
 	c.NoNesting(true)
@@ -601,6 +667,61 @@ func TestCondition_IsNesting(t *testing.T) {
 	}
 }
 
+func TestCondition_Depth(t *testing.T) {
+	nightmare := nightmareStack()
+	for idx, tst := range []struct {
+		Path  []int
+		Depth int
+		Count int
+	}{
+		{[]int{1, 0}, 1, 1},          // outer: customStack expression
+		{[]int{1, 1, 1, 0, 0}, 0, 0}, // dayofweek: scalar expression
+		{[]int{1, 1, 1, 0, 2}, 3, 0}, // greeting: thrice-nested lists
+		{[]int{1, 3}, 1, 0},          // keyword: OR expression
+	} {
+		slice, _ := nightmare.Traverse(tst.Path...)
+		c, _ := slice.(Condition)
+		if got := c.Depth(); got != tst.Depth {
+			t.Errorf("%s failed [idx:%d]: want '%d', got '%d'", t.Name(), idx, tst.Depth, got)
+			return
+		} else if got = len(c.NestedConditions()); got != tst.Count {
+			t.Errorf("%s failed [idx:%d]: want '%d', got '%d'", t.Name(), idx, tst.Count, got)
+			return
+		}
+	}
+
+	c := Cond(`nightmare`, Eq, nightmare)
+	if got, want := c.Depth(), nightmare.Depth()+1; got != want {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), want, got)
+		return
+	}
+
+	var kws []string
+	for _, nc := range c.NestedConditions() {
+		kws = append(kws, nc.Keyword())
+	}
+	want := `outer,keyword,dayofweek,ssf,greeting,keyword2,keyword`
+	if got := join(kws, `,`); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// self-referential structures terminate
+	S := And()
+	c = Cond(`self`, Eq, S)
+	S.Push(c)
+	if d, n := c.Depth(), len(c.NestedConditions()); d != S.Depth()+1 || n != 1 {
+		t.Errorf("%s failed: want depth %d and count 1, got %d and %d", t.Name(), S.Depth()+1, d, n)
+		return
+	}
+
+	var z Condition
+	var _ Interface = z
+	if z.Depth() != 0 || z.NestedConditions() != nil {
+		t.Errorf("%s failed: unexpected results for zero instance", t.Name())
+	}
+}
+
 //func TestCondition_IsPadded(t *testing.T) {
 //	cond := Cond(`person`, Eq, `Jesse`).Paren().Encap(`"`).NoPadding(false)
 //
@@ -706,6 +827,116 @@ func ExampleCondition_SetExpression() {
 	// Output: Expr type: float64
 }
 
+func ExampleCondition_SetPushPolicy() {
+	var c Condition = Cond(`keyword`, Eq, `value`)
+	c.SetPushPolicy(func(x ...any) (err error) {
+		if _, ok := x[0].(string); !ok {
+			err = fmt.Errorf("Only string expressions allowed, got %T", x[0])
+		}
+		return
+	})
+
+	c.SetExpression(1.456)
+	fmt.Printf("%s (%v)", c, c.Err())
+	// Output: keyword = value (Only string expressions allowed, got float64)
+}
+
+func TestCondition_SetPushPolicy(t *testing.T) {
+	var c Condition = Cond(`keyword`, Eq, `value`)
+	c.SetPushPolicy(func(x ...any) (err error) {
+		if S, ok := stackTypeAliasConverter(x[0]); !ok || S.Category() != `nested` {
+			err = errorf("Only 'nested' Stack expressions allowed")
+		}
+		return
+	})
+
+	// acceptance
+	c.SetExpression(And().SetCategory(`nested`).Push(`this`, `that`))
+	if err := c.Err(); err != nil || !c.IsNesting() {
+		t.Errorf("%s failed [accept]: want '%t', got '%t' (err:%v)",
+			t.Name(), true, c.IsNesting(), err)
+		return
+	}
+
+	// rejection
+	c.SetExpression(`value`)
+	if err := c.Err(); err == nil || !c.IsNesting() {
+		t.Errorf("%s failed [reject]: want 'error', got '%v' [%T]",
+			t.Name(), err, c.Expression())
+		return
+	}
+
+	// clear the policy (and the error)
+	c.SetPushPolicy(nil).SetErr(nil)
+	c.SetExpression(`value`)
+	if got := c.String(); got != `keyword = value` {
+		t.Errorf("%s failed [clear]: want '%s', got '%s'",
+			t.Name(), `keyword = value`, got)
+	}
+}
+
+func ExampleCondition_AddExpressionValue() {
+	var c Condition = Cond(`attribute`, fakeOperator{Str: `IN`, Ctx: `list`}, `a`)
+	c.AddExpressionValue(`b`, `c`).SetValueDelimiter(`,`).Encap(`"`)
+	fmt.Printf("%s (%d values)", c, c.Len())
+	// Output: attribute IN "a","b","c" (3 values)
+}
+
+func TestCondition_AddExpressionValue(t *testing.T) {
+	var c Condition
+	c.Init()
+	c.SetKeyword(`attribute`).SetOperator(Eq)
+
+	if err := c.Valid(); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+		return
+	}
+
+	// single value behavior must match SetExpression
+	c.AddExpressionValue(`a`)
+	if want, got := Cond(`attribute`, Eq, `a`).String(), c.String(); want != got {
+		t.Errorf("%s failed [single]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	c.AddExpressionValue(2, ``, And().Push(`x`, `y`))
+	if want, got := `attribute = a,2,x AND y`, c.String(); want != got {
+		t.Errorf("%s failed [multi]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if got := len(c.Expressions()); got != 3 || c.Len() != 3 {
+		t.Errorf("%s failed [count]: want '%d', got '%d'", t.Name(), 3, got)
+		return
+	}
+
+	d := Cond(`attribute`, Eq, `a`).AddExpressionValue(2, And().Push(`x`, `y`))
+	if err := c.IsEqual(d); err != nil {
+		t.Errorf("%s failed [equal]: %v", t.Name(), err)
+		return
+	}
+
+	e := Cond(`attribute`, Eq, 2).AddExpressionValue(`a`, And().Push(`x`, `y`))
+	if err := c.IsEqual(e); err == nil {
+		t.Errorf("%s failed [order]: want 'error', got '%v'", t.Name(), err)
+		return
+	}
+
+	slices, err := c.Unmarshal()
+	if err != nil {
+		t.Errorf("%s failed [unmarshal]: %v", t.Name(), err)
+		return
+	} else if got := len(slices[3].([]any)); got != 3 {
+		t.Errorf("%s failed [unmarshal]: want '%d', got '%d'", t.Name(), 3, got)
+		return
+	}
+
+	// SetExpression resets to single-valued
+	if c.SetExpression(`z`); c.Len() != 1 || c.String() != `attribute = z` {
+		t.Errorf("%s failed [reset]: want '%s', got '%s'", t.Name(), `attribute = z`, c)
+	}
+}
+
 func ExampleCondition_Operator() {
 	var c Condition
 	c.Init()
@@ -834,6 +1065,41 @@ func ExampleCondition_Len() {
 	// length with stackage.Stack: 3
 }
 
+func TestCondition_SetCountElements(t *testing.T) {
+	for idx, tst := range []struct {
+		Expr         any
+		Len, Counted int
+	}{
+		{`string`, 1, 1},
+		{[]string{`a`, `b`, `c`}, 1, 3},
+		{&[]string{`a`, `b`}, 1, 2},
+		{[2]int{1, 2}, 1, 2},
+		{map[string]int{`a`: 1, `b`: 2}, 1, 2},
+		{And().Push(`a`, `b`, `c`, `d`), 4, 4},
+		{nil, 0, 0},
+	} {
+		c := Cond(`keyword`, Eq, tst.Expr)
+		if got := c.Len(); got != tst.Len {
+			t.Errorf("%s failed [idx:%d]: want '%d', got '%d'", t.Name(), idx, tst.Len, got)
+			return
+		} else if got = c.ExpressionLen(); got != tst.Counted {
+			t.Errorf("%s failed [idx:%d, explicit]: want '%d', got '%d'", t.Name(), idx, tst.Counted, got)
+			return
+		} else if got = c.SetCountElements(true).Len(); got != tst.Counted {
+			t.Errorf("%s failed [idx:%d, counted]: want '%d', got '%d'", t.Name(), idx, tst.Counted, got)
+			return
+		} else if got = c.SetCountElements().Len(); got != tst.Len || c.IsCountElements() {
+			t.Errorf("%s failed [idx:%d, toggled]: want '%d', got '%d'", t.Name(), idx, tst.Len, got)
+			return
+		}
+	}
+
+	var z Condition
+	if z.SetCountElements(true).IsCountElements() || z.ExpressionLen() != 0 {
+		t.Errorf("%s failed: unexpected result for zero condition", t.Name())
+	}
+}
+
 func ExampleCondition_Logger() {
 	var buf *bytes.Buffer = &bytes.Buffer{}
 	var customLogger *log.Logger = log.New(buf, ``, 0)
@@ -1018,5 +1284,844 @@ func TestCondition_codecov(t *testing.T) {
 	cz.Free()
 
 	subc := []any{`CONDITION`, `Keywerdd`, Gt, 5}
-	extractConditionValues([]any{`CONDITION`, `Keyword`, Eq, subc})
+	extractConditionValues([]any{`CONDITION`, `Keyword`, Eq, subc}, false)
+}
+
+func TestCondition_SetPaddingStyle(t *testing.T) {
+	for idx, tst := range []struct {
+		Style PaddingStyle
+		Paren bool
+		Want  string
+	}{
+		{PadAfterOperator, false, `keyword>= value`},
+		{PadBeforeOperator, false, `keyword >=value`},
+		{PadBeforeOperator | PadAfterOperator, false, `keyword >= value`},
+		{0, true, `(keyword>=value)`},
+		{PadInsideParens, true, `( keyword>=value )`},
+	} {
+		c := Cond(`keyword`, Ge, `value`).SetParen(tst.Paren).SetPaddingStyle(tst.Style)
+		if got := c.String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		}
+	}
+
+	// legacy padding resumes following SetNoPadding
+	c := Cond(`keyword`, Ge, `value`).SetPaddingStyle(PadAfterOperator).SetNoPadding(false)
+	if got, want := c.String(), `keyword >= value`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestCondition_KeywordValue(t *testing.T) {
+	c := Cond(testKeyword02, Eq, `value`)
+	if kw, ok := c.KeywordValue().(customTestKeyword); !ok || kw != testKeyword02 {
+		t.Errorf("%s failed: want '%T', got '%T'", t.Name(), testKeyword02, c.KeywordValue())
+		return
+	} else if want, got := `keyword_02 = value`, c.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// the original keyword survives an unmarshal/marshal roundtrip
+	s := And().Push(c)
+	um, _ := s.Unmarshal()
+	var m Stack
+	if err := m.Marshal(um); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	slice, _ := m.Index(0)
+	if mc, _ := slice.(Condition); mc.KeywordValue() != testKeyword02 {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), testKeyword02, mc.KeywordValue())
+		return
+	} else if err := mc.IsEqual(Cond(`keyword_02`, Eq, `value`)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	c.SetKeyword(`plain`)
+	if kw := c.KeywordValue(); kw != `plain` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `plain`, kw)
+	}
+}
+
+type negatableOperator string
+
+func (r negatableOperator) String() string  { return string(r) }
+func (r negatableOperator) Context() string { return `negatable` }
+func (r negatableOperator) Negate() Operator {
+	if r == `~=` {
+		return negatableOperator(`!~=`)
+	}
+	return negatableOperator(`~=`)
+}
+
+func TestCondition_Negate(t *testing.T) {
+	for idx, tst := range []struct {
+		Op   Operator
+		Want string
+	}{
+		{Eq, `!=`},
+		{Ne, `=`},
+		{Lt, `>=`},
+		{Ge, `<`},
+		{Gt, `<=`},
+		{Le, `>`},
+		{negatableOperator(`~=`), `!~=`},
+	} {
+		c := Cond(`keyword`, tst.Op, `value`).Negate()
+		if got := c.Operator().String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		}
+	}
+
+	c := Cond(`keyword`, fakeOperator{Str: `~`, Ctx: `fake`}, `value`).Negate()
+	if c.Err() == nil || c.Operator().String() != `~` {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), c.Err())
+	}
+}
+
+func TestCondition_ComparisonOperator(t *testing.T) {
+	for idx, tst := range []struct {
+		Op       ComparisonOperator
+		Equality bool
+	}{
+		{Eq, true}, {Ne, true}, {Lt, false}, {Gt, false}, {Le, false}, {Ge, false},
+	} {
+		c := Cond(`keyword`, tst.Op, `value`)
+		if cop, ok := c.ComparisonOperator(); !ok || cop != tst.Op || !cop.Valid() {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Op, cop)
+			return
+		} else if c.IsEquality() != tst.Equality || c.IsOrdering() == tst.Equality {
+			t.Errorf("%s failed [idx:%d]: unexpected predicate results", t.Name(), idx)
+			return
+		} else if !c.OperatorIs(tst.Op) || c.OperatorIs(fakeOperator{Str: tst.Op.String(), Ctx: `fake`}) {
+			t.Errorf("%s failed [idx:%d]: unexpected OperatorIs result", t.Name(), idx)
+			return
+		}
+	}
+
+	fake := fakeOperator{Str: `~=`, Ctx: `approx`}
+	c := Cond(`keyword`, fake, `value`)
+	if _, ok := c.ComparisonOperator(); ok || c.IsEquality() || c.IsOrdering() {
+		t.Errorf("%s failed: want no comparison operator for %T", t.Name(), fake)
+		return
+	} else if !c.OperatorIs(fakeOperator{Str: `~=`, Ctx: `approx`}) {
+		t.Errorf("%s failed: want matching %T", t.Name(), fake)
+		return
+	}
+
+	if bogus := ComparisonOperator(77); bogus.Valid() || nco.Valid() {
+		t.Errorf("%s failed: want invalid operators", t.Name())
+		return
+	} else if _, ok := Cond(`keyword`, bogus, `value`).ComparisonOperator(); ok {
+		t.Errorf("%s failed: want no comparison operator for %d", t.Name(), bogus)
+	}
+}
+
+func TestCondition_SetPermittedOperators(t *testing.T) {
+	sim := fakeOperator{Str: `>=similarity`, Ctx: `fuzzy`}
+
+	c := Cond(`cn`, sim, `Jesse`)
+	if err := c.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	c.SetPermittedOperators(sim).SetOperator(Eq)
+	if c.Operator() != sim {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), sim, c.Operator())
+		return
+	} else if c.Err() == nil {
+		t.Errorf("%s failed: expected error for prohibited %s", t.Name(), Eq)
+		return
+	}
+
+	c.SetErr(nil).SetPermittedOperators(Ge, sim)
+	if err := c.Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	d := Cond(`cn`, Eq, `Jesse`).SetPermittedOperators(sim)
+	if err := d.Valid(); err == nil {
+		t.Errorf("%s failed: expected error for prohibited %s", t.Name(), Eq)
+		return
+	}
+
+	e := Cond(`cn`, ComparisonOperator(7), `Jesse`)
+	if err := e.Valid(); err == nil {
+		t.Errorf("%s failed: expected error for bogus operator", t.Name())
+	}
+}
+
+func TestCondition_Marshal(t *testing.T) {
+	for idx, orig := range []Condition{
+		Cond(`objectClass`, Ne, Or().Paren().Push(`a`, Cond(`b`, Eq, `c`))),
+		Cond(`cn`, Eq, `Jesse`),
+	} {
+		slices, err := orig.Unmarshal()
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		}
+
+		var c Condition
+		if err = c.Marshal(slices...); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if err = c.IsEqual(orig); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		}
+	}
+
+	var c Condition
+	if err := c.Marshal(`CONDITION`, `cn`); !errors.Is(err, ErrMarshalInput) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrMarshalInput, err)
+		return
+	} else if err = c.Marshal(`AND`, `cn`, Eq, `x`); !errors.Is(err, ErrMarshalInput) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrMarshalInput, err)
+		return
+	}
+
+	var called bool
+	c.SetMarshaler(func(_ ...any) error {
+		called = true
+		return nil
+	})
+	if c.Marshal(`anything`); !called {
+		t.Errorf("%s failed: custom marshaler not called", t.Name())
+	}
+}
+
+func TestCondition_Evaluate_default(t *testing.T) {
+	for idx, tst := range []struct {
+		C    Condition
+		X    any
+		Want bool
+	}{
+		{Cond(`age`, Ge, 18), 21, true},
+		{Cond(`age`, Ge, 18), uint8(17), false},
+		{Cond(`age`, Lt, 18.5), int64(18), true},
+		{Cond(`cn`, Lt, `m`), `jesse`, true},
+		{Cond(`cn`, Lt, `m`), `zed`, false},
+		{Cond(`cn`, Eq, `jesse`), `jesse`, true},
+		{Cond(`cn`, Ne, `jesse`), `jessie`, true},
+		{Cond(`z`, Eq, complex(1, 2)), complex(1, 2), true},
+	} {
+		if ev, err := tst.C.Evaluate(tst.X); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if ev != tst.Want {
+			t.Errorf("%s[%d] failed: want '%t', got '%v'", t.Name(), idx, tst.Want, ev)
+			return
+		}
+	}
+
+	for idx, c := range []Condition{
+		Cond(`objectClass`, Eq, Or().Push(`a`, `b`)),
+		Cond(`cn`, fakeOperator{Str: `~=`, Ctx: `approx`}, `jesse`),
+		Cond(`cn`, Lt, `m`),
+	} {
+		if _, err := c.Evaluate(5); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nil", t.Name(), idx)
+			return
+		}
+	}
+
+	// complex numbers cannot be ordered
+	for idx, op := range []ComparisonOperator{Lt, Le, Gt, Ge} {
+		if _, err := Cond(`z`, op, complex(1, 2)).Evaluate(complex(1, 2)); err == nil {
+			t.Errorf("%s[%d] failed: expected error, got nil", t.Name(), idx)
+			return
+		}
+	}
+
+	c := Cond(`cn`, fakeOperator{Str: `~=`, Ctx: `approx`}, `jesse`)
+	if _, err := c.Evaluate(`jesse`); !strings.Contains(err.Error(), `SetEvaluator`) {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	// a user Evaluator takes precedence
+	c = Cond(`age`, Ge, 18).SetEvaluator(func(_ ...any) (any, error) {
+		return `custom`, nil
+	})
+	if ev, _ := c.Evaluate(21); ev != `custom` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `custom`, ev)
+	}
+}
+
+func TestCondition_SetFold(t *testing.T) {
+	c := Cond(`cn`, fakeOperator{Str: `APPROX`, Ctx: `approx`}, `jesse`).SetFold(true)
+	if got, want := c.String(), `cn approx jesse`; got != want || !c.IsFolded() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// symbolic operators are unaffected
+	c = Cond(`cn`, Ge, `jesse`).SetFold()
+	if got, want := c.String(), `cn >= jesse`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestCondition_SetStringCache(t *testing.T) {
+	or := Or().Push(`a`, `b`)
+	c := Cond(`cn`, Eq, or).SetStringCache(true)
+	if got, want := c.String(), `cn = a OR b`; got != want || !c.IsStringCached() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// direct changes to the nested stack are not detected ...
+	or.Push(`c`)
+	if got, want := c.String(), `cn = a OR b`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// ... but any setter discards the cache.
+	for idx, tst := range []struct {
+		Set  func()
+		Want string
+	}{
+		{func() { c.SetExpression(or) }, `cn = a OR b OR c`},
+		{func() { c.SetKeyword(`sn`) }, `sn = a OR b OR c`},
+		{func() { c.SetOperator(Ne) }, `sn != a OR b OR c`},
+		{func() { c.SetParen(true) }, `( sn != a OR b OR c )`},
+		{func() { c.SetNoPadding(true) }, `(sn!=a OR b OR c)`},
+		{func() { c.SetExpression(`x`).SetEncap(`"`) }, `(sn!="x")`},
+	} {
+		tst.Set()
+		if got := c.String(); got != tst.Want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		}
+	}
+
+	c.SetStringCache(false)
+	if c.IsStringCached() || c.condition.cfg.csc != nil {
+		t.Errorf("%s failed: cache not disabled", t.Name())
+	}
+}
+
+func BenchmarkCondition_String(b *testing.B) {
+	for _, cache := range []bool{false, true} {
+		or := Or()
+		for i := 0; i < 1000; i++ {
+			or.Push(Cond(`cn`, Eq, sprintf("value%d", i)))
+		}
+		c := Cond(`filter`, Eq, or).SetStringCache(cache)
+
+		b.Run(sprintf("cache=%t", cache), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = c.String()
+			}
+		})
+	}
+}
+
+func TestCondition_SetOperatorContext(t *testing.T) {
+	c := Cond(`cn`, Eq, `jesse`).SetOperatorContext(`matchingRule`)
+	if err := c.Valid(); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	}
+
+	c.SetOperator(fakeOperator{Str: `:caseExactMatch:=`, Ctx: `matchingRule`})
+	if err := c.Valid(); err != nil || c.OperatorContext() != `matchingRule` {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	c.SetOperator(fakeOperator{Str: `~=`, Ctx: `approx`})
+	if got, want := c.Operator().String(), `:caseExactMatch:=`; got != want || c.Err() == nil {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// zero context disables the check
+	c.SetOperatorContext(``).SetOperator(Ge)
+	if got, want := c.String(), `cn >= jesse`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestCondition_SetOperatorRules(t *testing.T) {
+	rules := OperatorRules{`objectClass`: EqualityOperators()}
+
+	c := Cond(`objectclass`, Eq, `person`).SetOperatorRules(rules)
+	if err := c.Valid(); err != nil || c.Err() != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	// case-folded keyword match
+	c.SetOperator(Ge)
+	if got, want := c.String(), `objectclass = person`; got != want || c.Err() == nil {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), want, got, c.Err())
+		return
+	}
+
+	// unrestricted keyword
+	c.SetErr(nil).SetKeyword(`age`).SetOperator(Ge)
+	if err := c.Valid(); err != nil || c.Err() != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	// keyword is set, but the error is recorded
+	c.SetKeyword(`objectClass`)
+	if c.Keyword() != `objectClass` || c.Err() == nil || c.Valid() == nil {
+		t.Errorf("%s failed: want error, got nil", t.Name())
+		return
+	}
+
+	// rules are copied upon assignment and retrieval
+	rules[`objectClass`] = append(rules[`objectClass`], Ge)
+	if got := c.OperatorRules(); len(got[`objectClass`]) != 2 {
+		t.Errorf("%s failed: want %d operators, got %d", t.Name(), 2, len(got[`objectClass`]))
+		return
+	}
+
+	// nil rules remove the restriction
+	if err := c.SetOperatorRules(nil).Valid(); err != nil || c.OperatorRules() != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+	}
+}
+
+func TestCondition_Config(t *testing.T) {
+	c := Cond(`keyword`, Eq, `a`).
+		SetID(`config`).
+		SetIDPrefix(`pfx`).
+		SetCategory(`test`).
+		SetValueDelimiter(`,`).
+		SetEncap(`"`).
+		SetLogLevel(LogLevel2).
+		SetOperatorContext(`ctx`).
+		SetMaxStringLength(32).
+		SetParen(true).
+		SetFold(true).
+		SetNoPadding(true).
+		SetStringCache(true).
+		SetExpressionParen(true).
+		SetCountElements(true)
+
+	getters := func(r Condition) ConditionConfig {
+		return ConditionConfig{
+			ID:              r.ID(),
+			IDPrefix:        r.IDPrefix(),
+			Category:        r.Category(),
+			Delimiter:       r.ValueDelimiter(),
+			Encap:           r.EncapChars(),
+			LogLevels:       r.LogLevels(),
+			OperatorContext: r.OperatorContext(),
+			MaxStringLength: r.MaxStringLength(),
+			Paren:           r.IsParen(),
+			Fold:            r.IsFolded(),
+			Padded:          r.IsPadded(),
+			ReadOnly:        r.IsReadOnly(),
+			NoNesting:       r.getState(nnest),
+			StringCached:    r.IsStringCached(),
+			ExpressionParen: r.IsExpressionParen(),
+			CountElements:   r.IsCountElements(),
+		}
+	}
+
+	snap := c.Config()
+	want := sprintf("%#v", getters(c))
+	if got := sprintf("%#v", snap); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	c.SetID(`changed`).
+		SetValueDelimiter(`;`).
+		SetParen(false).
+		SetStringCache(false).
+		SetReadOnly(true)
+
+	if got := sprintf("%#v", snap); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	want = sprintf("%#v", getters(c))
+	if got := sprintf("%#v", c.Config()); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	var zero Condition
+	if got := sprintf("%#v", zero.Config()); got != sprintf("%#v", ConditionConfig{}) {
+		t.Errorf("%s failed: want zero snapshot, got '%s'", t.Name(), got)
+	}
+}
+
+func TestCondition_SetFoldKeywordComparison(t *testing.T) {
+	upper := Cond(`CN`, Eq, `x`)
+	lower := Cond(`cn`, Eq, `x`)
+
+	if err := upper.IsEqual(lower); err == nil {
+		t.Errorf("%s failed: want mismatch, got nil", t.Name())
+		return
+	}
+
+	upper.SetFoldKeywordComparison(true)
+	if err := upper.IsEqual(lower); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// the bit is consulted on the receiver only
+	if err := lower.IsEqual(upper); err == nil {
+		t.Errorf("%s failed: want mismatch, got nil", t.Name())
+		return
+	}
+
+	lower.SetFoldKeywordComparison(true)
+	if err := lower.IsEqual(upper); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	for idx, tst := range []struct {
+		kw   string
+		fold []bool
+		want bool
+	}{
+		{`cn`, []bool{false}, false},
+		{`cn`, []bool{true}, true},
+		{`cn`, nil, true},
+		{`CN`, []bool{false}, true},
+		{`sn`, []bool{true}, false},
+	} {
+		if got := upper.MatchesKeyword(tst.kw, tst.fold...); got != tst.want {
+			t.Errorf("%s[%d] failed: want '%t', got '%t'", t.Name(), idx, tst.want, got)
+			return
+		}
+	}
+
+	if (Condition{}).MatchesKeyword(``) {
+		t.Errorf("%s failed: want 'false', got 'true'", t.Name())
+	}
+}
+
+func TestCondition_ExpressionAsStack(t *testing.T) {
+	type aliasStack Stack
+	type aliasCondition Condition
+
+	c := Cond(`keyword`, Eq, aliasStack(Or().Push(`a`, `b`)))
+	if S, ok := c.ExpressionAsStack(); !ok || S.String() != `a OR b` {
+		t.Errorf("%s failed: want '%s', got '%s' (ok:%t)", t.Name(), `a OR b`, S, ok)
+		return
+	} else if _, ok = c.ExpressionAsCondition(); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+		return
+	}
+
+	c = Cond(`keyword`, Eq, aliasCondition(Cond(`inner`, Ne, `x`)))
+	if C, ok := c.ExpressionAsCondition(); !ok || C.Keyword() != `inner` {
+		t.Errorf("%s failed: want '%s', got '%s' (ok:%t)", t.Name(), `inner`, C.Keyword(), ok)
+		return
+	}
+
+	c = Cond(`keyword`, Eq, `value`)
+	if _, ok := c.ExpressionAsStack(); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+		return
+	} else if _, ok = (Condition{}).ExpressionAsStack(); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+		return
+	}
+
+	if err := c.SetExpressionStack(And().Push(`x`, `y`)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := c.String(); got != `keyword = x AND y` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `keyword = x AND y`, got)
+		return
+	}
+
+	c.SetNoNesting(true)
+	if err := c.SetExpressionStack(Or().Push(`z`)); err == nil {
+		t.Errorf("%s failed: want error, got nil", t.Name())
+		return
+	} else if got := c.String(); got != `keyword = x AND y` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `keyword = x AND y`, got)
+		return
+	}
+
+	if err := c.SetExpressionStack(Stack{}); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+		return
+	}
+
+	c.SetNoNesting(false).SetReadOnly(true)
+	if err := c.SetExpressionStack(Or().Push(`z`)); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+	}
+}
+
+func TestCondition_SetReadOnlyDeep(t *testing.T) {
+	inner := List().Push(`a`)
+	c := Cond(`keyword`, Eq, customStack(And().Push(inner, Cond(`b`, Ne, `c`))))
+
+	if c.SetReadOnlyDeep(true); !c.IsReadOnlyDeep() || !inner.IsReadOnly() {
+		t.Errorf("%s failed: want deep read-only", t.Name())
+		return
+	}
+
+	if inner.Push(`b`); inner.Len() != 1 {
+		t.Errorf("%s failed: want len 1, got %d", t.Name(), inner.Len())
+		return
+	}
+
+	if c.SetReadOnlyDeep(); c.IsReadOnly() || inner.IsReadOnly() || c.IsReadOnlyDeep() {
+		t.Errorf("%s failed: want thawed condition tree", t.Name())
+		return
+	}
+
+	if !Cond(`a`, Eq, `b`).SetReadOnlyDeep(true).IsReadOnlyDeep() {
+		t.Errorf("%s failed: want deep read-only for scalar expression", t.Name())
+	}
+}
+
+func TestCondition_SetInvalidMarker(t *testing.T) {
+	var c Condition
+	c.Init()
+	c.SetKeyword(`keyword`).SetOperator(Ge)
+
+	if got := c.String(); got != badCond || c.InvalidMarker() != badCond {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), badCond, got)
+		return
+	}
+
+	if got, want := c.SetInvalidMarker(`???`).String(), `???`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// validity is unaffected
+	if err := c.Valid(); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	}
+
+	if got, want := c.SetExpression(`value`).String(), `keyword >= value`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// zero string restores the default
+	if got := c.SetInvalidMarker(``).InvalidMarker(); got != badCond {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), badCond, got)
+		return
+	}
+
+	var z Condition
+	if got := z.String(); got != `` {
+		t.Errorf("%s failed: want '', got '%s'", t.Name(), got)
+	}
+}
+
+func TestCondition_SetMaxStringLength(t *testing.T) {
+	L := List().Paren()
+	for i := 0; i < 1000; i++ {
+		L.Push(sprintf("value%d", i))
+	}
+
+	c := Cond(`keyword`, Eq, L).Paren()
+	full := c.String()
+
+	got := c.SetMaxStringLength(100).String()
+	if !strings.HasSuffix(got, `… ) )`) || strings.Count(got, `(`) != strings.Count(got, `)`) {
+		t.Errorf("%s failed: want balanced and truncated output, got '%s'", t.Name(), got)
+		return
+	} else if body := strings.TrimSuffix(got, `… ) )`); len(body) > 100 || !strings.HasPrefix(full, body) {
+		t.Errorf("%s failed: want prefix of at most 100 bytes, got '%s'", t.Name(), body)
+		return
+	}
+
+	// truncation does not apply when nested
+	if S := And().Push(c); !strings.Contains(S.String(), `value999`) {
+		t.Errorf("%s failed: want complete nested condition", t.Name())
+		return
+	}
+
+	if got = c.SetTruncationMarker(`...`).SetMaxStringLength(3).String(); got != `...` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `...`, got)
+		return
+	}
+
+	if got = c.SetMaxStringLength(0).String(); got != full {
+		t.Errorf("%s failed: want unchanged output for unlimited condition", t.Name())
+	}
+}
+
+func TestCondition_SetErrorAccumulation(t *testing.T) {
+	c := Cond(`keyword`, Eq, `value`).SetErrorAccumulation(true)
+	c.SetPushPolicy(func(x ...any) error {
+		return errorf("refused")
+	})
+
+	c.SetExpression(`other`).SetErr(errorf("manual")).SetErr(nil)
+
+	errs := c.Errs()
+	var rec ErrorRecord
+	if len(errs) != 2 || c.Err() != nil {
+		t.Errorf("%s failed: want 2 errors and nil Err, got %d and %v", t.Name(), len(errs), c.Err())
+		return
+	} else if !errors.As(errs[0], &rec) || rec.Op != `expression` {
+		t.Errorf("%s failed: want expression record, got '%#v'", t.Name(), rec)
+		return
+	}
+
+	if errs = c.SetErrorHistorySize(1).Errs(); len(errs) != 1 || errs[0].Error() != `manual` {
+		t.Errorf("%s failed: want [manual], got %v", t.Name(), errs)
+		return
+	}
+
+	if errs = c.ClearErrs().Errs(); len(errs) != 0 {
+		t.Errorf("%s failed: want cleared history, got %v", t.Name(), errs)
+		return
+	}
+
+	if c.SetErrorAccumulation().IsAccumulatingErrors() {
+		t.Errorf("%s failed: accumulation not toggled off", t.Name())
+	}
+}
+
+func TestConvertConditions(t *testing.T) {
+	type MyCondition Condition
+	in := []any{MyCondition(Cond(`a`, Eq, `b`)), `plain`, Cond(`c`, Ne, `d`), customStack(And())}
+
+	conds, failed, ok := ConvertConditions(in...)
+	if ok || len(conds) != 2 || sprintf("%v", failed) != `[1 3]` {
+		t.Errorf("%s failed: want 2 conditions and failures [1 3], got %d and %v", t.Name(), len(conds), failed)
+		return
+	} else if conds[0].String() != `a = b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `a = b`, conds[0])
+		return
+	}
+
+	if _, failed, ok = ConvertConditions(in[0], in[2]); !ok || failed != nil {
+		t.Errorf("%s failed: want complete conversion, got failures %v", t.Name(), failed)
+	}
+}
+
+func TestSetComparisonOperatorStrings(t *testing.T) {
+	t.Cleanup(ResetComparisonOperatorStrings)
+
+	words := map[ComparisonOperator]string{
+		Eq: `eq`, Ne: `ne`, Lt: `lt`, Gt: `gt`, Le: `le`,
+	}
+	if err := SetComparisonOperatorStrings(words); err == nil {
+		t.Errorf("%s failed: want error for incomplete map, got nil", t.Name())
+		return
+	}
+
+	words[Ge] = `eq`
+	if err := SetComparisonOperatorStrings(words); err == nil {
+		t.Errorf("%s failed: want error for duplicate value, got nil", t.Name())
+		return
+	}
+
+	words[Ge] = `ge`
+	if err := SetComparisonOperatorStrings(words); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	c := Cond(`age`, Ge, 21)
+	if got, want := c.String(), `age ge 21`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if got = Ge.Context(); got != `comparison` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `comparison`, got)
+		return
+	}
+
+	ResetComparisonOperatorStrings()
+	if got, want := c.String(), `age >= 21`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestCondition_SetOperatorStringer(t *testing.T) {
+	c := Cond(`age`, Ge, 21).SetOperatorStringer(func(op Operator) string {
+		if op == Ge {
+			return `≥`
+		}
+		return op.String()
+	})
+
+	if got, want := c.String(), `age ≥ 21`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// the stringer is presentation-only
+	if err := c.IsEqual(Cond(`age`, Ge, 21)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := c.Operator().String(); got != `>=` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `>=`, got)
+		return
+	}
+
+	if got, want := c.SetOperatorStringer(nil).String(), `age >= 21`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestCondition_PendingExpression(t *testing.T) {
+	var c Condition
+	c.Init()
+	c.SetKeyword(`cn`).SetOperator(Eq).SetExpression(PendingExpression)
+
+	if got, want := c.String(), `cn = ?`; got != want || !c.IsPending() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if err := c.Valid(); !errors.Is(err, ErrPendingExpression) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrPendingExpression, err)
+		return
+	} else if err = c.SetAllowPending(true).Valid(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if _, err = c.Evaluate(`x`); !errors.Is(err, ErrPendingExpression) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrPendingExpression, err)
+		return
+	}
+
+	c.SetPlaceholder(`<tbd>`)
+	stk := And().Push(c, Cond(`sn`, Eq, `Smith`))
+	if got, want := stk.String(), `cn = <tbd> AND sn = Smith`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if err := c.IsEqual(Cond(`cn`, Eq, PendingExpression)); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = c.IsEqual(Cond(`cn`, Ne, PendingExpression)); err == nil {
+		t.Errorf("%s failed: want mismatch, got nil", t.Name())
+		return
+	}
+
+	c.SetExpression(`Jesse`)
+	if got, want := c.String(), `cn = Jesse`; got != want || c.IsPending() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if got = c.SetPlaceholder(``).Placeholder(); got != `?` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `?`, got)
+	}
 }
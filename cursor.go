@@ -0,0 +1,303 @@
+package stackage
+
+/*
+cursor.go contains the Cursor type and its navigational methods.
+*/
+
+import "reflect"
+
+/*
+Cursor is a read-only view of a [Stack] which permits incremental navigation
+of its structure, such that each step begins where the last one ended rather
+than at the root, as with [Stack.Traverse].
+
+The cursor always rests upon a single node: initially the [Stack] from which
+it was obtained, and thereafter any slice reached by way of [Cursor.Down].
+Nested [Stack] instances, as well as [Condition] instances bearing a [Stack]
+expression value (or aliases of either), may be descended.
+
+Each navigational step verifies that the path leading to the node at hand
+still leads to the same values. Only those portions of the path which have
+changed since the previous step are examined; an unchanged path costs no
+more than a comparison of version numbers per level. Should the structure
+have changed beneath the cursor -- for instance, by way of [Stack.Remove]
+upon an ancestor -- an error wrapping [ErrStaleCursor] is returned.
+
+Instances should be created using the [Stack.Cursor] method.
+*/
+type Cursor struct {
+	*cursor
+}
+
+/*
+cursor is the private embedded type to be circumscribed within instances
+of Cursor. The chain field contains one frame for each descent made from
+the root.
+*/
+type cursor struct {
+	root  *stack
+	chain []cursorFrame
+}
+
+/*
+cursorFrame describes a single descent made by a [Cursor]. The parent
+field contains the [Stack] in which the node resides, at the user index
+(idx), while id contains the identity of the node as produced by the
+cursorIdentity function. The node field contains the [Stack] which may
+be descended from the node, if any. The pver and nver fields contain the
+versions of the parent and of the node (conditions only) at the time the
+frame was last verified; see nodeConfig.touch.
+*/
+type cursorFrame struct {
+	parent *stack
+	idx    int
+	id     any
+	node   *stack
+	pver   uint64
+	nver   uint64
+}
+
+/*
+Cursor returns a new instance of [Cursor] positioned at the receiver.
+*/
+func (r Stack) Cursor() Cursor {
+	return Cursor{&cursor{root: r.stack}}
+}
+
+/*
+IsInit returns a Boolean value indicative of whether the receiver was
+obtained from an initialized [Stack] by way of the [Stack.Cursor] method.
+*/
+func (r Cursor) IsInit() bool {
+	return r.cursor != nil && r.cursor.root != nil && r.cursor.root.isInit()
+}
+
+/*
+Valid returns an error if the receiver is uninitialized, or if the structure
+beneath it has changed such that the current path no longer leads to the same
+node.
+*/
+func (r Cursor) Valid() (err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "cursor instance is nil")
+	} else {
+		err = r.cursor.verify(len(r.cursor.chain))
+	}
+
+	return
+}
+
+/*
+Down moves the receiver to the slice found at index idx within the current
+node, which must be a [Stack] or a [Condition] bearing a [Stack] expression
+value (or alias of either). Index semantics are identical to those of
+[Stack.Index], thus negative and forward indices are honored if enabled.
+
+An error is returned, and the receiver is not moved, if the receiver is stale
+(see [Cursor.Valid]), if the current node cannot be descended, or if idx does
+not identify a non-nil slice.
+*/
+func (r Cursor) Down(idx int) (err error) {
+	if err = r.Valid(); err == nil {
+		depth := len(r.cursor.chain) + 1
+		stk := r.cursor.container()
+		if stk == nil {
+			err = errorf("depth %d: %T is not traversable", depth, r.Current())
+		} else {
+			f := cursorFrame{parent: stk}
+			f.pver, _ = stk.version()
+			if slice, i, ok := stk.index(idx); !ok {
+				err = errorf("depth %d: index %d not found (len %d)", depth, idx, stk.ulen())
+			} else {
+				f.idx, f.id, f.node = i-1, cursorIdentity(slice), cursorStack(slice)
+				f.nver, _ = f.nodeVersion()
+				r.cursor.chain = append(r.cursor.chain, f)
+			}
+		}
+	}
+
+	return
+}
+
+/*
+Up moves the receiver to the parent of the current node. An error is returned
+if the receiver is positioned at the root, or if the path leading to the parent
+is stale (see [Cursor.Valid]). Changes affecting only the current node do not
+prevent this, thus a stale cursor may be backed out of.
+*/
+func (r Cursor) Up() (err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "cursor instance is nil")
+	} else if L := len(r.cursor.chain); L == 0 {
+		err = errorf("cursor is positioned at the root")
+	} else if err = r.cursor.verify(L - 1); err == nil {
+		r.cursor.chain = r.cursor.chain[:L-1]
+	}
+
+	return
+}
+
+/*
+Current returns the node upon which the receiver is positioned, or nil if the
+receiver is stale or uninitialized (see [Cursor.Valid]). The root is returned
+as a [Stack].
+*/
+func (r Cursor) Current() (slice any) {
+	if r.Valid() == nil {
+		if L := len(r.cursor.chain); L == 0 {
+			slice = Stack{r.cursor.root}
+		} else {
+			f := r.cursor.chain[L-1]
+			slice = f.parent.indexRaw(f.idx)
+		}
+	}
+
+	return
+}
+
+/*
+Path returns the indices leading from the root to the current node, in the
+form accepted by [Stack.Traverse]. Negative and forward indices given to
+[Cursor.Down] are reported as the true indices they resolved to. A zero
+length slice is returned while the receiver is positioned at the root.
+*/
+func (r Cursor) Path() (path []int) {
+	path = []int{}
+	if r.cursor != nil {
+		for i := 0; i < len(r.cursor.chain); i++ {
+			path = append(path, r.cursor.chain[i].idx)
+		}
+	}
+
+	return
+}
+
+/*
+Len returns the number of slices within the current node, which may be
+descended using [Cursor.Down]. Zero is returned if the current node cannot
+be descended, or if the receiver is stale or uninitialized.
+*/
+func (r Cursor) Len() (n int) {
+	if r.Valid() == nil {
+		if stk := r.cursor.container(); stk != nil {
+			n = stk.ulen()
+		}
+	}
+
+	return
+}
+
+/*
+Siblings returns the slices of the [Stack] in which the current node resides,
+including the current node itself. Nil is returned if the receiver is at the
+root, or if it is stale or uninitialized.
+*/
+func (r Cursor) Siblings() (slices []any) {
+	if r.Valid() == nil {
+		if L := len(r.cursor.chain); L > 0 {
+			parent := r.cursor.chain[L-1].parent
+			for i := 0; i < parent.ulen(); i++ {
+				slices = append(slices, parent.indexRaw(i))
+			}
+		}
+	}
+
+	return
+}
+
+/*
+container returns the [Stack] which may be descended from the current node
+of the receiver, or nil if the node cannot be descended.
+*/
+func (r *cursor) container() *stack {
+	if L := len(r.chain); L > 0 {
+		return r.chain[L-1].node
+	}
+
+	return r.root
+}
+
+/*
+verify returns an error if any of the first n frames of the receiver no
+longer describe the structure beneath it. Frames whose versions have not
+changed since they were last verified are not examined further.
+*/
+func (r *cursor) verify(n int) (err error) {
+	stk := r.root
+	for i := 0; i < n; i++ {
+		f := &r.chain[i]
+		pver, pok := f.parent.version()
+		nver, nok := f.nodeVersion()
+		if pok && nok && pver == f.pver && nver == f.nver {
+			stk = f.node
+			continue
+		}
+
+		if f.parent != stk {
+			err = wrapErr(ErrStaleCursor, "depth %d: stack was replaced", i+1)
+		} else if cursorIdentity(f.parent.indexRaw(f.idx)) != f.id {
+			err = wrapErr(ErrStaleCursor, "depth %d: index %d no longer holds the expected value", i+1, f.idx)
+		} else if f.node != cursorStack(f.parent.indexRaw(f.idx)) {
+			err = wrapErr(ErrStaleCursor, "depth %d: expression at index %d was replaced", i+1, f.idx)
+		}
+
+		if err != nil {
+			break
+		}
+		f.pver, f.nver = pver, nver
+		stk = f.node
+	}
+
+	return
+}
+
+/*
+nodeVersion returns the version of the node described by the receiver if
+it is a [Condition], alongside a Boolean value indicative of whether the
+version could be read. Other nodes bear no version of interest, as their
+replacement is reflected by the version of the parent.
+*/
+func (r cursorFrame) nodeVersion() (v uint64, ok bool) {
+	if c, isCond := r.id.(*condition); !isCond {
+		ok = true
+	} else if c != nil {
+		v, ok = c.cfg.version()
+	}
+
+	return
+}
+
+/*
+cursorStack returns the embedded stack of x, if x is a [Stack] or a [Condition]
+bearing a [Stack] expression value (or alias of either). Nil is returned
+otherwise.
+*/
+func cursorStack(x any) *stack {
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		return S.stack
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		if S, sok := C.ExpressionAsStack(); sok && S.IsInit() {
+			return S.stack
+		}
+	}
+
+	return nil
+}
+
+/*
+cursorIdentity returns a comparable value identifying x. Instances of [Stack]
+and [Condition] (or aliases of either) are identified by their embedded
+pointer, while other comparable values are identified by themselves. Values
+which are not comparable are identified only by their type.
+*/
+func cursorIdentity(x any) any {
+	if S, ok := stackTypeAliasConverter(x); ok {
+		return S.stack
+	} else if C, ok := conditionTypeAliasConverter(x); ok {
+		return C.condition
+	} else if v := reflect.ValueOf(x); v.IsValid() && !v.Comparable() {
+		return v.Type()
+	}
+
+	return x
+}
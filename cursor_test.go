@@ -0,0 +1,181 @@
+package stackage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleStack_Cursor() {
+	c := exportFixture().Cursor()
+	c.Down(1) // the OR stack
+	c.Down(1) // its second condition
+	fmt.Println(c.Path(), c.Current())
+
+	c.Up()
+	fmt.Println(c.Path(), c.Len())
+	// Output:
+	// [1 1] (objectClass=shareholder)
+	// [1] 2
+}
+
+func TestStack_Cursor(t *testing.T) {
+	S := exportFixture()
+	c := S.Cursor()
+
+	// agreement with Traverse at each step
+	agrees := func() bool {
+		path := c.Path()
+		if len(path) == 0 {
+			return cursorIdentity(c.Current()) == S.stack
+		}
+		slice, ok := S.Traverse(path...)
+		return ok && cursorIdentity(slice) == cursorIdentity(c.Current())
+	}
+
+	for idx, step := range []struct {
+		down bool
+		idx  int
+		want string
+	}{
+		{true, 1, `[1]`},
+		{true, 1, `[1 1]`},
+		{false, 0, `[1]`},
+		{true, 0, `[1 0]`},
+		{false, 0, `[1]`},
+		{false, 0, `[]`},
+		{true, 2, `[2]`},
+		{true, 0, `[2 0]`},
+	} {
+		var err error
+		if step.down {
+			err = c.Down(step.idx)
+		} else {
+			err = c.Up()
+		}
+
+		if got := sprintf("%v", c.Path()); err != nil || got != step.want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s' (%v)", t.Name(), idx, step.want, got, err)
+			return
+		} else if !agrees() {
+			t.Errorf("%s failed [idx:%d]: cursor disagrees with Traverse at %s", t.Name(), idx, got)
+			return
+		}
+	}
+
+	// leaf conditions cannot be descended
+	if err := c.Down(0); err == nil || c.Len() != 0 {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	} else if got := len(c.Siblings()); got != 1 {
+		t.Errorf("%s failed: want %d siblings, got %d", t.Name(), 1, got)
+		return
+	}
+
+	c.Up()
+	c.Up()
+	if err := c.Up(); err == nil {
+		t.Errorf("%s failed: expected error at root, got nil", t.Name())
+		return
+	} else if err = c.Down(3); err == nil || c.Len() != 3 || c.Siblings() != nil {
+		t.Errorf("%s failed: expected error for index 3, got nil", t.Name())
+		return
+	}
+
+	// condition stack expressions are descended
+	E := And().Push(Cond(`k`, Eq, List().Push(`a`, `b`)))
+	ec := E.Cursor()
+	if err := ec.Down(0); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = ec.Down(1); err != nil || ec.Current() != `b` {
+		t.Errorf("%s failed: want '%s', got '%v' (%v)", t.Name(), `b`, ec.Current(), err)
+		return
+	} else if slice, _ := E.Traverse(ec.Path()...); slice != `b` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `b`, slice)
+		return
+	}
+
+	var z Cursor
+	if err := z.Down(0); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+		return
+	} else if err = (Stack{}).Cursor().Valid(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
+
+func TestStack_Cursor_stale(t *testing.T) {
+	S := exportFixture()
+	c := S.Cursor()
+	if err := c.Down(1); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if err = c.Down(1); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// removal of an ancestor's sibling shifts the path
+	S.Remove(0)
+	if err := c.Valid(); !errors.Is(err, ErrStaleCursor) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrStaleCursor, err)
+		return
+	} else if c.Current() != nil || c.Len() != 0 || c.Siblings() != nil {
+		t.Errorf("%s failed: stale cursor yielded content", t.Name())
+		return
+	} else if err = c.Down(0); !errors.Is(err, ErrStaleCursor) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrStaleCursor, err)
+		return
+	} else if err = c.Up(); !errors.Is(err, ErrStaleCursor) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrStaleCursor, err)
+		return
+	}
+
+	// a stale current node may be backed out of
+	c = S.Cursor()
+	c.Down(0)
+	c.Down(1)
+	inner, _ := S.Index(0)
+	inner.(Stack).Remove(1)
+	if err := c.Valid(); !errors.Is(err, ErrStaleCursor) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrStaleCursor, err)
+		return
+	} else if err = c.Up(); err != nil || c.Valid() != nil || c.Len() != 1 {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	// changes which fire no change hook, as well as changes
+	// to nested stacks and expressions, are detected
+	E := And().Push(`a`, Cond(`k`, Eq, List().Push(`x`, `y`)))
+	for idx, change := range []func(){
+		func() { E.Swap(0, 1) },
+		func() { E.Reverse() },
+		func() {
+			C, _ := E.Index(1)
+			C.(Condition).SetExpression(List().Push(`x`, `y`))
+		},
+		func() {
+			C, _ := E.Index(1)
+			L, _ := C.(Condition).ExpressionAsStack()
+			L.Remove(0)
+		},
+	} {
+		ec := E.Cursor()
+		if err := ec.Down(1); err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		} else if err = ec.Down(0); err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		}
+
+		change()
+		if err := ec.Valid(); !errors.Is(err, ErrStaleCursor) {
+			t.Errorf("%s failed [idx:%d]: want '%v', got '%v'", t.Name(), idx, ErrStaleCursor, err)
+			return
+		}
+		E = And().Push(`a`, Cond(`k`, Eq, List().Push(`x`, `y`)))
+	}
+}
@@ -0,0 +1,342 @@
+package stackage
+
+/*
+diff.go contains the Stack.Diff method and its associated types.
+*/
+
+/*
+DiffKind describes the nature of a single [DiffEntry].
+*/
+type DiffKind uint8
+
+/*
+DiffKind constants define the possible kinds of [DiffEntry] instances.
+*/
+const (
+	_                DiffKind = iota
+	DiffAdded                 // slice present only within the other stack
+	DiffRemoved               // slice present only within the receiver
+	DiffChanged               // slices (or Condition components) differ
+	DiffKindMismatch          // stacks are of differing kinds; not descended
+)
+
+/*
+String returns the string representation of the receiver.
+*/
+func (r DiffKind) String() (kind string) {
+	switch r {
+	case DiffAdded:
+		kind = `added`
+	case DiffRemoved:
+		kind = `removed`
+	case DiffChanged:
+		kind = `changed`
+	case DiffKindMismatch:
+		kind = `kind mismatch`
+	}
+
+	return
+}
+
+/*
+DiffField identifies the component of a [Condition] to which a [DiffEntry]
+pertains. The zero value, DiffFieldNone, denotes the slice as a whole.
+*/
+type DiffField uint8
+
+/*
+DiffField constants define the possible [Condition] components of a [DiffEntry].
+*/
+const (
+	DiffFieldNone       DiffField = iota // the slice as a whole
+	DiffFieldKeyword                     // the Condition keyword
+	DiffFieldOperator                    // the Condition operator
+	DiffFieldExpression                  // the Condition expression value(s)
+)
+
+/*
+String returns the string representation of the receiver, which is a
+zero string for DiffFieldNone.
+*/
+func (r DiffField) String() (field string) {
+	switch r {
+	case DiffFieldKeyword:
+		field = `keyword`
+	case DiffFieldOperator:
+		field = `operator`
+	case DiffFieldExpression:
+		field = `expression`
+	}
+
+	return
+}
+
+/*
+DiffEntry describes a single difference perceived by [Stack.Diff].
+
+Path contains the traversal path of the slice concerned, in the form
+accepted by [Stack.Traverse]. The path leads through the receiver, save
+for the final index of a [DiffAdded] entry, which is that of the slice
+within the other stack. [Comment] slices, though disregarded, are counted
+as they are by [Stack.Traverse]. Field, when non-zero, identifies which
+component of the [Condition] found at Path differs. Before and After
+contain the string representations of the value(s) concerned within
+the receiver and the other stack respectively; Before is zero for
+[DiffAdded] entries, while After is zero for [DiffRemoved] entries.
+*/
+type DiffEntry struct {
+	Path   []int
+	Kind   DiffKind
+	Field  DiffField
+	Before string
+	After  string
+}
+
+/*
+String returns the string representation of the receiver, e.g.:
+
+	changed [1 0] keyword: cn -> sn
+	added [2]: value
+*/
+func (r DiffEntry) String() string {
+	loc := sprintf("%v", r.Path)
+	if r.Field != DiffFieldNone {
+		loc += ` ` + r.Field.String()
+	}
+
+	switch r.Kind {
+	case DiffAdded:
+		return sprintf("%s %s: %s", r.Kind, loc, r.After)
+	case DiffRemoved:
+		return sprintf("%s %s: %s", r.Kind, loc, r.Before)
+	}
+
+	return sprintf("%s %s: %s -> %s", r.Kind, loc, r.Before, r.After)
+}
+
+/*
+Diff returns a report of the differences between the receiver and o, which
+must be an initialized [Stack] or [Stack]-alias instance. Whereas [Stack.IsEqual]
+reports only that two stacks differ, each [DiffEntry] returned describes where
+and how they differ. No entries are returned if the two are equal.
+
+Both structures are walked in parallel, and slices are compared using the
+same logic as [Stack.IsEqual], including keyword folding. Comment slices are
+disregarded. Slices are compared positionally unless the receiver compares
+order-insensitively (see [Stack.SetEqualityOrderInsensitive]), in which case
+slices are matched regardless of position. Trailing slices found within only
+one stack are reported as [DiffAdded] or [DiffRemoved] entries.
+
+Nested [Stack] instances are descended, unless they differ in kind, in
+which case a single [DiffKindMismatch] entry is reported. Nested [Condition]
+instances are compared per component: the keyword, operator and expression
+each produce a [DiffChanged] entry bearing the appropriate [DiffField]. A
+[Stack] expression is descended in the manner of [Stack.Traverse].
+
+Any [EqualityPolicy] is disregarded. An error is returned only if either
+instance is uninitialized, if o is not a [Stack], or if either contains a
+cycle (see [Stack.ContainsCycle]).
+*/
+func (r Stack) Diff(o any) (entries []DiffEntry, err error) {
+	S, ok := stackTypeAliasConverter(o)
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "source stack instance is nil")
+	} else if !ok {
+		err = errorf("cannot diff %T against %T; not a stack", r, o)
+	} else if !S.IsInit() {
+		err = wrapErr(ErrNotInitialized, "%T instance is nil", o)
+	} else if err = r.stack.cycleErr(); err == nil {
+		if err = S.stack.cycleErr(); err == nil {
+			entries = r.stack.diff(S.stack, nil, false)
+		}
+	}
+
+	return
+}
+
+/*
+diff is a private method called by [Stack.Diff]. The input path is that of
+the receiver, while fold indicates whether keywords are compared without
+regard to case.
+*/
+func (r *stack) diff(o *stack, path []int, fold bool) (entries []DiffEntry) {
+	if r == o {
+		return
+	}
+
+	fold = fold || r.positive(kfold)
+	if r.stackType() != o.stackType() {
+		return []DiffEntry{newDiffEntry(path, DiffKindMismatch, DiffFieldNone,
+			Stack{r}, Stack{o})}
+	}
+
+	// Comments are disregarded, though paths
+	// bear the indices at which slices reside.
+	ri, oi := r.uncommentedIndices(), o.uncommentedIndices()
+
+	switch r.stackType() {
+	case and, or, list:
+		if r.positive(ueqty) {
+			return r.diffUnordered(o, ri, oi, path, fold)
+		}
+	}
+
+	for i := 0; i < max(len(ri), len(oi)); i++ {
+		switch {
+		case i >= len(oi):
+			entries = append(entries, newDiffEntry(diffPath(path, ri[i]),
+				DiffRemoved, DiffFieldNone, r.indexRaw(ri[i]), nil))
+		case i >= len(ri):
+			entries = append(entries, newDiffEntry(diffPath(path, oi[i]),
+				DiffAdded, DiffFieldNone, nil, o.indexRaw(oi[i])))
+		default:
+			entries = append(entries, diffValues(r.indexRaw(ri[i]),
+				o.indexRaw(oi[i]), diffPath(path, ri[i]), fold)...)
+		}
+	}
+
+	return
+}
+
+/*
+diffPath returns a copy of path bearing index idx as its final element.
+*/
+func diffPath(path []int, idx int) []int {
+	return append(append([]int{}, path...), idx)
+}
+
+/*
+diffUnordered is a private method called by stack.diff when the order-insensitive
+equality bit is set. Slices of the receiver lacking an equal, unmatched slice
+within o are reported as removed, while unmatched slices of o are reported as
+added, each bearing its own index. Only the slices found at the user indices
+ri and oi, respectively, are considered.
+*/
+func (r *stack) diffUnordered(o *stack, ri, oi []int, path []int, fold bool) (entries []DiffEntry) {
+	matched := make([]bool, len(oi))
+	for _, i := range ri {
+		var found bool
+		for j := 0; j < len(oi) && !found; j++ {
+			if !matched[j] {
+				found = foldEqual(r.indexRaw(i), o.indexRaw(oi[j]), fold) == nil
+				matched[j] = found
+			}
+		}
+
+		if !found {
+			entries = append(entries, newDiffEntry(diffPath(path, i),
+				DiffRemoved, DiffFieldNone, r.indexRaw(i), nil))
+		}
+	}
+
+	for j := 0; j < len(matched); j++ {
+		if !matched[j] {
+			entries = append(entries, newDiffEntry(diffPath(path, oi[j]),
+				DiffAdded, DiffFieldNone, nil, o.indexRaw(oi[j])))
+		}
+	}
+
+	return
+}
+
+/*
+diffValues returns the differences between slices x and y, both of which
+reside at the input path. Stacks and conditions are descended, while all
+other values are compared as a whole.
+*/
+func diffValues(x, y any, path []int, fold bool) []DiffEntry {
+	if xs, ok := stackTypeAliasConverter(x); ok && xs.IsInit() {
+		if ys, yok := stackTypeAliasConverter(y); yok && ys.IsInit() {
+			return xs.stack.diff(ys.stack, path, fold)
+		}
+	} else if xc, ok := conditionTypeAliasConverter(x); ok && xc.IsInit() {
+		if yc, yok := conditionTypeAliasConverter(y); yok && yc.IsInit() {
+			return xc.condition.diff(yc.condition, path, fold)
+		}
+	}
+
+	if foldEqual(x, y, fold) != nil {
+		return []DiffEntry{newDiffEntry(path, DiffChanged, DiffFieldNone, x, y)}
+	}
+
+	return nil
+}
+
+/*
+diff returns the differences between the components of the receiver and
+those of o, both of which reside at the input path. A single-valued [Stack]
+expression found within both is descended.
+*/
+func (r *condition) diff(o *condition, path []int, fold bool) (entries []DiffEntry) {
+	if r == o {
+		return
+	}
+
+	fold = fold || r.positive(kfold)
+	if !r.matchesKeyword(o.kw, fold) {
+		entries = append(entries, newDiffEntry(path, DiffChanged,
+			DiffFieldKeyword, r.kw, o.kw))
+	}
+
+	if !operatorsEqual(r.op, o.op) {
+		entries = append(entries, newDiffEntry(path, DiffChanged,
+			DiffFieldOperator, r.op, o.op))
+	}
+
+	if len(r.exv) == 0 && len(o.exv) == 0 {
+		xs, xok := stackTypeAliasConverter(r.ex)
+		ys, yok := stackTypeAliasConverter(o.ex)
+		if xok && yok && xs.IsInit() && ys.IsInit() {
+			return append(entries, xs.stack.diff(ys.stack, path, fold)...)
+		}
+	}
+
+	var differ bool
+	if differ = len(r.exv) != len(o.exv); !differ {
+		for i := 0; i < len(r.exv) && !differ; i++ {
+			differ = foldEqual(r.exv[i], o.exv[i], fold) != nil
+		}
+		differ = differ || foldEqual(r.ex, o.ex, fold) != nil
+	}
+
+	if differ {
+		entries = append(entries, newDiffEntry(path, DiffChanged,
+			DiffFieldExpression, r.valuesString(nil), o.valuesString(nil)))
+	}
+
+	return
+}
+
+/*
+operatorsEqual returns a Boolean value indicative of whether the input
+[Operator] instances bear the same string and context values.
+*/
+func operatorsEqual(x, y Operator) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	return x.String() == y.String() && x.Context() == y.Context()
+}
+
+/*
+newDiffEntry returns a new instance of [DiffEntry] bearing the string
+representations of the before and after values. A nil value produces
+a zero string.
+*/
+func newDiffEntry(path []int, kind DiffKind, field DiffField, before, after any) DiffEntry {
+	str := func(x any) (s string) {
+		if x != nil {
+			s = sprintf("%v", x)
+		}
+		return
+	}
+
+	return DiffEntry{
+		Path:   append([]int{}, path...),
+		Kind:   kind,
+		Field:  field,
+		Before: str(before),
+		After:  str(after),
+	}
+}
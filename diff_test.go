@@ -0,0 +1,127 @@
+package stackage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleStack_Diff() {
+	a := And().Push(Cond(`cn`, Eq, `Jesse`), `x`)
+	b := And().Push(Cond(`sn`, Eq, `Jesse`), `x`, `y`)
+
+	entries, _ := a.Diff(b)
+	for _, entry := range entries {
+		fmt.Println(entry)
+	}
+	// Output:
+	// changed [0] keyword: cn -> sn
+	// added [2]: y
+}
+
+func TestStack_Diff(t *testing.T) {
+	orig := nightmareStack()
+
+	// change one leaf
+	mod := nightmareStack()
+	leaf, _ := mod.Traverse(1, 1, 1, 0, 0)
+	leaf.(Condition).SetExpression(`Thursday`)
+
+	entries, err := orig.Diff(mod)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if len(entries) != 1 {
+		t.Errorf("%s failed: want '%d' entries, got '%d' (%v)", t.Name(), 1, len(entries), entries)
+		return
+	}
+
+	entry := entries[0]
+	if got, want := sprintf("%v", entry.Path), `[1 1 1 0 0]`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if entry.Kind != DiffChanged || entry.Field != DiffFieldExpression {
+		t.Errorf("%s failed: want '%s %s', got '%s %s'", t.Name(),
+			DiffChanged, DiffFieldExpression, entry.Kind, entry.Field)
+		return
+	} else if entry.Before != `Wednesday` || entry.After != `Thursday` {
+		t.Errorf("%s failed: unexpected before/after values: %s", t.Name(), entry)
+		return
+	}
+
+	// change a condition residing within another condition's expression
+	mod = nightmareStack()
+	leaf, _ = mod.Traverse(1, 0, 0)
+	leaf.(Condition).SetKeyword(`otherkeyword`)
+	if entries, _ = orig.Diff(mod); len(entries) != 1 ||
+		sprintf("%v", entries[0].Path) != `[1 0 0]` ||
+		entries[0].Field != DiffFieldKeyword {
+		t.Errorf("%s failed: unexpected entries: %v", t.Name(), entries)
+		return
+	}
+
+	// remove a slice
+	mod = nightmareStack()
+	mod.Remove(2)
+	if entries, _ = orig.Diff(mod); len(entries) != 1 ||
+		sprintf("%v", entries[0].Path) != `[2]` ||
+		entries[0].Kind != DiffRemoved ||
+		entries[0].Before != `this2` {
+		t.Errorf("%s failed: unexpected entries: %v", t.Name(), entries)
+		return
+	}
+
+	// no differences
+	if entries, err = orig.Diff(nightmareStack()); err != nil || len(entries) != 0 {
+		t.Errorf("%s failed: unexpected entries: %v (%v)", t.Name(), entries, err)
+		return
+	}
+
+	// kind mismatch at the root
+	if entries, _ = And().Push(`a`).Diff(Or().Push(`a`)); len(entries) != 1 ||
+		len(entries[0].Path) != 0 ||
+		entries[0].Kind != DiffKindMismatch {
+		t.Errorf("%s failed: unexpected entries: %v", t.Name(), entries)
+		return
+	}
+
+	// order-insensitive comparison
+	if entries, _ = List().SetEqualityOrderInsensitive(true).Push(`a`, `b`).
+		Diff(List().Push(`c`, `a`)); len(entries) != 2 ||
+		entries[0].Kind != DiffRemoved || entries[0].Before != `b` ||
+		entries[1].Kind != DiffAdded || entries[1].After != `c` {
+		t.Errorf("%s failed: unexpected entries: %v", t.Name(), entries)
+		return
+	}
+
+	// comments are disregarded, yet counted within paths
+	commented := List().Push(Comment(`note`), `a`, List().Push(Comment(`inner`), `x`))
+	for idx, tst := range []struct {
+		O    Stack
+		Want string
+	}{
+		{List().Push(`b`, List().Push(`x`)), `[changed [1]: a -> b]`},
+		{List().Push(`a`, List().Push(`y`)), `[changed [2 1]: x -> y]`},
+		{List().Push(`a`, List().Push(`x`), Comment(`c`), `z`), `[added [3]: z]`},
+		{List().Push(`a`), `[removed [2]: x]`},
+	} {
+		if entries, _ = commented.Diff(tst.O); sprintf("%v", entries) != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%v'", t.Name(), idx, tst.Want, entries)
+			return
+		}
+	}
+
+	if entries, _ = commented.SetEqualityOrderInsensitive(true).
+		Diff(List().Push(Comment(`c`), `z`, List().Push(`x`))); sprintf("%v", entries) != `[removed [1]: a added [1]: z]` {
+		t.Errorf("%s failed: unexpected entries: %v", t.Name(), entries)
+		return
+	}
+
+	var z Stack
+	if _, err = z.Diff(orig); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+		return
+	} else if _, err = orig.Diff(`bogus`); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+	}
+}
@@ -44,6 +44,31 @@ being added to a [Stack] via its [Stack.Push] method.
 */
 type PushPolicy func(...any) error
 
+/*
+PushPolicyContext is a first-class (closure) function signature that
+serves the same purpose as [PushPolicy], but which is also provided an
+instance of [PushContext] describing the receiving [Stack]. This allows
+a single closure to be shared by any number of [Stack] instances.
+
+A PushPolicyContext function or method is executed for each element
+being added to a [Stack] via its [Stack.Push] method. It must not alter
+the receiver. See [Stack.SetPushPolicyContext].
+*/
+type PushPolicyContext func(PushContext, any) error
+
+/*
+PushContext describes a single prospective [Stack.Push] element to a
+[PushPolicyContext] closure.
+*/
+type PushContext struct {
+	Receiver    Interface // the receiving Stack
+	Index       int       // user index the element would occupy
+	Len         int       // user length of the receiver prior to the element
+	Cap         int       // user capacity of the receiver, or -1 if unlimited
+	IsStack     bool      // element is a Stack or Stack alias
+	IsCondition bool      // element is a Condition or Condition alias
+}
+
 /*
 ValidityPolicy is a first-class (closure) function signature
 that may be leveraged by users in order to better gauge the
@@ -126,3 +151,34 @@ type Marshaler func(...any) (err error)
 LessFunc qualifies for [sort.Interface].
 */
 type LessFunc func(int, int) bool
+
+/*
+ChangeHook is a first-class (closure) function signature that may be
+leveraged by users in order to be notified of changes to the contents
+of a [Stack] or [Stack]-alias instance.
+
+The operation name, the affected user index (or -1 for whole-stack
+operations) and the slice involved (if any) are provided as input.
+See [Stack.SetChangeHook] for details.
+*/
+type ChangeHook func(op string, idx int, value any)
+
+/*
+MergePolicy is a first-class (closure) function signature that may be
+leveraged by users in order to control the outcome of a [Stack.Merge]
+operation for each incoming slice.
+
+The existing input value is the slice already present within the
+destination which is equal to the incoming slice, or nil if no such
+slice was found. The function returns the value to keep (keep), and
+a Boolean value indicative of whether the incoming slice should be
+skipped outright (skip).
+
+When no existing slice was found and skip is false, keep is appended
+to the destination. When an existing slice was found and skip is false,
+keep replaces the existing slice in place.
+
+If no MergePolicy is provided, the default policy appends incoming
+slices that are not already present and skips all others.
+*/
+type MergePolicy func(existing, incoming any) (keep any, skip bool)
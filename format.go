@@ -0,0 +1,507 @@
+package stackage
+
+/*
+format.go contains the fmt.Formatter implementations and ExportGo
+methods extended by the Stack and Condition types.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+/*
+Format implements the [fmt.Formatter] interface, allowing the receiver
+to be presented using the following verbs:
+
+  - %s and %v produce the string representation, as with [Stack.String]
+  - %q produces the string representation quoted with [strconv.Quote]
+  - %+v produces a multi-line, indented tree view suitable for debugging
+  - %#v produces a Go-like construction expression, e.g.: And().Push(...)
+
+Any other verb produces the standard [fmt] "bad verb" notation.
+*/
+func (r Stack) Format(f fmt.State, verb rune) {
+	formatNode(f, verb, r, r.String)
+}
+
+/*
+Format implements the [fmt.Formatter] interface, allowing the receiver
+to be presented using the verbs described by [Stack.Format].
+*/
+func (r Condition) Format(f fmt.State, verb rune) {
+	formatNode(f, verb, r, r.String)
+}
+
+/*
+formatNode is a private function called by [Stack.Format] and
+[Condition.Format]. The input stringer (str) supplies the string
+representation of x.
+*/
+func formatNode(f fmt.State, verb rune, x any, str func() string) {
+	var out string
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		out = join(formatTree(x, 0, nil, visitSet{}), "\n")
+	case verb == 'v' && f.Flag('#'):
+		out = formatGo(x, visitSet{})
+	case verb == 'v', verb == 's':
+		out = str()
+	case verb == 'q':
+		out = qt(str())
+	default:
+		out = sprintf("%%!%c(%T=%s)", verb, x, str())
+	}
+
+	fmt.Fprint(f, out)
+}
+
+/*
+formatTree appends the tree view lines of x, indented per depth, to lines,
+returning the result. [Stack] and [Condition] aliases are resolved using
+the alias converters. A [Stack] already present within seen, which holds
+those of the current path, is rendered using recursiveSentinel.
+*/
+func formatTree(x any, depth int, lines []string, seen visitSet) []string {
+	pad := sprintf("%*s", depth*2, ``)
+
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		if seen[S.stack] {
+			return append(lines, pad+recursiveSentinel)
+		}
+		seen[S.stack] = true
+		defer delete(seen, S.stack)
+
+		lines = append(lines, pad+S.stack.stackType().String())
+		for i := 1; i < S.stack.len(); i++ {
+			lines = formatTree((*S.stack)[i], depth+1, lines, seen)
+		}
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		line := pad + C.Keyword()
+		if C.Operator() != nil {
+			line += ` ` + C.condition.opString()
+		}
+
+		if S, sok := C.ExpressionAsStack(); sok && S.IsInit() {
+			lines = formatTree(S, depth+1, append(lines, line), seen)
+		} else {
+			var vals []string
+			for _, ex := range C.Expressions() {
+				vals = append(vals, sprintf("%v", ex))
+			}
+			lines = append(lines, line+` `+join(vals, `, `))
+		}
+	} else {
+		lines = append(lines, sprintf("%s%v", pad, x))
+	}
+
+	return lines
+}
+
+/*
+formatGo returns the Go-like construction expression of x. [Stack] and
+[Condition] aliases are resolved using the alias converters. See the
+formatTree function regarding seen.
+*/
+func formatGo(x any, seen visitSet) (s string) {
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		if seen[S.stack] {
+			return recursiveSentinel
+		}
+		seen[S.stack] = true
+		defer delete(seen, S.stack)
+
+		var vals []string
+		for i := 1; i < S.stack.len(); i++ {
+			vals = append(vals, formatGo((*S.stack)[i], seen))
+		}
+
+		if s = goConstructor(S.stack.stackType()); len(vals) > 0 {
+			s += `.Push(` + join(vals, `, `) + `)`
+		}
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		s = sprintf("Cond(%q, %s, %s)", C.Keyword(),
+			formatGoOperator(C.Operator()), formatGo(C.Expression(), seen))
+	} else {
+		s = sprintf("%#v", x)
+	}
+
+	return
+}
+
+/*
+formatGoOperator returns the Go-like expression of op, which is either
+the name of a [ComparisonOperator] constant or the %#v representation of
+any other [Operator].
+*/
+func formatGoOperator(op Operator) string {
+	if cop, ok := op.(ComparisonOperator); ok {
+		switch cop {
+		case Eq:
+			return `Eq`
+		case Ne:
+			return `Ne`
+		case Lt:
+			return `Lt`
+		case Gt:
+			return `Gt`
+		case Le:
+			return `Le`
+		case Ge:
+			return `Ge`
+		}
+	}
+
+	return sprintf("%#v", op)
+}
+
+/*
+goConstructor returns the Go expression which initializes a new [Stack]
+of the input kind, e.g.: And().
+*/
+func goConstructor(typ stackType) (ctor string) {
+	switch typ {
+	case and:
+		ctor = `And()`
+	case or:
+		ctor = `Or()`
+	case not:
+		ctor = `Not()`
+	case list:
+		ctor = `List()`
+	default:
+		ctor = `Basic()`
+	}
+
+	return
+}
+
+/*
+ExportGo returns Go source code which reconstructs the receiver using the
+package's own constructors and setters, e.g.:
+
+	And().SetParen(true).Push(Cond("objectClass", Eq, "employee"), Or().Push(...))
+
+Unlike the %#v verb of [Stack.Format], presentation settings which differ
+from their defaults are included, namely those copied by the method named
+[Stack.CopyPresentation], as well as the ordering, identifier and category.
+Nested [Stack] and [Condition] instances, including those which serve as
+[Condition] expression values, are exported recursively. Instances of an
+alias type are converted to that type by name.
+
+The output presumes the package's identifiers are in scope, as is the case
+within this package or following a dot import. Values which cannot be
+expressed as Go literals, such as functions and channels, are rendered as
+commented nil placeholders, in which case an error is returned alongside
+the best-effort output. The same applies to [Operator] types not provided
+by this package, as well as to cyclical references.
+*/
+func (r Stack) ExportGo() (src string, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "%T instance is nil", r)
+		return
+	}
+
+	x := newGoExporter()
+	src = x.export(r)
+	err = errors.Join(x.errs...)
+
+	return
+}
+
+/*
+ExportGo returns Go source code which reconstructs the receiver, as
+described by the [Stack.ExportGo] method.
+*/
+func (r Condition) ExportGo() (src string, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "%T instance is nil", r)
+		return
+	}
+
+	x := newGoExporter()
+	src = x.export(r)
+	err = errors.Join(x.errs...)
+
+	return
+}
+
+/*
+goExporter retains the state of a single execution of [Stack.ExportGo]
+or [Condition.ExportGo]. The active map contains the stacks currently
+being exported, thereby revealing cyclical references, while errs holds
+every problem perceived along the way.
+*/
+type goExporter struct {
+	active map[*stack]struct{}
+	errs   []error
+}
+
+func newGoExporter() *goExporter {
+	return &goExporter{active: make(map[*stack]struct{})}
+}
+
+/*
+goSettingFlags contains the presentation bits exported by goExporter
+alongside the names of their respective setter methods, in the order
+in which the setters are emitted.
+*/
+var goSettingFlags = []struct {
+	flag   cfgFlag
+	method string
+}{
+	{parens, `SetParen`},
+	{cfold, `SetFold`},
+	{lonce, `SetLeadOnce`},
+	{nspad, `SetNoPadding`},
+}
+
+/*
+export returns the Go expression of x, which may be of any type.
+*/
+func (r *goExporter) export(x any) string {
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		return r.alias(x, nativeStack, r.stack(S))
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		return r.alias(x, nativeCondition, r.condition(C))
+	}
+
+	return r.value(x)
+}
+
+/*
+alias wraps src within a conversion to the type of x, if x is an instance
+of a named alias of the native type.
+*/
+func (r *goExporter) alias(x any, native reflect.Type, src string) string {
+	if typ := typOf(x); typ != native && len(typ.Name()) > 0 && native.ConvertibleTo(typ) {
+		src = typ.Name() + `(` + src + `)`
+	}
+
+	return src
+}
+
+/*
+stack returns the Go expression of S, including its non-default
+presentation settings and its slices.
+*/
+func (r *goExporter) stack(S Stack) (src string) {
+	if _, cyclic := r.active[S.stack]; cyclic {
+		return r.placeholder(`cyclic reference`,
+			errorf("cyclic %T reference cannot be exported", S))
+	}
+	r.active[S.stack] = struct{}{}
+	defer delete(r.active, S.stack)
+
+	sc, _ := S.config()
+	if src = goConstructor(sc.typ); sc.ord {
+		src += `.SetFIFO(true)`
+	} else if sc.pri {
+		src += `.SetPriority(true)`
+	}
+
+	src += r.settings(sc)
+	if len(sc.sym) > 0 && sc.typ != list {
+		src += `.SetSymbol(` + qt(sc.sym) + `)`
+	}
+
+	if len(sc.ljs) > 0 {
+		var delims []string
+		for _, delim := range sc.ljs {
+			delims = append(delims, qt(delim))
+		}
+		src += `.SetDelimiters(` + join(delims, `, `) + `)`
+	} else if len(sc.ljc) > 0 {
+		src += `.SetDelimiter(` + qt(sc.ljc) + `)`
+	}
+
+	if sc.spd != nil {
+		src += `.SetSymbolPadding(` + bool2str(*sc.spd) + `)`
+	}
+
+	if sc.inh {
+		src += `.SetInheritPresentation(true)`
+	}
+
+	var vals []string
+	for i := 0; i < S.stack.ulen(); i++ {
+		vals = append(vals, r.export(S.stack.indexRaw(i)))
+	}
+
+	if len(vals) > 0 {
+		src += `.Push(` + join(vals, `, `) + `)`
+	}
+
+	return
+}
+
+/*
+condition returns the Go expression of C, including its non-default
+presentation settings and all of its expression values.
+*/
+func (r *goExporter) condition(C Condition) (src string) {
+	var vals []string
+	for _, ex := range C.Expressions() {
+		vals = append(vals, r.export(ex))
+	}
+
+	ex := `nil`
+	if len(vals) > 0 {
+		ex = vals[0]
+	}
+
+	src = sprintf("Cond(%s, %s, %s)", r.value(C.KeywordValue()),
+		r.operator(C.Operator()), ex)
+	if len(vals) > 1 {
+		src += `.AddExpressionValue(` + join(vals[1:], `, `) + `)`
+	}
+
+	cfg := C.condition.cfg
+	if src += r.settings(cfg); cfg.epr {
+		src += `.SetExpressionParen(true)`
+	}
+
+	if len(cfg.ljc) > 0 {
+		src += `.SetValueDelimiter(` + qt(cfg.ljc) + `)`
+	}
+
+	return
+}
+
+/*
+settings returns the setter calls, common to stacks and conditions, which
+reproduce the non-default presentation settings found within cfg.
+*/
+func (r *goExporter) settings(cfg *nodeConfig) (src string) {
+	for _, setting := range goSettingFlags {
+		if cfg.positive(setting.flag) {
+			src += `.` + setting.method + `(true)`
+		}
+	}
+
+	if cfg.pst != nil {
+		src += sprintf(".SetPaddingStyle(PaddingStyle(%d))", *cfg.pst)
+	}
+
+	if len(cfg.enc) > 0 {
+		var encs []string
+		for _, enc := range cfg.enc {
+			encs = append(encs, sprintf("%#v", enc))
+		}
+		src += `.SetEncap(` + join(encs, `, `) + `)`
+	}
+
+	if len(cfg.id) > 0 {
+		src += `.SetID(` + qt(cfg.id) + `)`
+	}
+
+	if len(cfg.cat) > 0 {
+		src += `.SetCategory(` + qt(cfg.cat) + `)`
+	}
+
+	return
+}
+
+/*
+operator returns the Go expression of op. Only the [ComparisonOperator]
+constants can be expressed; any other [Operator] is rendered as a
+placeholder.
+*/
+func (r *goExporter) operator(op Operator) string {
+	if op == nil {
+		return `nil`
+	} else if cop, ok := op.(ComparisonOperator); ok && cop.Valid() {
+		return formatGoOperator(cop)
+	}
+
+	return r.placeholder(sprintf("%T operator", op),
+		errorf("%T operator cannot be exported", op))
+}
+
+/*
+value returns the Go literal of x, which is neither a [Stack] nor a
+[Condition]. Values of named types are converted by name, while values
+which cannot be expressed as literals are rendered as placeholders.
+*/
+func (r *goExporter) value(x any) string {
+	switch tv := x.(type) {
+	case nil:
+		return `nil`
+	case string:
+		return qt(tv)
+	case int, bool:
+		return sprintf("%v", tv)
+	case Comment:
+		return `Comment(` + qt(string(tv)) + `)`
+	case pendingType:
+		return `PendingExpression`
+	}
+
+	typ := typOf(x)
+	if lit, ok := goLiteral(valOf(x)); ok {
+		return typ.Name() + `(` + lit + `)`
+	} else if isGoLiteralType(typ) {
+		return sprintf("%#v", x)
+	}
+
+	return r.placeholder(typ.String(), errorf("%T value cannot be exported", x))
+}
+
+/*
+placeholder records err and returns a nil placeholder bearing a comment
+describing the value which could not be exported.
+*/
+func (r *goExporter) placeholder(desc string, err error) string {
+	r.errs = append(r.errs, err)
+	return `nil /* unsupported ` + desc + ` */`
+}
+
+/*
+goLiteral returns the Go literal of the Boolean, numeric or string value
+held by v, disregarding any methods of its type, alongside a Boolean value
+indicative of success.
+*/
+func goLiteral(v reflect.Value) (lit string, ok bool) {
+	ok = true
+	switch v.Kind() {
+	case reflect.Bool:
+		lit = bool2str(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lit = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lit = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		lit = strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Complex64, reflect.Complex128:
+		lit = strconv.FormatComplex(v.Complex(), 'g', -1, v.Type().Bits())
+	case reflect.String:
+		lit = qt(v.String())
+	default:
+		ok = false
+	}
+
+	return
+}
+
+/*
+isGoLiteralType returns a Boolean value indicative of whether the %#v
+representation of a value of the input type is a valid Go literal, which
+is the case for predeclared Boolean, numeric and string types, as well as
+unnamed slices, arrays and maps thereof.
+*/
+func isGoLiteralType(typ reflect.Type) (is bool) {
+	switch k := typ.Kind(); k {
+	case reflect.Slice, reflect.Array:
+		is = len(typ.Name()) == 0 && isGoLiteralType(typ.Elem())
+	case reflect.Map:
+		is = len(typ.Name()) == 0 && isGoLiteralType(typ.Key()) &&
+			isGoLiteralType(typ.Elem())
+	default:
+		_, basic := goLiteral(reflect.Zero(typ))
+		is = basic && typ.Name() == k.String()
+	}
+
+	return
+}
@@ -0,0 +1,210 @@
+package stackage
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleStack_Format() {
+	stk := And().Push(`a`, Cond(`b`, Ne, 1))
+	fmt.Printf("%#v", stk)
+	// Output: And().Push("a", Cond("b", Ne, 1))
+}
+
+func TestStack_Format(t *testing.T) {
+	stk := Basic().Push(
+		1,
+		Basic().Push(2),
+		Cond(`keyword`, Eq, Basic().Push(3)),
+	)
+
+	want := join([]string{
+		`BASIC`,
+		`  1`,
+		`  BASIC`,
+		`    2`,
+		`  keyword =`,
+		`    BASIC`,
+		`      3`,
+	}, "\n")
+
+	if got := sprintf("%+v", stk); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	want = `Basic().Push(1, Basic().Push(2), Cond("keyword", Eq, Basic().Push(3)))`
+	if got := sprintf("%#v", stk); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// cyclical stacks terminate
+	cyc := And().Push(`x`)
+	cyc.Push(cyc)
+	want = join([]string{`AND`, `  x`, `  ` + recursiveSentinel}, "\n")
+	if got := sprintf("%+v", cyc); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	want = `And().Push("x", ` + recursiveSentinel + `)`
+	if got := sprintf("%#v", cyc); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	list := List().Push(`a`, `b`)
+	for verb, want := range map[string]string{
+		`%s`: `a b`,
+		`%v`: `a b`,
+		`%q`: `"a b"`,
+		`%d`: `%!d(stackage.Stack=a b)`,
+	} {
+		if got := sprintf(verb, list); got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+			return
+		}
+	}
+}
+
+func TestCondition_Format(t *testing.T) {
+	type customCondition Condition
+	c := Cond(`keyword`, Ne, Or().Push(`a`, customCondition(Cond(`b`, Eq, `c`))))
+
+	want := join([]string{
+		`keyword !=`,
+		`  OR`,
+		`    a`,
+		`    b = c`,
+	}, "\n")
+
+	if got := sprintf("%+v", c); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if got, want := sprintf("%q", Cond(`a`, Eq, `b`)), `"a = b"`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+/*
+exportFixture returns the LDAP filter fixture used by the ExportGo tests.
+*/
+func exportFixture() Stack {
+	maker := func(r Stack) Stack {
+		return r.Paren().LeadOnce().NoPadding()
+	}
+
+	return maker(And().Symbol('&')).Push(
+		Cond(`objectClass`, Eq, `employee`).NoPadding().Paren(),
+		maker(Or().Symbol('|')).Push(
+			Cond(`objectClass`, Eq, `engineeringLead`).NoPadding().Paren(),
+			Cond(`objectClass`, Eq, `shareholder`).NoPadding().Paren(),
+		),
+		maker(Not().Symbol('!')).Push(
+			Cond(`drink`, Eq, `beer`).NoPadding().Paren(),
+		),
+	)
+}
+
+func TestStack_ExportGo(t *testing.T) {
+	src, err := exportFixture().ExportGo()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	cond := func(kw, val string) string {
+		return `Cond("` + kw + `", Eq, "` + val + `").SetParen(true).SetNoPadding(true)`
+	}
+	stk := func(ctor, sym string) string {
+		return ctor + `.SetParen(true).SetLeadOnce(true).SetNoPadding(true).SetSymbol("` + sym + `")`
+	}
+
+	want := stk(`And()`, `&`) + `.Push(` + cond(`objectClass`, `employee`) + `, ` +
+		stk(`Or()`, `|`) + `.Push(` + cond(`objectClass`, `engineeringLead`) + `, ` +
+		cond(`objectClass`, `shareholder`) + `), ` +
+		stk(`Not()`, `!`) + `.Push(` + cond(`drink`, `beer`) + `))`
+	if src != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, src)
+		return
+	}
+
+	// non-default settings, literals and aliases
+	type customStack Stack
+	src, err = List().SetDelimiter(`,`).SetID(`x`).Push(
+		customStack(Basic().Push(int8(3), 1.5, []string{`a`}, Comment(`note`))),
+		Cond(`a`, Ge, 3).AddExpressionValue(4).SetExpressionParen(true),
+	).ExportGo()
+	want = `List().SetID("x").SetDelimiter(",").Push(customStack(Basic().Push(int8(3), ` +
+		`float64(1.5), []string{"a"}, Comment("note"))), ` +
+		`Cond("a", Ge, 3).AddExpressionValue(4).SetExpressionParen(true))`
+	if err != nil || src != want {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), want, src, err)
+		return
+	}
+
+	// unsupported values produce best-effort output
+	src, err = Basic().Push(`a`, func() {}).ExportGo()
+	want = `Basic().Push("a", nil /* unsupported func() */)`
+	if err == nil || src != want {
+		t.Errorf("%s failed: want '%s' and an error, got '%s' (%v)", t.Name(), want, src, err)
+		return
+	}
+
+	var z Stack
+	if _, err = z.ExportGo(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
+
+func TestStack_ExportGo_construct(t *testing.T) {
+	orig := exportFixture()
+	src, _ := orig.ExportGo()
+
+	// Recreated by hand from the output verified by TestStack_ExportGo.
+	built := And().SetParen(true).SetLeadOnce(true).SetNoPadding(true).SetSymbol("&").Push(
+		Cond("objectClass", Eq, "employee").SetParen(true).SetNoPadding(true),
+		Or().SetParen(true).SetLeadOnce(true).SetNoPadding(true).SetSymbol("|").Push(
+			Cond("objectClass", Eq, "engineeringLead").SetParen(true).SetNoPadding(true),
+			Cond("objectClass", Eq, "shareholder").SetParen(true).SetNoPadding(true),
+		),
+		Not().SetParen(true).SetLeadOnce(true).SetNoPadding(true).SetSymbol("!").Push(
+			Cond("drink", Eq, "beer").SetParen(true).SetNoPadding(true),
+		),
+	)
+
+	if want, got := orig.String(), built.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if got, _ := built.ExportGo(); got != src {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), src, got)
+	}
+}
+
+func TestCondition_ExportGo(t *testing.T) {
+	c := Cond(`cn`, Ne, Or().Push(`a`, `b`)).SetEncap(`"`).SetCategory(`name`)
+	want := `Cond("cn", Ne, Or().Push("a", "b")).SetEncap([]string{"\""}).SetCategory("name")`
+	if got, err := c.ExportGo(); err != nil || got != want {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), want, got, err)
+		return
+	}
+
+	// custom operators cannot be exported
+	c = Cond(`cn`, fakeOperator{Str: `IN`, Ctx: `list`}, `x`)
+	want = `Cond("cn", nil /* unsupported stackage.fakeOperator operator */, "x")`
+	if got, err := c.ExportGo(); err == nil || got != want {
+		t.Errorf("%s failed: want '%s' and an error, got '%s' (%v)", t.Name(), want, got, err)
+		return
+	}
+
+	var z Condition
+	if _, err := z.ExportGo(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
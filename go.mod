@@ -1,6 +1,6 @@
 module github.com/JesseCoretta/go-stackage
 
-go 1.20
+go 1.21
 
 retract [v0.0.1-alpha.0, v0.0.3-alpha.1]
 
@@ -0,0 +1,157 @@
+package stackage
+
+/*
+interpolate.go contains the placeholder substitution features extended
+by the Stack type.
+*/
+
+/*
+Interpolate returns a deep copy of the receiver in which placeholders of
+the form {name} found within the string expression values of [Condition]
+instances -- at any depth -- are replaced with the corresponding values
+of vars. The receiver is not modified.
+
+An expression value consisting of a single placeholder is replaced with
+the corresponding value as-is, thereby preserving its type. For example,
+an expression value of "{age}" becomes the int 21 given a vars entry of
+"age" and 21. Placeholders found within larger string expression values
+are replaced with the string representation of the corresponding value,
+as produced by the %v verb. Placeholder names may not contain braces or
+whitespace.
+
+An error listing the name and path of each placeholder lacking a value
+within vars is returned, unless partial is true, in which case such
+placeholders remain in place. In the former case, the returned [Stack]
+is uninitialized. Paths are suitable for submission to [Stack.Traverse].
+*/
+func (r Stack) Interpolate(vars map[string]any, partial ...bool) (S Stack, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "Not initialized")
+		return
+	}
+
+	r.stack.lock()
+	C := r.stack.clone(make(map[*stack]*stack))
+	r.stack.unlock()
+
+	var unresolved []string
+	C.interpolate(vars, nil, visitSet{C: true}, &unresolved)
+	if len(unresolved) > 0 && !(len(partial) > 0 && partial[0]) {
+		err = errorf("unresolved placeholders: %s", join(unresolved, `; `))
+		return
+	}
+
+	S = Stack{C}
+	return
+}
+
+/*
+interpolate is a private method called by [Stack.Interpolate]. The input
+path is that of the receiver, while seen contains those instances present
+along the current path. A description of each unresolved placeholder is
+appended to unresolved.
+*/
+func (r *stack) interpolate(vars map[string]any, path []int, seen visitSet, unresolved *[]string) {
+	for i := 1; i < r.len(); i++ {
+		p := append(append(make([]int, 0, len(path)+1), path...), i-1)
+
+		var inner Stack
+		if S, ok := stackTypeAliasConverter((*r)[i]); ok {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter((*r)[i]); ok && C.IsInit() {
+			C.condition.interpolate(vars, p, unresolved)
+			inner, _ = C.ExpressionAsStack()
+		}
+
+		if inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			inner.stack.interpolate(vars, p, seen, unresolved)
+			delete(seen, inner.stack)
+		}
+	}
+}
+
+/*
+interpolate is a private method called by stack.interpolate. The input
+path is that of the receiver.
+*/
+func (r *condition) interpolate(vars map[string]any, path []int, unresolved *[]string) {
+	var missing []string
+	if len(r.exv) > 0 {
+		for i := 0; i < len(r.exv); i++ {
+			r.exv[i] = interpolateValue(r.exv[i], vars, &missing)
+		}
+		r.ex = r.exv[0]
+	} else {
+		r.ex = interpolateValue(r.ex, vars, &missing)
+	}
+
+	for i := 0; i < len(missing); i++ {
+		*unresolved = append(*unresolved, `slice `+pathString(path)+`: {`+missing[i]+`}`)
+	}
+
+	r.cfg.dropString()
+}
+
+/*
+interpolateValue returns x following the substitution of any placeholders
+it contains, if it is a string. The name of each placeholder lacking a value
+within vars is appended to missing.
+*/
+func interpolateValue(x any, vars map[string]any, missing *[]string) any {
+	s, ok := x.(string)
+	if !ok {
+		return x
+	}
+
+	if name, n := nextPlaceholder(s); n == 0 && len(name)+2 == len(s) {
+		// whole-value placeholder; preserve type
+		if val, found := vars[name]; found {
+			return val
+		}
+		*missing = append(*missing, name)
+		return x
+	}
+
+	builder := newStringBuilder()
+	for len(s) > 0 {
+		name, n := nextPlaceholder(s)
+		if n < 0 {
+			builder.WriteString(s)
+			break
+		}
+
+		builder.WriteString(s[:n])
+		if val, found := vars[name]; found {
+			builder.WriteString(sprintf("%v", val))
+		} else {
+			*missing = append(*missing, name)
+			builder.WriteString(`{` + name + `}`)
+		}
+		s = s[n+len(name)+2:]
+	}
+
+	return builder.String()
+}
+
+/*
+nextPlaceholder returns the name and byte offset of the first placeholder
+found within s, or a zero string and -1 if none was found.
+*/
+func nextPlaceholder(s string) (name string, n int) {
+	for off := 0; off < len(s); off++ {
+		if s[off] != '{' {
+			continue
+		}
+
+		for end := off + 1; end < len(s); end++ {
+			if c := s[end]; c == '}' && end > off+1 {
+				return s[off+1 : end], off
+			} else if c == '{' || c == '}' || c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+				break
+			}
+		}
+	}
+
+	return ``, -1
+}
@@ -0,0 +1,102 @@
+package stackage
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStack_Interpolate(t *testing.T) {
+	skel := And().Push(
+		Cond(`uid`, Eq, `{username}`),
+		Cond(`age`, Ge, `{age}`),
+		Cond(`description`, Eq, `member of {group} since {year}`),
+		Or().Push(
+			Cond(`mail`, Eq, `{username}@{domain}`),
+			Cond(`nested`, Eq, List().Push(Cond(`cn`, Eq, `{username}`))),
+		),
+	)
+	orig := skel.String()
+
+	vars := map[string]any{
+		`username`: `jesse`,
+		`age`:      21,
+		`group`:    `admins`,
+		`year`:     2020,
+		`domain`:   `example.com`,
+	}
+
+	S, err := skel.Interpolate(vars)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	want := `uid = jesse AND age >= 21 AND description = member of admins since 2020 AND mail = jesse@example.com OR nested = cn = jesse`
+	if got := S.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// whole-value placeholders preserve type
+	if slice, _ := S.Traverse(1); slice.(Condition).Expression() != 21 {
+		t.Errorf("%s failed: want int expression, got %T", t.Name(), slice.(Condition).Expression())
+		return
+	}
+
+	// substitution reaches stack expressions
+	if slice, _ := S.Traverse(3, 1, 0); slice.(Condition).Expression() != `jesse` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `jesse`, slice.(Condition).Expression())
+		return
+	}
+
+	// the original is never mutated
+	if got := skel.String(); got != orig {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), orig, got)
+		return
+	}
+
+	// missing variables are reported by name and path
+	delete(vars, `username`)
+	if _, err = skel.Interpolate(vars); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	}
+
+	want = `unresolved placeholders: slice [0]: {username}; slice [3][0]: {username}; slice [3][1][0]: {username}`
+	if got := err.Error(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// ... unless permitted to remain
+	if S, err = skel.Interpolate(vars, true); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if slice, _ := S.Traverse(3, 0); slice.(Condition).Expression() != `{username}@example.com` {
+		t.Errorf("%s failed: unexpected expression '%v'", t.Name(), slice.(Condition).Expression())
+		return
+	}
+
+	var zero Stack
+	if _, err = zero.Interpolate(vars); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
+
+func TestNextPlaceholder(t *testing.T) {
+	for _, tst := range []struct {
+		In   string
+		Name string
+		Off  int
+	}{
+		{`{a}`, `a`, 0},
+		{`x {} {b c} {d}`, `d`, 11},
+		{`{{e}}`, `e`, 1},
+		{`none`, ``, -1},
+		{`{unterminated`, ``, -1},
+	} {
+		if name, off := nextPlaceholder(tst.In); name != tst.Name || off != tst.Off {
+			t.Errorf("%s failed: want '%s'@%d, got '%s'@%d", t.Name(), tst.Name, tst.Off, name, off)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+//go:build go1.23
+
+package stackage
+
+/*
+iter.go contains the range-over-func iterators extended by the Stack type.
+*/
+
+import "iter"
+
+/*
+Iter returns an [iter.Seq2] which yields the index and value of each slice
+within the receiver in logical order, beginning at the "front" as with
+[Stack.Front]. Thus, LIFO receivers (the default) are iterated from the
+right-most slice, while FIFO receivers are iterated from the left-most
+slice. Each index yielded is the true index of the slice, as would be
+submitted to [Stack.Index]. Nil slices are not skipped.
+
+For example, the following loop visits every slice of stk:
+
+	for i, v := range stk.Iter() {
+		fmt.Printf("%d: %v\n", i, v)
+	}
+
+If the receiver is locking-enabled, a snapshot of its slices is taken
+up front, thus the lock is not held for the duration of the loop. In
+any case, slices added or removed during iteration are not visited.
+
+Nothing is yielded if the receiver is uninitialized.
+*/
+func (r Stack) Iter() iter.Seq2[int, any] {
+	return r.iter(true)
+}
+
+/*
+IterReverse returns an [iter.Seq2] which behaves as that returned by
+[Stack.Iter] does, except that iteration begins at the "rear" of the
+receiver, as with [Stack.Back].
+*/
+func (r Stack) IterReverse() iter.Seq2[int, any] {
+	return r.iter(false)
+}
+
+/*
+iter is a private method called by [Stack.Iter] and [Stack.IterReverse].
+*/
+func (r Stack) iter(front bool) iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		if r.IsInit() {
+			r.stack.each(front, yield)
+		}
+	}
+}
+
+/*
+IterRecursive returns an [iter.Seq2] which yields the path and value of
+every slice within the receiver, as well as those within any nested [Stack]
+or [Stack] alias, and within any [Condition] (or [Condition] alias) bearing
+such an expression value. Each path is suitable for submission to the
+[Stack.Traverse] method, and is not retained by the iterator.
+
+Nested instances are yielded themselves prior to being descended. Each is
+iterated in logical order as described by [Stack.Iter], and is descended
+only once per path, thus cyclical structures do not iterate endlessly.
+
+	for path, v := range stk.IterRecursive() {
+		fmt.Printf("%v: %v\n", path, v)
+	}
+*/
+func (r Stack) IterRecursive() iter.Seq2[[]int, any] {
+	return func(yield func([]int, any) bool) {
+		if r.IsInit() {
+			r.stack.eachRecursive(nil, visitSet{r.stack: true}, yield)
+		}
+	}
+}
+
+/*
+each calls fn for each slice of the receiver in logical order, beginning
+at the front if front is true, else at the rear, until fn returns false.
+If the receiver is locking-enabled, a snapshot of its slices is taken
+and the lock released prior to the first call of fn.
+*/
+func (r *stack) each(front bool, fn func(int, any) bool) {
+	slices := (*r)[1:]
+	if r.canMutex() {
+		r.lock()
+		slices = append([]any(nil), (*r)[1:]...)
+		r.unlock()
+	}
+
+	// LIFO fronts are on the right, while
+	// FIFO fronts are on the left.
+	ltr := front == r.isFIFO()
+	for i := 0; i < len(slices); i++ {
+		j := i
+		if !ltr {
+			j = len(slices) - i - 1
+		}
+
+		if !fn(j, slices[j]) {
+			return
+		}
+	}
+}
+
+/*
+eachRecursive is a private method called by [Stack.IterRecursive]. The
+input path is that of the receiver, while seen contains those instances
+present along the current path. A Boolean value of false is returned if
+yield requested that iteration stop.
+*/
+func (r *stack) eachRecursive(path []int, seen visitSet, yield func([]int, any) bool) (more bool) {
+	more = true
+	r.each(true, func(i int, slice any) bool {
+		p := append(append(make([]int, 0, len(path)+1), path...), i)
+		if more = yield(p, slice); !more {
+			return false
+		}
+
+		var inner Stack
+		if S, ok := stackTypeAliasConverter(slice); ok {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter(slice); ok {
+			inner, _ = C.ExpressionAsStack()
+		}
+
+		if inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			more = inner.stack.eachRecursive(p, seen, yield)
+			delete(seen, inner.stack)
+		}
+
+		return more
+	})
+
+	return
+}
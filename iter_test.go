@@ -0,0 +1,117 @@
+//go:build go1.23
+
+package stackage
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleStack_Iter() {
+	stk := List().SetFIFO(true).Push(`a`, `b`, `c`)
+	for i, v := range stk.Iter() {
+		fmt.Printf("%d:%v ", i, v)
+	}
+	// Output: 0:a 1:b 2:c
+}
+
+func TestStack_Iter(t *testing.T) {
+	for _, tst := range []struct {
+		FIFO    bool
+		Want    string
+		Reverse string
+	}{
+		{false, `2:c 1:b 0:a `, `0:a 1:b 2:c `},
+		{true, `0:a 1:b 2:c `, `2:c 1:b 0:a `},
+	} {
+		stk := List().SetFIFO(tst.FIFO).Push(`a`, `b`, `c`)
+
+		var got string
+		for i, v := range stk.Iter() {
+			got += sprintf("%d:%v ", i, v)
+		}
+		if got != tst.Want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), tst.Want, got)
+			return
+		}
+
+		got = ``
+		for i, v := range stk.IterReverse() {
+			got += sprintf("%d:%v ", i, v)
+		}
+		if got != tst.Reverse {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), tst.Reverse, got)
+			return
+		}
+	}
+
+	// early break, with writes permitted mid-loop
+	// by virtue of the up-front snapshot.
+	stk := List().SetFIFO(true).Mutex().Push(`a`, `b`, `c`)
+	var count int
+	for _, v := range stk.Iter() {
+		stk.Push(v)
+		if count++; count == 2 {
+			break
+		}
+	}
+	if count != 2 || stk.Len() != 5 {
+		t.Errorf("%s failed: want '%d/%d', got '%d/%d'", t.Name(), 2, 5, count, stk.Len())
+		return
+	}
+
+	var zero Stack
+	for range zero.Iter() {
+		t.Errorf("%s failed: uninitialized stack yielded a value", t.Name())
+		return
+	}
+}
+
+func TestStack_IterRecursive(t *testing.T) {
+	stk := And().SetFIFO(true).Push(
+		`a`,
+		Or().Push(`b`, Cond(`c`, Eq, List().Push(`d`, `e`))),
+		Cond(`f`, Ne, `g`),
+	)
+
+	var paths []string
+	for path, v := range stk.IterRecursive() {
+		paths = append(paths, sprintf("%v", path))
+
+		slice, ok := stk.Traverse(path...)
+		if !ok || sprintf("%v", slice) != sprintf("%v", v) {
+			t.Errorf("%s failed: path %v: want '%v', got '%v'", t.Name(), path, v, slice)
+			return
+		}
+	}
+
+	// Or() is LIFO, and is thus iterated right-to-left
+	want := `[0] [1] [1 1] [1 1 1] [1 1 0] [1 0] [2]`
+	if got := join(paths, ` `); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// early break at depth
+	var count int
+	for range stk.IterRecursive() {
+		if count++; count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 3, count)
+		return
+	}
+
+	// cyclical structures terminate
+	cyc := List()
+	cyc.Push(`x`, cyc)
+	count = 0
+	for range cyc.IterRecursive() {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, count)
+	}
+}
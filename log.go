@@ -1,8 +1,10 @@
 package stackage
 
 import (
+	"context"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 )
 
@@ -26,6 +28,7 @@ var (
 type logSystem struct {
 	lvl logLevels
 	log *log.Logger
+	slg *slog.Logger // supersedes log when non-nil
 }
 
 type LogLevel uint16
@@ -247,30 +250,180 @@ func (r *logSystem) isZero() (is bool) {
 	return
 }
 
-func (r logSystem) logger() (l *log.Logger) {
+func (r logSystem) logger() (l any) {
 	if !r.isZero() {
-		l = r.log
+		if r.slg != nil {
+			l = r.slg
+		} else {
+			l = r.log
+		}
 	}
 	return
 }
 
 func (r *logSystem) setLogger(logger any) *logSystem {
-	r.log = resolveLogger(logger)
+	if r.slg = resolveSlogLogger(logger); r.slg != nil {
+		r.log = devNull
+	} else {
+		r.log = resolveLogger(logger)
+	}
 	return r
 }
 
 func newLogSystem(logger any, l ...any) (lsys *logSystem) {
-	lgr := devNull
+	lsys = new(logSystem)
+	lsys.log = devNull
 	if logger != nil {
-		lgr = resolveLogger(logger)
+		lsys.setLogger(logger)
 	}
-	lsys = new(logSystem)
-	lsys.log = lgr
 	lsys.lvl = *new(logLevels).shift(l...)
 
 	return
 }
 
+/*
+event transcribes a single log event, comprised of the identifier (id)
+and kind of the instance concerned, the operation name (op), the index
+(idx) and error (err) involved, if any. The event is only dispatched if
+the specified LogLevel (lvl) is active.
+
+If a *[slog.Logger] is in use, the event is dispatched as a structured
+record whose message is the operation name. Otherwise, a single line of
+text is written to the *[log.Logger], unless it discards all events.
+*/
+func (r *logSystem) event(lvl LogLevel, id, kind, op string, idx int, err error) {
+	if r == nil || !r.positive(lvl) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String(`id`, id),
+		slog.String(`kind`, kind),
+		slog.String(`op`, op),
+		slog.Int(`index`, idx),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String(`error`, err.Error()))
+	}
+	r.dispatch(lvl, op, attrs...)
+}
+
+/*
+call transcribes a [LogLevel1] (calls) event, comprised of the identifier
+(id) and kind of the instance concerned, the name of the method called
+(op) and the number of arguments (args) supplied. The event is only
+dispatched if [LogLevel1] is active.
+*/
+func (r *logSystem) call(id, kind, op string, args int) {
+	if r == nil || !r.positive(LogLevel1) {
+		return
+	}
+
+	r.dispatch(LogLevel1, op,
+		slog.String(`id`, id),
+		slog.String(`kind`, kind),
+		slog.String(`op`, op),
+		slog.Int(`args`, args))
+}
+
+/*
+length transcribes a [LogLevel3] (state) event, comprised of the identifier
+(id) and kind of the instance concerned, the operation name (op) and the
+length of the instance prior to (before) and following (after) the operation.
+The event is only dispatched if [LogLevel3] is active.
+*/
+func (r *logSystem) length(id, kind, op string, before, after int) {
+	if r == nil || !r.positive(LogLevel3) {
+		return
+	}
+
+	r.dispatch(LogLevel3, op,
+		slog.String(`id`, id),
+		slog.String(`kind`, kind),
+		slog.String(`op`, op),
+		slog.Int(`len_before`, before),
+		slog.Int(`len_after`, after))
+}
+
+/*
+dispatch writes the provided attributes (attrs) to the underlying logger.
+
+If a *[slog.Logger] is in use, the attributes are dispatched as a structured
+record whose message is the operation name (op). Otherwise, a single line of
+text comprised of key=value pairs is written to the *[log.Logger], unless it
+discards all events. Callers are expected to have verified that the specified
+LogLevel (lvl) is active.
+*/
+func (r *logSystem) dispatch(lvl LogLevel, op string, attrs ...slog.Attr) {
+	if r.slg != nil {
+		r.slg.LogAttrs(context.Background(), slogLevel(lvl), op, attrs...)
+	} else if !logDiscard(r.log) {
+		fields := make([]string, len(attrs))
+		for i := 0; i < len(attrs); i++ {
+			fields[i] = attrs[i].Key + `=` + attrs[i].Value.String()
+		}
+		r.log.Print(join(fields, ` `))
+	}
+}
+
+/*
+slogLevel returns the [slog.Level] appropriate for the input [LogLevel].
+*/
+func slogLevel(lvl LogLevel) (l slog.Level) {
+	switch lvl {
+	case LogLevel4, LogLevel6:
+		l = slog.LevelDebug
+	case LogLevel5:
+		l = slog.LevelError
+	default:
+		l = slog.LevelInfo
+	}
+
+	return
+}
+
+/*
+logEvent transcribes a log event on behalf of the instance which bears
+the receiver. See logSystem.event.
+*/
+func (r *nodeConfig) logEvent(lvl LogLevel, op string, idx int, err error) {
+	if r.logs(lvl) {
+		r.log.event(lvl, r.logID(), r.kind(), op, idx, err)
+	}
+}
+
+/*
+logs returns a Boolean value indicative of whether the specified LogLevel
+(lvl) is active within the instance which bears the receiver. This allows
+events to be suppressed before any of their fields are assembled.
+*/
+func (r *nodeConfig) logs(lvl LogLevel) bool {
+	return !r.isZero() && r.log != nil && r.log.positive(lvl)
+}
+
+/*
+logCall transcribes a [LogLevel1] (calls) event on behalf of the instance
+which bears the receiver. See logSystem.call. The operation (op) is noted
+for the purpose of error accumulation as well; see nodeConfig.beginCall.
+*/
+func (r *nodeConfig) logCall(op string, args int) {
+	r.beginCall(op)
+	if r.logs(LogLevel1) {
+		r.log.call(r.logID(), r.kind(), op, args)
+	}
+}
+
+/*
+logLen transcribes a [LogLevel3] (state) event on behalf of the instance
+which bears the receiver. See logSystem.length.
+*/
+func (r *nodeConfig) logLen(op string, before, after int) {
+	r.endCall()
+	if r.logs(LogLevel3) {
+		r.log.length(r.logID(), r.kind(), op, before, after)
+	}
+}
+
 /*
 SetDefaultConditionLogger is a package-level function that will define
 which logging facility new instances of [Condition] or equivalent type
@@ -411,6 +564,23 @@ func SetDefaultStackLogLevel(lvl any) {
 	sLogLevelDefault = level
 }
 
+/*
+resolveSlogLogger returns a *[slog.Logger] instance if the input value is
+a non-nil *[slog.Logger] or [slog.Handler], else nil is returned.
+*/
+func resolveSlogLogger(logger any) (l *slog.Logger) {
+	switch tv := logger.(type) {
+	case *slog.Logger:
+		l = tv
+	case slog.Handler:
+		if tv != nil {
+			l = slog.New(tv)
+		}
+	}
+
+	return
+}
+
 func resolveLogger(logger any) (l *log.Logger) {
 	switch tv := logger.(type) {
 	case *log.Logger:
@@ -468,17 +638,19 @@ func logDiscard(logger *log.Logger) (is bool) {
 }
 
 /*
-Logger returns the *[log.Logger] instance. This can be used for quick
-access to the [log.Logger] type's methods in a manner such as:
+Logger returns the logging facility in use by the receiver, which shall
+be either a *[log.Logger] or a *[slog.Logger] instance, depending on the
+value provided to [Stack.SetLogger]. The return value may be type asserted
+for quick access to the logger's methods in a manner such as:
 
-	r.Logger().Fatalf("We died")
+	r.Logger().(*log.Logger).Fatalf("We died")
 
 It is not recommended to modify the return instance for the purpose
 of disabling logging outright (see [Stack.SetLogger] method as well
 as the [SetDefaultStackLogger] package-level function for ways of
 doing this easily).
 */
-func (r Stack) Logger() (l *log.Logger) {
+func (r Stack) Logger() (l any) {
 	if r.IsInit() {
 		l = r.stack.logger()
 	}
@@ -486,30 +658,32 @@ func (r Stack) Logger() (l *log.Logger) {
 
 }
 
-func (r *stack) logger() *log.Logger {
+func (r *stack) logger() any {
 	cfg, _ := r.config()
 	return cfg.log.logger()
 }
 
 /*
-Logger returns the *[log.Logger] instance. This can be used for quick
-access to the [log.Logger] type's methods in a manner such as:
+Logger returns the logging facility in use by the receiver, which shall
+be either a *[log.Logger] or a *[slog.Logger] instance, depending on the
+value provided to [Condition.SetLogger]. The return value may be type
+asserted for quick access to the logger's methods in a manner such as:
 
-	r.Logger().Fatalf("We died")
+	r.Logger().(*log.Logger).Fatalf("We died")
 
 It is not recommended to modify the return instance for the purpose
 of disabling logging outright (see [Condition.SetLogger] method as well
 as the [SetDefaultConditionLogger] package-level function for ways of
 doing this easily).
 */
-func (r Condition) Logger() (l *log.Logger) {
+func (r Condition) Logger() (l any) {
 	if r.IsInit() {
 		l = r.condition.logger()
 	}
 	return
 }
 
-func (r condition) logger() *log.Logger {
+func (r condition) logger() any {
 	return r.cfg.log.logger()
 }
 
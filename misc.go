@@ -3,13 +3,16 @@ package stackage
 import (
 	"errors"
 	"fmt"
-	"log"
+	"io"
 	"math/rand" // not for crypto, don't worry :)
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 /*
@@ -30,15 +33,21 @@ var (
 	uq      func(string) (string, error)        = strconv.Unquote
 	itoa    func(int) string                    = strconv.Itoa
 	split   func(string, string) []string       = strings.Split
+	fields  func(string) []string               = strings.Fields
 	trimS   func(string) string                 = strings.TrimSpace
 	join    func([]string, string) string       = strings.Join
 	scmp    func(string, string) int            = strings.Compare
+	hasPfx  func(string, string) bool           = strings.HasPrefix
 	now     func() time.Time                    = time.Now
 )
 
 const (
 	randChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
 	randIDSize = 24
+
+	// addrID is the identifier keyword which, when set, causes
+	// the address of an instance to be reported as its ID.
+	addrID = `_addr`
 )
 
 func newStringBuilder() strings.Builder {
@@ -52,6 +61,18 @@ func bool2str(b bool) string {
 	return `false`
 }
 
+/*
+randIntn returns a function which returns a non-negative pseudo-random
+number in [0,n), drawn from the first [rand.Source] within src, if any,
+else from the default source of the math/rand package.
+*/
+func randIntn(src []rand.Source) func(int) int {
+	if len(src) > 0 && src[0] != nil {
+		return rand.New(src[0]).Intn
+	}
+	return rand.Intn
+}
+
 func randomID(n int) string {
 	id := make([]byte, n)
 	for i := range id {
@@ -76,11 +97,15 @@ func timestamp() string {
 /*
 errorf wraps errors.New and returns a non-nil instance of error
 based upon a non-nil/non-zero msg input value with optional args.
+When args are provided, a string msg is treated as a format.
 */
 func errorf(msg any, x ...any) (err error) {
 	switch tv := msg.(type) {
 	case string:
 		if len(tv) > 0 {
+			if len(x) > 0 {
+				tv = sprintf(tv, x...)
+			}
 			err = errors.New(tv)
 		}
 	case error:
@@ -199,22 +224,22 @@ func channelsEqual(x, y any) error {
 	yft, yfv := assertReflect(y)
 
 	if !valueIsValid(xfv, yfv) {
-		return errorf("Channel(s) invalid")
+		return wrapErr(ErrEqualityMismatch, "Channel(s) invalid")
 	}
 
 	xfk := xft.Kind()
 	yfk := yft.Kind()
 
 	if xfk != reflect.Chan || yfk != reflect.Chan {
-		return errorf("Channel kind mismatch")
+		return wrapErr(ErrEqualityMismatch, "Channel kind mismatch")
 	}
 
 	if xft != yft {
-		return errorf("Channel type mismatch")
+		return wrapErr(ErrEqualityMismatch, "Channel type mismatch")
 	}
 
 	if x != y {
-		return errorf("Channel mismatch")
+		return wrapErr(ErrEqualityMismatch, "Channel mismatch")
 	}
 
 	return nil
@@ -223,7 +248,7 @@ func channelsEqual(x, y any) error {
 func functionsEqual(x, y any) error {
 
 	if x == nil || y == nil {
-		return errorf("Nil functions incomparable")
+		return wrapErr(ErrEqualityMismatch, "Nil functions incomparable")
 	}
 
 	xft, _ := assertReflect(x)
@@ -233,11 +258,11 @@ func functionsEqual(x, y any) error {
 	yfk := yft.Kind()
 
 	if xfk != reflect.Func || yfk != reflect.Func {
-		return errorf("Function kind mismatch")
+		return wrapErr(ErrEqualityMismatch, "Function kind mismatch")
 	}
 
 	if xft != yft {
-		return errorf("Function type mismatch")
+		return wrapErr(ErrEqualityMismatch, "Function type mismatch")
 	}
 
 	// Try to match by signature elements...
@@ -246,7 +271,7 @@ func functionsEqual(x, y any) error {
 
 func primitivesEqual(x, y reflect.Value) (tried bool, err error) {
 	if !valueIsValid(x, y) {
-		err = errorf("Nil input")
+		err = wrapErr(ErrEqualityMismatch, "Nil input")
 		return
 	}
 
@@ -254,11 +279,11 @@ func primitivesEqual(x, y reflect.Value) (tried bool, err error) {
 		tried = true
 		if isKnownPrimitive(y.Interface()) {
 			if !x.Equal(y) {
-				err = errorf("primitive mismatch")
+				err = wrapErr(ErrEqualityMismatch, "primitive mismatch")
 			}
 			return
 		}
-		err = errorf("primitive incomparable to non-primitive")
+		err = wrapErr(ErrEqualityMismatch, "primitive incomparable to non-primitive")
 	}
 
 	return
@@ -294,6 +319,20 @@ func valuesEqual(x, y any) error {
 	}
 }
 
+/*
+containsValue returns a Boolean value indicative of whether x is equal,
+per valuesEqual, to any of the input vals.
+*/
+func containsValue(vals []any, x any) bool {
+	for i := 0; i < len(vals); i++ {
+		if valuesEqual(vals[i], x) == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 func matchExtra(l, k reflect.Kind, a, b reflect.Value, x, y any) error {
 
 	switch k {
@@ -305,16 +344,16 @@ func matchExtra(l, k reflect.Kind, a, b reflect.Value, x, y any) error {
 		return uuptrsEqual(l, k, a, b)
 	}
 
-	return errorf("Unsupported type")
+	return wrapErr(ErrEqualityMismatch, "Unsupported type")
 }
 
 func uuptrsEqual(l, k reflect.Kind, x, y reflect.Value) error {
 	if l == k {
 		if x.Interface() != y.Interface() {
-			return errorf("UnsafePointer mismatch")
+			return wrapErr(ErrEqualityMismatch, "UnsafePointer mismatch")
 		}
 	} else {
-		return errorf("Uintptr or unsafepointer kind mismatch")
+		return wrapErr(ErrEqualityMismatch, "Uintptr or unsafepointer kind mismatch")
 	}
 
 	return nil
@@ -339,7 +378,7 @@ func stackageStructsEqual(x, y any) (tried bool, err error) {
 		}
 	}
 
-	err = errorf("Cannot compare stackage instances, cannot convert")
+	err = wrapErr(ErrEqualityMismatch, "Cannot compare stackage instances, cannot convert")
 
 	return
 }
@@ -350,17 +389,17 @@ func mapsEqual(x, y any) (err error) {
 	yrt, yrv, yrk := derefPtr(assertReflect(y))
 
 	if xrk != reflect.Map || xrk != yrk {
-		err = errorf("Cannot compare non-map instances")
+		err = wrapErr(ErrEqualityMismatch, "Cannot compare non-map instances")
 		return
 	}
 
 	if xrt != yrt {
-		err = errorf("Map type mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Map type mismatch")
 		return
 	}
 
 	if xrv.Len() != yrv.Len() {
-		err = errorf("Map length mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Map length mismatch")
 		return
 	}
 
@@ -380,12 +419,12 @@ func structsEqual(x, y any) (err error) {
 	yrt, yrv, yrk := derefPtr(assertReflect(y))
 
 	if xrk != yrk {
-		err = errorf("Struct type mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Struct type mismatch")
 		return
 	}
 
 	if xrt.NumField() != yrt.NumField() {
-		err = errorf("Struct field number mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Struct field number mismatch")
 		return
 	}
 
@@ -404,7 +443,7 @@ func structsEqual(x, y any) (err error) {
 			yanon := ytf.Anonymous
 
 			if !(xanon && yanon) {
-				err = errorf("Struct anonymous field mismatch failed")
+				err = wrapErr(ErrEqualityMismatch, "Struct anonymous field mismatch failed")
 				return
 			}
 		}
@@ -434,12 +473,12 @@ func slicesEqual(x, y any) (err error) {
 	_, yrv, yrk := derefPtr(assertReflect(y))
 
 	if !sliceOrArrayKind(xrk, yrk) {
-		err = errorf("Slice/array kind mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Slice/array kind mismatch")
 		return
 	}
 
 	if !capLenEqual(xrv.Cap(), yrv.Cap(), xrv.Len(), yrv.Len()) {
-		err = errorf("Slice/array capacity or length mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Slice/array capacity or length mismatch")
 		return
 	}
 
@@ -480,28 +519,388 @@ indented "block value" into a single line value more
 cleanly.
 */
 func condenseWHSP(b string) string {
-	b = trimS(b)
-
-	var last bool // previous char was WHSP or HTAB.
-	var builder strings.Builder
-
-	for i := 0; i < len(b); i++ {
-		c := rune(b[i])
-		switch c {
-		case rune(9), rune(32): // match either WHSP or horizontal tab
-			if !last {
-				last = true
-				builder.WriteRune(rune(32)) // Add WHSP
+	builder := newStringBuilder()
+	cw := newCondenser(&builder)
+	cw.writeString(b)
+	_ = cw.close()
+
+	return builder.String()
+}
+
+/*
+condenser is an [io.Writer] qualifier which applies the effects of the
+condenseWHSP function to all content written through it, in piecemeal
+fashion, before handing it off to the underlying writer (w).
+
+Leading WHSP characters are discarded, contiguous WHSP or HTAB
+characters are condensed into a single WHSP character, and trailing
+WHSP characters are withheld until more content arrives (or are
+discarded upon close).
+*/
+type condenser struct {
+	w       io.Writer
+	buf     []byte // condensed content awaiting transfer to w
+	pend    []byte // WHSP content withheld pending more content
+	started bool   // true once the first non-WHSP character arrives
+	last    bool   // previous character was WHSP or HTAB
+	eager   bool   // flush upon each write, as w ultimately leads to a truncator
+	err     error  // first error encountered
+}
+
+const condenserFlushSize = 4096
+
+func newCondenser(w io.Writer) *condenser {
+	return &condenser{w: w, eager: flushesEagerly(w)}
+}
+
+/*
+Write implements [io.Writer].
+*/
+func (r *condenser) Write(p []byte) (int, error) {
+	r.writeString(string(p))
+	return len(p), r.err
+}
+
+/*
+writeString condenses the input string value and buffers the result for
+eventual transfer to the underlying writer.
+*/
+func (r *condenser) writeString(s string) {
+	if r.err != nil {
+		return
+	}
+
+	for i := 0; i < len(s); {
+		c, w := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case !unicode.IsSpace(c):
+			r.started = true
+			r.last = false
+			r.buf = append(r.buf, r.pend...)
+			r.buf = append(r.buf, s[i:i+w]...)
+			r.pend = r.pend[:0]
+		case !r.started:
+			// discard leading WHSP
+		case c == rune(9) || c == rune(32):
+			if !r.last {
+				r.last = true
+				r.pend = append(r.pend, byte(32))
 			}
-		default: // match all other characters
-			if last {
-				last = false
+		default:
+			r.last = false
+			r.pend = append(r.pend, s[i:i+w]...)
+		}
+		i += w
+	}
+
+	if r.eager || len(r.buf) >= condenserFlushSize {
+		r.flush()
+	}
+}
+
+/*
+writePadded writes s, surrounded by a single WHSP character on each side
+if pad is true, as with padValue. The padding is written separately, as
+it is withheld pending more content regardless.
+*/
+func (r *condenser) writePadded(pad bool, s string) {
+	if pad {
+		r.writeString(` `)
+	}
+	r.writeString(s)
+	if pad {
+		r.writeString(` `)
+	}
+}
+
+func (r *condenser) flushesEagerly() bool {
+	return r.eager
+}
+
+/*
+flush transfers all buffered content to the underlying writer.
+*/
+func (r *condenser) flush() {
+	if r.err == nil && len(r.buf) > 0 {
+		_, r.err = r.w.Write(r.buf)
+		r.buf = r.buf[:0]
+	}
+}
+
+/*
+close flushes all buffered content, discards any trailing WHSP and
+returns the first error encountered, if any.
+*/
+func (r *condenser) close() error {
+	r.flush()
+	r.pend = r.pend[:0]
+	return r.err
+}
+
+/*
+lazyWriter is an [io.Writer] qualifier that writes a prefix value to
+the underlying writer (w) immediately before the first non-zero write.
+*/
+type lazyWriter struct {
+	w      io.Writer
+	prefix string
+	done   bool
+}
+
+/*
+Write implements [io.Writer].
+*/
+func (r *lazyWriter) Write(p []byte) (n int, err error) {
+	if !r.done && len(p) > 0 {
+		r.done = true
+		if _, err = io.WriteString(r.w, r.prefix); err != nil {
+			return
+		}
+	}
+
+	return r.w.Write(p)
+}
+
+func (r *lazyWriter) flushesEagerly() bool {
+	return flushesEagerly(r.w)
+}
+
+/*
+eagerFlusher is qualified by the [io.Writer] qualifiers which must not
+withhold content from the underlying writer, such that each write arrives
+at a truncator intact and in order.
+*/
+type eagerFlusher interface {
+	flushesEagerly() bool
+}
+
+/*
+flushesEagerly returns a Boolean value indicative of whether content
+written to w must be flushed immediately.
+*/
+func flushesEagerly(w io.Writer) bool {
+	e, ok := w.(eagerFlusher)
+	return ok && e.flushesEagerly()
+}
+
+/*
+truncator is an [io.Writer] qualifier which imposes a budget, in bytes,
+upon the content transferred to the underlying writer (w). Each write is
+either accepted in full or refused; once a write is refused, all further
+writes are refused as well and a *truncatedError is returned.
+*/
+type truncator struct {
+	w   io.Writer
+	rem int
+	err *truncatedError
+}
+
+/*
+Write implements [io.Writer].
+*/
+func (r *truncator) Write(p []byte) (int, error) {
+	if r.err != nil || len(p) > r.rem {
+		if r.err == nil {
+			r.err = &truncatedError{}
+		}
+		return 0, r.err
+	}
+
+	r.rem -= len(p)
+	return r.w.Write(p)
+}
+
+func (r *truncator) flushesEagerly() bool {
+	return true
+}
+
+/*
+truncatedError is returned by a truncator once its budget is exhausted.
+As the error travels back up through each nested [Stack] being rendered,
+the closing parenthetical characters of those [Stack] instances whose
+opening characters were already written are appended to tail, thereby
+allowing the truncated output to be balanced.
+*/
+type truncatedError struct {
+	tail string
+}
+
+func (r *truncatedError) Error() string {
+	return `string representation truncated`
+}
+
+/*
+defaultTruncationMarker is appended to string representations which were
+truncated per the maximum string length of a [Stack] or [Condition].
+*/
+const defaultTruncationMarker = `…`
+
+/*
+countWriter is an [io.Writer] qualifier that tallies the number of
+bytes written to the underlying writer (w).
+*/
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+/*
+Write implements [io.Writer].
+*/
+func (r *countWriter) Write(p []byte) (n int, err error) {
+	n, err = r.w.Write(p)
+	r.n += int64(n)
+	return
+}
+
+/*
+stackAliases and conditionAliases contain the [Stack] and [Condition]
+alias types registered via [RegisterStackAlias] and [RegisterConditionAlias]
+respectively, keyed by (non-pointer) type.
+*/
+var (
+	stackAliases     sync.Map
+	conditionAliases sync.Map
+
+	nativeStack     reflect.Type = typOf(Stack{})
+	nativeCondition reflect.Type = typOf(Condition{})
+)
+
+/*
+registerAlias records the type of example within the cache if it is
+convertible to the native type. A Boolean value indicative of success
+is returned.
+*/
+func registerAlias(cache *sync.Map, example any, native reflect.Type) (ok bool) {
+	if t := stringerType(example); t != nil && t != native {
+		if ok = t.Kind() == reflect.Struct && t.ConvertibleTo(native); ok {
+			cache.Store(t, true)
+		}
+	}
+
+	return
+}
+
+/*
+aliasConvertible returns a Boolean value indicative of whether type a is
+convertible to the native type. Registered types found within the cache
+forgo the convertibility check, while non-struct types are rejected outright
+as neither [Stack] nor [Condition] aliases can be anything else.
+*/
+func aliasConvertible(cache *sync.Map, a, native reflect.Type) bool {
+	if _, found := cache.Load(a); found {
+		return true
+	}
+
+	return a.Kind() == reflect.Struct && a.ConvertibleTo(native)
+}
+
+/*
+isAliasCandidate returns a Boolean value indicative of whether x could
+possibly be a [Stack] or [Condition] type alias, allowing the common
+primitive types to be dismissed without the use of reflection.
+*/
+func isAliasCandidate(x any) (is bool) {
+	switch x.(type) {
+	case nil, string, bool, []byte,
+		int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64,
+		float32, float64, complex64, complex128:
+	default:
+		is = true
+	}
+
+	return
+}
+
+/*
+typeStringers contains the package-wide type stringer handlers
+registered via the [RegisterStringer] function, keyed by type.
+*/
+var (
+	typeStringers     sync.Map
+	typeStringerCount atomic.Int32
+)
+
+/*
+RegisterStringer registers the provided function (fn) as the package-wide
+string handler for all values of the type of typ, which may be an instance
+of the desired type, a pointer thereto, or a [reflect.Type]. Registrations
+are keyed upon the underlying (non-pointer) type, thus both value and pointer
+forms of the type shall be handled.
+
+Registered handlers are consulted by [Stack.String] and [Condition.String]
+before any String method of the type, and before the package stringer for
+Go primitives. When a pointer is encountered, the handler is provided the
+value to which it points.
+
+Specifying a nil fn shall remove any handler registered for the type. This
+function is safe for concurrent use. See also [Stack.SetTypeStringer].
+*/
+func RegisterStringer(typ any, fn func(any) string) {
+	if t := stringerType(typ); t != nil {
+		if fn == nil {
+			if _, loaded := typeStringers.LoadAndDelete(t); loaded {
+				typeStringerCount.Add(-1)
 			}
-			builder.WriteRune(c)
+		} else if _, loaded := typeStringers.Swap(t, fn); !loaded {
+			typeStringerCount.Add(1)
 		}
 	}
+}
 
-	return builder.String()
+/*
+stringerType returns the non-pointer [reflect.Type] of x, which may be
+an instance of [reflect.Type].
+*/
+func stringerType(x any) (t reflect.Type) {
+	if x != nil {
+		var ok bool
+		if t, ok = x.(reflect.Type); !ok {
+			t = typOf(x)
+		}
+		for isPtr(t) {
+			t = t.Elem()
+		}
+	}
+
+	return
+}
+
+/*
+typeStringer returns the string representation of x alongside a Boolean
+value indicative of success, using the first handler found within the
+provided type stringer map (m), or, failing that, the package-wide handlers
+registered via [RegisterStringer].
+*/
+func typeStringer(m map[reflect.Type]func(any) string, x any) (s string, ok bool) {
+	if len(m) == 0 && typeStringerCount.Load() == 0 {
+		return // nothing registered
+	}
+
+	t := stringerType(x)
+	if t == nil {
+		return
+	}
+
+	var fn func(any) string
+	if fn, ok = m[t]; !ok {
+		var v any
+		if v, ok = typeStringers.Load(t); ok {
+			fn = v.(func(any) string)
+		}
+	}
+
+	if ok {
+		// dereference pointer value(s), if any
+		v := valOf(x)
+		for v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		s = fn(v.Interface())
+	}
+
+	return
 }
 
 /*
@@ -533,19 +932,28 @@ func encapValue(enc [][]string, v string) string {
 		return v
 	}
 
+	L, R := encapParts(enc)
+	return L + v + R
+}
+
+/*
+encapParts returns the leading (L) and trailing (R) encapsulation
+sequences which encapValue would apply to a value.
+*/
+func encapParts(enc [][]string) (L, R string) {
 	for i := len(enc); i > 0; i-- {
 		sl := enc[i-1]
 		switch len(sl) {
 		case 1:
 			// use char 0 for both L and R
-			v = sl[0] + v + sl[0]
+			L, R = sl[0]+L, R+sl[0]
 		case 2:
 			// char 0 = L, char 1 = R
-			v = sl[0] + v + sl[1]
+			L, R = sl[0]+L, R+sl[1]
 		}
 	}
 
-	return v
+	return
 }
 
 /*
@@ -587,6 +995,31 @@ func foldValue(do bool, value string) (s string) {
 	return
 }
 
+/*
+pathString returns the string form of the input index path, such as
+"[1][0]", for use in error messages.
+*/
+func pathString(path []int) (s string) {
+	for i := 0; i < len(path); i++ {
+		s += `[` + itoa(path[i]) + `]`
+	}
+	return
+}
+
+/*
+isAlpha returns a Boolean value indicative of whether the input string
+value is non-zero and consists solely of letters.
+*/
+func isAlpha(value string) bool {
+	for _, c := range value {
+		if !unicode.IsLetter(c) {
+			return false
+		}
+	}
+
+	return len(value) > 0
+}
+
 func isNumberPrimitive(x any) bool {
 	switch x.(type) {
 	case int, int8, int16, int32, int64,
@@ -598,6 +1031,70 @@ func isNumberPrimitive(x any) bool {
 	return false
 }
 
+/*
+compareNumbers compares number primitives x and y, returning a negative
+value if x is less than y, a positive value if x is greater than y, or
+zero if they are equal. Complex numbers may only be compared with other
+complex numbers for equality, thus an error is returned if ordered is true,
+indicating an ordering comparison is sought.
+*/
+func compareNumbers(x, y any, ordered bool) (c int, err error) {
+	xv, yv := valOf(x), valOf(y)
+
+	if xv.CanComplex() || yv.CanComplex() {
+		if !(xv.CanComplex() && yv.CanComplex()) {
+			err = errorf("incomparable types %T and %T", x, y)
+		} else if ordered {
+			err = errorf("complex numbers %v and %v cannot be ordered", x, y)
+		} else if xv.Complex() != yv.Complex() {
+			c = 1
+		}
+		return
+	}
+
+	switch {
+	case xv.CanInt() && yv.CanInt():
+		c = cmpOrdered(xv.Int() < yv.Int(), xv.Int() > yv.Int())
+	case xv.CanUint() && yv.CanUint():
+		c = cmpOrdered(xv.Uint() < yv.Uint(), xv.Uint() > yv.Uint())
+	default:
+		xf, yf := numberFloat(xv), numberFloat(yv)
+		c = cmpOrdered(xf < yf, xf > yf)
+	}
+
+	return
+}
+
+/*
+numberFloat returns the float64 form of the integer, unsigned integer
+or float value v.
+*/
+func numberFloat(v reflect.Value) (f float64) {
+	switch {
+	case v.CanInt():
+		f = float64(v.Int())
+	case v.CanUint():
+		f = float64(v.Uint())
+	case v.CanFloat():
+		f = v.Float()
+	}
+
+	return
+}
+
+/*
+cmpOrdered returns -1 if lt is true, 1 if gt is true, else 0.
+*/
+func cmpOrdered(lt, gt bool) (c int) {
+	if lt {
+		c = -1
+	} else if gt {
+		c = 1
+	}
+
+	return
+}
+
 func isStringPrimitive(x any) bool {
 	switch x.(type) {
 	case string:
@@ -822,6 +1319,13 @@ type Interface interface {
 	// See also the NoNesting and CanNest method for either of these types.
 	IsNesting() bool
 
+	// Stack: Depth returns the maximum nesting depth beneath the receiver,
+	// which is zero (0) if no Stack instances reside within.
+	//
+	// Condition: Depth returns zero (0) if the Expression value is not a
+	// Stack instance, else one (1) more than the depth of that Stack.
+	Depth() int
+
 	// Unmarshal will unmarshal the receiver instance.
 	//
 	// Stack or Stack-alias instances become []any.
@@ -891,13 +1395,183 @@ type Interface interface {
 	// instances for details.
 	Valid() error
 
-	// Logger returns the underlying instance of *log.Logger, which may be set by
+	// Logger returns the underlying logging facility, which may be set by
 	// the package by defaults, or supplied by the user in a piecemeal manner.
 	//
+	// The return value is either a *log.Logger or *slog.Logger instance.
+	//
 	// See also the SetLogger method for Condition and Stack instances.
-	Logger() *log.Logger
+	Logger() any
+}
+
+/*
+StackInterface is an interface type qualified through instances of [Stack],
+as well as [Stack] type aliases converted by way of [AsStackInterface]. It
+extends [Interface] with the read-only methods needed for traversal.
+*/
+type StackInterface interface {
+	Interface
+
+	// Kind returns the string name of the kind of receiver
+	// configuration, e.g.: AND, OR, NOT, LIST or BASIC.
+	Kind() string
+
+	// Index returns the Nth slice within the receiver alongside a
+	// Boolean value indicative of success. See Stack.Index.
+	Index(int) (any, bool)
+
+	// Traverse will "walk" a structure of stack elements using the
+	// path indices provided. See Stack.Traverse.
+	Traverse(...int) (any, bool)
+
+	// Front returns the slice from the logical "front" of the
+	// receiver, per its LIFO or FIFO mode. See Stack.Front.
+	Front() (any, bool)
+
+	// Back returns the slice from the logical "rear" of the
+	// receiver, per its LIFO or FIFO mode. See Stack.Back.
+	Back() (any, bool)
+
+	// IsFIFO returns a Boolean value indicative of whether the
+	// receiver is in FIFO (rather than LIFO) mode. See Stack.IsFIFO.
+	IsFIFO() bool
+}
+
+/*
+ConditionInterface is an interface type qualified through instances of
+[Condition], as well as [Condition] type aliases converted by way of
+[AsConditionInterface]. It extends [Interface] with the read-only methods
+needed for traversal.
+*/
+type ConditionInterface interface {
+	Interface
+
+	// Keyword returns the keyword value set within the receiver.
+	Keyword() string
+
+	// Operator returns the Operator set within the receiver.
+	Operator() Operator
+
+	// Expression returns the expression value set within the receiver.
+	Expression() any
+}
+
+var (
+	_ StackInterface     = Stack{}
+	_ ConditionInterface = Condition{}
+)
+
+/*
+AsStackInterface returns an instance of [StackInterface] alongside a
+Boolean value indicative of success. Instances of [Stack], as well as
+[Stack] type aliases, are supported. See also [ConvertStack].
+*/
+func AsStackInterface(x any) (StackInterface, bool) {
+	if S, ok := stackTypeAliasConverter(x); ok {
+		return S, true
+	}
+	return nil, false
+}
+
+/*
+AsConditionInterface returns an instance of [ConditionInterface] alongside
+a Boolean value indicative of success. Instances of [Condition], as well as
+[Condition] type aliases, are supported. See also [ConvertCondition].
+*/
+func AsConditionInterface(x any) (ConditionInterface, bool) {
+	if C, ok := conditionTypeAliasConverter(x); ok {
+		return C, true
+	}
+	return nil, false
 }
 
+/*
+Package-defined sentinel errors. Errors produced by this package that
+pertain to any of the following conditions wrap the appropriate value,
+and may be identified using [errors.Is].
+*/
 var (
-	unexpectedReceiverState error = errorf("Receiver does not contain an expected instance; aborting")
+	// ErrReadOnly is wrapped when an operation is refused
+	// due to the read-only state of an instance.
+	ErrReadOnly error = errors.New("instance is read-only")
+
+	// ErrCapacityViolation is wrapped when an operation is
+	// refused due to the capacity constraints of a Stack.
+	ErrCapacityViolation error = errors.New("capacity violation")
+
+	// ErrNotInitialized is wrapped when an operation is
+	// refused due to an uninitialized instance.
+	ErrNotInitialized error = errors.New("instance not initialized")
+
+	// ErrNoConfig is wrapped when a Stack does not possess
+	// the expected configuration instance.
+	ErrNoConfig error = errors.New("configuration not found")
+
+	// ErrEqualityMismatch is wrapped when an equality assertion,
+	// such as that performed by Stack.IsEqual, fails.
+	ErrEqualityMismatch error = errors.New("equality mismatch")
+
+	// ErrMarshalInput is wrapped when the input provided to a
+	// marshaling operation is unsuitable.
+	ErrMarshalInput error = errors.New("invalid marshal input")
+
+	// ErrOrderLocked is wrapped when a reordering operation is
+	// refused by an order-locked FIFO Stack.
+	ErrOrderLocked error = errors.New("order is locked")
+
+	// ErrCycle is wrapped when an operation is refused due to
+	// a Stack which contains itself, whether directly or not.
+	ErrCycle error = errors.New("cyclical structure")
+
+	// ErrPendingExpression is wrapped when an operation is
+	// refused due to a Condition whose expression is the
+	// PendingExpression placeholder.
+	ErrPendingExpression error = errors.New("expression is pending")
+
+	// ErrDuplicate is wrapped when a value is refused by a
+	// Stack which does not permit duplicate slices.
+	ErrDuplicate error = errors.New("duplicate value")
+
+	// ErrStaleCursor is wrapped when a Cursor operation is
+	// refused due to a change in the structure beneath it.
+	ErrStaleCursor error = errors.New("stale cursor")
 )
+
+var (
+	unexpectedReceiverState error = wrapErr(ErrNoConfig, "Receiver does not contain an expected instance; aborting")
+)
+
+/*
+wrappedError is an error which bears its own message, while wrapping a
+package-defined sentinel error for use with [errors.Is].
+*/
+type wrappedError struct {
+	msg string
+	err error
+}
+
+/*
+Error returns the string message of the receiver.
+*/
+func (r wrappedError) Error() string {
+	return r.msg
+}
+
+/*
+Unwrap returns the underlying sentinel error.
+*/
+func (r wrappedError) Unwrap() error {
+	return r.err
+}
+
+/*
+wrapErr returns an error based upon msg, with optional args as handled by
+errorf, which wraps the base (sentinel) error.
+*/
+func wrapErr(base error, msg string, x ...any) error {
+	if err := errorf(msg, x...); err != nil {
+		return wrappedError{msg: err.Error(), err: base}
+	}
+
+	return base
+}
@@ -391,3 +391,36 @@ func TestMapEqualityAssertions(t *testing.T) {
 		}
 	}
 }
+
+type noStringer struct {
+	Field string
+}
+
+func TestRegisterStringer(t *testing.T) {
+	RegisterStringer(noStringer{}, func(x any) string {
+		return `custom:` + x.(noStringer).Field
+	})
+	defer RegisterStringer(noStringer{}, nil)
+
+	stk := List().SetDelimiter(`,`).NoPadding().Push(
+		noStringer{`value`},
+		&noStringer{`pointer`},
+	)
+
+	if want, got := `custom:value,custom:pointer`, stk.String(); want != got {
+		t.Errorf("%s failed [stack]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	c := Cond(`keyword`, Eq, noStringer{`value`})
+	if want, got := `keyword = custom:value`, c.String(); want != got {
+		t.Errorf("%s failed [condition]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// unregister and verify the default behavior resumes
+	RegisterStringer(noStringer{}, nil)
+	if want, got := `UNKNOWN,UNKNOWN`, stk.String(); want != got {
+		t.Errorf("%s failed [unregister]: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
@@ -1,5 +1,7 @@
 package stackage
 
+import "sync/atomic"
+
 /*
 op.go contains well-known comparison operators that will
 be used in the expression of a given condition, and also
@@ -72,6 +74,11 @@ of the receiver instance.
 */
 func (r ComparisonOperator) String() (op string) {
 	op = badOp
+	if m := compOpStrings.Load(); m != nil {
+		if s, found := (*m)[r]; found {
+			return s
+		}
+	}
 
 	switch r {
 	case Eq:
@@ -91,6 +98,59 @@ func (r ComparisonOperator) String() (op string) {
 	return
 }
 
+/*
+compOpStrings contains the custom [ComparisonOperator] string values set
+via [SetComparisonOperatorStrings], or nil if the defaults are in effect.
+*/
+var compOpStrings atomic.Pointer[map[ComparisonOperator]string]
+
+/*
+SetComparisonOperatorStrings replaces the string values returned by the
+String method of each [ComparisonOperator] constant with those found within
+the input map (m). This allows the string representation of [Condition]
+instances to suit grammars which require, for example, "ge" or "≥" in place
+of ">=".
+
+The map must contain a non-zero string value for each of [Eq], [Ne], [Lt],
+[Gt], [Le] and [Ge], and no two values may be identical; else an error is
+returned and the strings in effect are not modified. The Context values of
+the operators are never modified.
+
+As the String method itself is affected, so too are the [Parse] function,
+the [Condition.IsEqual] method and any other facility that relies upon it.
+String representations already cached by [Condition] instances (see
+[Condition.SetStringCache]) are not invalidated.
+
+See [ResetComparisonOperatorStrings] to restore the default values. This
+function is safe for concurrent use.
+*/
+func SetComparisonOperatorStrings(m map[ComparisonOperator]string) error {
+	strs := make(map[ComparisonOperator]string, 6)
+	seen := make(map[string]ComparisonOperator, 6)
+	for _, cop := range []ComparisonOperator{Eq, Ne, Lt, Gt, Le, Ge} {
+		s, found := m[cop]
+		if !found || len(s) == 0 {
+			return errorf("missing string value for comparison operator %d", cop)
+		} else if dup, taken := seen[s]; taken {
+			return errorf("comparison operators %d and %d share string value '%s'", dup, cop, s)
+		}
+		seen[s] = cop
+		strs[cop] = s
+	}
+
+	compOpStrings.Store(&strs)
+	return nil
+}
+
+/*
+ResetComparisonOperatorStrings restores the default string values of the
+[ComparisonOperator] constants, undoing the effects of any prior call of
+[SetComparisonOperatorStrings]. This function is safe for concurrent use.
+*/
+func ResetComparisonOperatorStrings() {
+	compOpStrings.Store(nil)
+}
+
 /*
 Context returns the contextual label associated with instances of
 this type as a string value.
@@ -98,3 +158,160 @@ this type as a string value.
 func (r ComparisonOperator) Context() string {
 	return compOpCtx
 }
+
+/*
+Valid returns a Boolean value indicative of whether the receiver is one
+(1) of the package-provided [ComparisonOperator] constants, i.e.: [Eq],
+[Ne], [Lt], [Gt], [Le] or [Ge].
+*/
+func (r ComparisonOperator) Valid() bool {
+	return Eq <= r && r <= Ge
+}
+
+/*
+Negatable is an optional interface type which user-defined [Operator]
+types may implement in order to declare their logical negation. The
+[NegateOperator] function consults this interface before falling back
+to the package's own [ComparisonOperator] negations.
+*/
+type Negatable interface {
+	// Negate returns the Operator which is the
+	// logical negation of the receiver, e.g.: a
+	// "~=" operator might return "!~=".
+	Negate() Operator
+}
+
+/*
+NegateOperator returns the logical negation of the input [Operator]
+alongside a Boolean value indicative of success.
+
+If op implements [Negatable], its Negate method is used. Otherwise,
+the [ComparisonOperator] constants are negated as follows:
+
+  - [Eq] and [Ne] negate one another
+  - [Lt] and [Ge] negate one another
+  - [Gt] and [Le] negate one another
+
+Any other input results in a nil [Operator] and a Boolean value of
+false.
+*/
+func NegateOperator(op Operator) (neg Operator, ok bool) {
+	if n, isNeg := op.(Negatable); isNeg {
+		neg = n.Negate()
+		ok = neg != nil
+		return
+	}
+
+	if cop, isComp := op.(ComparisonOperator); isComp {
+		switch cop {
+		case Eq:
+			neg = Ne
+		case Ne:
+			neg = Eq
+		case Lt:
+			neg = Ge
+		case Ge:
+			neg = Lt
+		case Gt:
+			neg = Le
+		case Le:
+			neg = Gt
+		}
+		ok = neg != nil
+	}
+
+	return
+}
+
+/*
+operatorContextErr returns an error describing the mismatch between the
+context of op and the required context (want).
+*/
+func operatorContextErr(op Operator, want string) error {
+	if op == nil {
+		return errorf("operator value is nil; want context '%s'", want)
+	}
+
+	return errorf("%T operator '%s' bears context '%s'; want '%s'",
+		op, op, op.Context(), want)
+}
+
+/*
+EqualityOperators returns the [ComparisonOperator] constants which assert
+equality, namely [Eq] and [Ne], for convenient use within [OperatorRules].
+*/
+func EqualityOperators() []Operator {
+	return []Operator{Eq, Ne}
+}
+
+/*
+OrderingOperators returns the [ComparisonOperator] constants which assert
+ordering, namely [Lt], [Le], [Gt] and [Ge], for convenient use within
+[OperatorRules].
+*/
+func OrderingOperators() []Operator {
+	return []Operator{Lt, Le, Gt, Ge}
+}
+
+/*
+OperatorRules maps keywords to the [Operator] instances permitted for use
+alongside them, for example:
+
+	OperatorRules{
+		`objectClass`: EqualityOperators(),
+		`age`:         append(EqualityOperators(), OrderingOperators()...),
+	}
+
+Rules may be assigned to a [Stack] via [Stack.SetOperatorRules], or to a
+[Condition] via [Condition.SetOperatorRules]. Keywords absent from the map
+are not restricted, nor is any keyword when the map is nil.
+*/
+type OperatorRules map[string][]Operator
+
+/*
+Check returns an error if op is not permitted for use alongside kw by the
+receiver. Operators are matched by both their string and context values.
+
+The rules of a key matching kw exactly are used, else those of any key
+matching kw without regard to case. No error is returned if no key matches,
+or if either kw or op is zero.
+*/
+func (r OperatorRules) Check(kw string, op Operator) (err error) {
+	if len(r) == 0 || len(kw) == 0 || op == nil {
+		return
+	}
+
+	ops, found := r[kw]
+	for k, v := range r {
+		if found {
+			break
+		} else if eq(k, kw) {
+			ops, found = v, true
+		}
+	}
+
+	if found {
+		err = errorf("%T operator '%s' is not permitted for keyword '%s'", op, op, kw)
+		for i := 0; i < len(ops) && err != nil; i++ {
+			if operatorsEqual(op, ops[i]) {
+				err = nil
+			}
+		}
+	}
+
+	return
+}
+
+/*
+clone returns a copy of the receiver, or nil if the receiver is nil.
+*/
+func (r OperatorRules) clone() (c OperatorRules) {
+	if r != nil {
+		c = make(OperatorRules, len(r))
+		for k, v := range r {
+			c[k] = append([]Operator(nil), v...)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,242 @@
+package stackage
+
+/*
+parse.go contains the ParseFilterLike function, which reconstructs
+Stack and Condition instances from LDAP filter-like text.
+*/
+
+import "unicode/utf8"
+
+/*
+ParseOption is a first-class (closure) function signature used to alter
+the behavior of the [ParseFilterLike] function. See the [ParseSymbols]
+and [ParseOperators] functions.
+*/
+type ParseOption func(*filterParser)
+
+/*
+ParseSymbols returns a [ParseOption] which replaces the default lead-once
+symbol mapping used by [ParseFilterLike]. Each key is a symbol rune, and
+each value is the (case-insensitive) kind of [Stack] it represents, which
+must be one of "AND", "OR" or "NOT". Entries bearing any other kind are
+ignored.
+
+The default mapping is '&' (AND), '|' (OR) and '!' (NOT).
+*/
+func ParseSymbols(syms map[rune]string) ParseOption {
+	return func(r *filterParser) {
+		r.syms = make(map[rune]stackType, len(syms))
+		for sym, kind := range syms {
+			switch uc(kind) {
+			case `AND`:
+				r.syms[sym] = and
+			case `OR`:
+				r.syms[sym] = or
+			case `NOT`:
+				r.syms[sym] = not
+			}
+		}
+	}
+}
+
+/*
+ParseOperators returns a [ParseOption] which replaces the default set of
+[Operator] instances recognized by [ParseFilterLike] within each
+keyword/operator/value triple.
+
+The default set is comprised of the [ComparisonOperator] constants.
+*/
+func ParseOperators(ops ...Operator) ParseOption {
+	return func(r *filterParser) {
+		r.ops = nil
+		for i := 0; i < len(ops); i++ {
+			if ops[i] != nil && len(ops[i].String()) > 0 {
+				r.ops = append(r.ops, ops[i])
+			}
+		}
+	}
+}
+
+/*
+ParseFilterLike parses the LDAP filter-like input string (s), such as:
+
+	(&(objectClass=employee)(|(cn=a)(cn=b)))
+
+... returning the equivalent [Stack] alongside an error, if any.
+
+Each parenthetical group beginning with a recognized symbol (see
+[ParseSymbols]) becomes a [Stack] of the appropriate kind, configured via
+[Stack.SetParen], [Stack.SetLeadOnce], [Stack.SetNoPadding] and
+[Stack.SetSymbol], such that its string representation matches the input.
+Any other group is parsed as a keyword, [Operator] and value triple,
+and becomes a [Condition] configured via [Condition.SetParen] and
+[Condition.SetNoPadding]. The leftmost (and longest) recognized operator
+within the group is used (see [ParseOperators]).
+
+If the input consists of a single keyword, [Operator] and value triple,
+the resulting [Condition] is returned within a [List] [Stack].
+
+Errors identify the (zero-based) byte offset at which the problem was
+encountered. No whitespace is ignored.
+*/
+func ParseFilterLike(s string, opts ...ParseOption) (S Stack, err error) {
+	p := &filterParser{
+		in: s,
+		syms: map[rune]stackType{
+			'&': and,
+			'|': or,
+			'!': not,
+		},
+		ops: comparisonOperators(),
+	}
+
+	for i := 0; i < len(opts); i++ {
+		if opts[i] != nil {
+			opts[i](p)
+		}
+	}
+
+	var x any
+	if x, err = p.parseFilter(); err == nil {
+		if p.pos < len(p.in) {
+			err = errorf("offset %d: unexpected trailing input", p.pos)
+		} else if S, _ = x.(Stack); !S.IsInit() {
+			S = List().Push(x)
+		}
+	}
+
+	return
+}
+
+/*
+filterParser contains the state of a single [ParseFilterLike] operation.
+*/
+type filterParser struct {
+	in   string
+	pos  int
+	syms map[rune]stackType
+	ops  []Operator
+}
+
+/*
+parseFilter parses a single parenthetical group beginning at the current
+offset, returning a [Stack] or [Condition] alongside an error, if any.
+*/
+func (r *filterParser) parseFilter() (x any, err error) {
+	open := r.pos
+	if r.pos >= len(r.in) {
+		err = errorf("offset %d: unexpected end of input; want '('", r.pos)
+		return
+	} else if r.in[r.pos] != '(' {
+		err = errorf("offset %d: unexpected '%c'; want '('", r.pos, r.in[r.pos])
+		return
+	}
+	r.pos++
+
+	sym, size := utf8.DecodeRuneInString(r.in[r.pos:])
+	if typ, found := r.syms[sym]; found && size > 0 {
+		r.pos += size
+		return r.parseStack(open, sym, typ)
+	}
+
+	return r.parseCondition(open)
+}
+
+/*
+parseStack parses the nested groups of a [Stack] of the specified kind
+(typ), whose opening parenthesis is found at offset open.
+*/
+func (r *filterParser) parseStack(open int, sym rune, typ stackType) (x any, err error) {
+	S := Stack{newStack(typ, false)}.
+		SetParen(true).
+		SetLeadOnce(true).
+		SetNoPadding(true).
+		SetSymbol(sym)
+
+	for r.pos < len(r.in) && r.in[r.pos] == '(' {
+		var sub any
+		if sub, err = r.parseFilter(); err != nil {
+			return
+		}
+		S.Push(sub)
+	}
+
+	if r.pos >= len(r.in) {
+		err = errorf("offset %d: unbalanced parenthesis", open)
+	} else if r.in[r.pos] != ')' {
+		err = errorf("offset %d: unexpected '%c'; want '(' or ')'", r.pos, r.in[r.pos])
+	} else if S.Len() == 0 {
+		err = errorf("offset %d: empty %s group", open, typ)
+	} else {
+		r.pos++
+		x = S
+	}
+
+	return
+}
+
+/*
+parseCondition parses the keyword, [Operator] and value triple of the
+group whose opening parenthesis is found at offset open.
+*/
+func (r *filterParser) parseCondition(open int) (x any, err error) {
+	end := r.pos
+	for end < len(r.in) && r.in[end] != ')' {
+		if r.in[end] == '(' {
+			err = errorf("offset %d: unexpected '('", end)
+			return
+		}
+		end++
+	}
+
+	if end == len(r.in) {
+		err = errorf("offset %d: unbalanced parenthesis", open)
+		return
+	}
+
+	item := r.in[r.pos:end]
+	if i, op := findOperator(item, r.ops); op == nil {
+		err = errorf("offset %d: no recognized operator in '%s'", r.pos, item)
+	} else if i == 0 {
+		err = errorf("offset %d: missing keyword", r.pos)
+	} else if i+len(op.String()) == len(item) {
+		err = errorf("offset %d: missing value", end)
+	} else {
+		x = Cond(item[:i], op, item[i+len(op.String()):]).
+			SetParen(true).
+			SetNoPadding(true)
+		r.pos = end + 1
+	}
+
+	return
+}
+
+/*
+findOperator returns the leftmost -- and, at that offset, the longest --
+of the input [Operator] instances found within s, alongside its byte
+offset. A nil [Operator] is returned if none were found.
+*/
+func findOperator(s string, ops []Operator) (i int, op Operator) {
+	for ; i < len(s); i++ {
+		for j := 0; j < len(ops); j++ {
+			ostr := ops[j].String()
+			if hasPfx(s[i:], ostr) && (op == nil || len(ostr) > len(op.String())) {
+				op = ops[j]
+			}
+		}
+
+		if op != nil {
+			return
+		}
+	}
+
+	return
+}
+
+/*
+comparisonOperators returns the [ComparisonOperator] constants as a slice
+of [Operator].
+*/
+func comparisonOperators() []Operator {
+	return []Operator{Eq, Ne, Lt, Gt, Le, Ge}
+}
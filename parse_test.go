@@ -0,0 +1,83 @@
+package stackage
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleParseFilterLike() {
+	filter, err := ParseFilterLike(`(&(objectClass=employee)(|(cn=a)(cn=b)))`)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	slice, _ := filter.Traverse(1, 0)
+	fmt.Printf("%s: %s", filter.Kind(), slice.(Condition).Keyword())
+	// Output: &: cn
+}
+
+func TestParseFilterLike(t *testing.T) {
+	for idx, want := range []string{
+		`(&(objectClass=employee)(|(objectClass=engineeringLead)(objectClass=shareholder))(!(drink=beer)(c=RU)))`,
+		`(!(!(cn=a)))`,
+		`(&(!(|(cn>=a)(cn<=b)))(!(sn!=c)))`,
+		`(cn=Jesse)`,
+	} {
+		filter, err := ParseFilterLike(want)
+		if err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		} else if got := filter.String(); got != want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, want, got)
+			return
+		}
+	}
+
+	// nested NOTs produce NOT stacks
+	filter, _ := ParseFilterLike(`(!(!(cn=a)))`)
+	if slice, _ := filter.Index(0); fmt.Sprint(slice) != `(!(cn=a))` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `(!(cn=a))`, slice)
+		return
+	} else if typ := filter.stackType(); typ != not {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), not, typ)
+		return
+	}
+
+	// custom symbols and operators
+	filter, err := ParseFilterLike(`(+(cn~=a)(sn=b))`,
+		ParseSymbols(map[rune]string{'+': `or`}),
+		ParseOperators(Eq, fakeOperator{Str: `~=`, Ctx: `approx`}))
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := filter.String(); got != `(+(cn~=a)(sn=b))` || filter.stackType() != or {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `(+(cn~=a)(sn=b))`, got)
+		return
+	}
+}
+
+func TestParseFilterLike_errors(t *testing.T) {
+	for idx, tst := range []struct {
+		In   string
+		Want string
+	}{
+		{``, `offset 0: unexpected end of input; want '('`},
+		{`cn=a`, `offset 0: unexpected 'c'; want '('`},
+		{`(cn=a`, `offset 0: unbalanced parenthesis`},
+		{`(&(cn=a)(sn=b)`, `offset 0: unbalanced parenthesis`},
+		{`(&(|(cn=a)(sn=b))`, `offset 0: unbalanced parenthesis`},
+		{`(cn=a))`, `offset 6: unexpected trailing input`},
+		{`(&(cn=a)x)`, `offset 8: unexpected 'x'; want '(' or ')'`},
+		{`(&)`, `offset 0: empty AND group`},
+		{`(cn(=a))`, `offset 3: unexpected '('`},
+		{`(=a)`, `offset 1: missing keyword`},
+		{`(&(cn=))`, `offset 6: missing value`},
+		{`(&(cn~a))`, `offset 3: no recognized operator in 'cn~a'`},
+	} {
+		if _, err := ParseFilterLike(tst.In); err == nil || err.Error() != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%v'", t.Name(), idx, tst.Want, err)
+			return
+		}
+	}
+}
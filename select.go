@@ -0,0 +1,387 @@
+package stackage
+
+/*
+select.go contains the selector mini-language used by the
+Stack.Select method.
+*/
+
+import (
+	"sort"
+	"strconv"
+)
+
+/*
+Select returns all values found within the receiver which match the
+selector expression (expr), in document order, alongside an error if
+the expression could not be parsed.
+
+A selector expression is comprised of one (1) or more steps delimited
+by a forward slash (/). Each step selects values relative to those
+selected by the previous step, beginning with the receiver itself. The
+"children" of a [Stack] are its slices, while the "children" of a
+[Condition] are the slices of its [Stack] expression, if any. This is
+consistent with the [Stack.Traverse] method.
+
+Supported steps are as follows:
+
+  - An integer index (e.g.: "1/0") selects the child at that index, as with [Stack.Index]
+  - A wildcard (*) selects all children
+  - A double wildcard (**) selects the current value(s) and all of their descendants, at any depth
+  - "Stack" selects all children that are [Stack] (or [Stack] alias) instances
+  - "Condition" selects all children that are [Condition] (or [Condition] alias) instances
+
+The wildcard, "Stack" and "Condition" steps may be followed by one (1)
+or more predicates enclosed in square brackets, each of the form key=value.
+Multiple predicates may be comma-delimited within a single pair of brackets,
+or specified using multiple bracket pairs. All predicates must be satisfied.
+Supported keys are:
+
+  - kind, which matches the [Stack.Kind] value (e.g.: "OR") case-insensitively; [Condition] instances are of the "condition" kind
+  - keyword, which matches the [Condition.Keyword] value
+  - id, which matches the [Stack.ID] or [Condition.ID] value
+  - category, which matches the [Stack.Category] or [Condition.Category] value
+
+For example, a double wildcard step followed by the step
+"Condition[keyword=objectClass]" selects all [Condition] instances bearing
+the "objectClass" keyword at any depth, while a wildcard step followed by
+the step "Stack[kind=OR]" selects all OR [Stack] instances found within the
+receiver's own children.
+
+Parse errors identify the (one-based) column at which the problem was
+encountered. An error wrapping [ErrCycle] is returned if the receiver
+contains itself.
+*/
+func (r Stack) Select(expr string) (slices []any, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+		return
+	} else if err = r.stack.cycleErr(); err != nil {
+		return
+	}
+
+	var steps []selStep
+	if steps, err = parseSelector(expr); err == nil {
+		nodes := []selNode{{val: r}}
+		for i := 0; i < len(steps) && len(nodes) > 0; i++ {
+			nodes = steps[i].apply(nodes)
+		}
+
+		for i := 0; i < len(nodes); i++ {
+			slices = append(slices, nodes[i].val)
+		}
+	}
+
+	return
+}
+
+/*
+selNode is a single value selected during the evaluation of a selector
+expression, alongside its index path relative to the receiver.
+*/
+type selNode struct {
+	val  any
+	path []int
+}
+
+/*
+selPred is a single key=value selector predicate.
+*/
+type selPred struct {
+	key, val string
+}
+
+const (
+	selIndex uint8 = iota // integer index
+	selChild              // *, Stack or Condition
+	selDesc               // **
+)
+
+/*
+selStep is a single parsed selector expression step.
+*/
+type selStep struct {
+	kind  uint8
+	idx   int
+	typ   string // `*`, `Stack` or `Condition`
+	preds []selPred
+}
+
+/*
+parseSelector parses the selector expression (expr), returning a slice of
+selStep instances alongside an error, if any.
+*/
+func parseSelector(expr string) (steps []selStep, err error) {
+	if len(expr) == 0 {
+		err = errorf("column 1: empty selector expression")
+		return
+	}
+
+	var start int
+	for i := 0; i <= len(expr) && err == nil; i++ {
+		if i < len(expr) && expr[i] != '/' {
+			continue
+		}
+
+		var step selStep
+		if step, err = parseSelectorStep(expr[start:i], start); err == nil {
+			steps = append(steps, step)
+		}
+		start = i + 1
+	}
+
+	return
+}
+
+/*
+parseSelectorStep parses a single selector step (seg), which begins at the
+zero-based offset (off) within the complete selector expression.
+*/
+func parseSelectorStep(seg string, off int) (step selStep, err error) {
+	if len(seg) == 0 {
+		err = errorf("column %d: empty selector step", off+1)
+		return
+	} else if seg == `**` {
+		step.kind = selDesc
+		return
+	} else if idx, ierr := strconv.Atoi(seg); ierr == nil {
+		step.kind = selIndex
+		step.idx = idx
+		return
+	}
+
+	step.kind = selChild
+
+	var pos int
+	for pos < len(seg) && seg[pos] != '[' {
+		pos++
+	}
+
+	switch step.typ = seg[:pos]; step.typ {
+	case `*`, `Stack`, `Condition`:
+	default:
+		err = errorf("column %d: unsupported selector step '%s'", off+1, step.typ)
+		return
+	}
+
+	for pos < len(seg) && err == nil {
+		if seg[pos] != '[' {
+			err = errorf("column %d: expected '[', found '%c'", off+pos+1, seg[pos])
+			break
+		}
+
+		end := pos + 1
+		for end < len(seg) && seg[end] != ']' {
+			end++
+		}
+
+		if end == len(seg) {
+			err = errorf("column %d: unterminated predicate", off+pos+1)
+			break
+		}
+
+		step.preds, err = parseSelectorPreds(step.preds, seg[pos+1:end], off+pos+1)
+		pos = end + 1
+	}
+
+	return
+}
+
+/*
+parseSelectorPreds parses the comma-delimited predicates (raw) found within
+a single pair of square brackets, beginning at the zero-based offset (off)
+within the complete selector expression. Parsed predicates are appended to
+preds, which is returned.
+*/
+func parseSelectorPreds(preds []selPred, raw string, off int) ([]selPred, error) {
+	var start int
+	for i := 0; i <= len(raw); i++ {
+		if i < len(raw) && raw[i] != ',' {
+			continue
+		}
+
+		pred := raw[start:i]
+		col := off + start + 1
+
+		var eqi int = -1
+		for j := 0; j < len(pred) && eqi == -1; j++ {
+			if pred[j] == '=' {
+				eqi = j
+			}
+		}
+
+		if eqi == -1 {
+			return preds, errorf("column %d: malformed predicate '%s'; want key=value", col, pred)
+		}
+
+		switch key := pred[:eqi]; key {
+		case `kind`, `keyword`, `id`, `category`:
+			preds = append(preds, selPred{key: key, val: pred[eqi+1:]})
+		default:
+			return preds, errorf("column %d: unsupported predicate key '%s'", col, key)
+		}
+
+		start = i + 1
+	}
+
+	return preds, nil
+}
+
+/*
+apply returns the nodes selected by the receiver step relative to the
+input nodes, deduplicated and in document order.
+*/
+func (r selStep) apply(nodes []selNode) (next []selNode) {
+	for i := 0; i < len(nodes); i++ {
+		switch r.kind {
+		case selIndex:
+			if S, ok := selChildStack(nodes[i].val); ok {
+				if slice, idx, found := S.stack.index(r.idx); found {
+					next = append(next, selNode{
+						val:  slice,
+						path: selPath(nodes[i].path, idx-1),
+					})
+				}
+			}
+		case selChild:
+			for _, child := range selChildren(nodes[i]) {
+				if r.matches(child.val) {
+					next = append(next, child)
+				}
+			}
+		case selDesc:
+			next = selDescend(nodes[i], append(next, nodes[i]))
+		}
+	}
+
+	return selSort(next)
+}
+
+/*
+matches returns a Boolean value indicative of whether x satisfies the type
+and predicate requirements of the receiver step.
+*/
+func (r selStep) matches(x any) bool {
+	var kind, kw, id, cat string
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		if r.typ == `Condition` {
+			return false
+		}
+		kind, id, cat = S.Kind(), S.ID(), S.Category()
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		if r.typ == `Stack` {
+			return false
+		}
+		kind, kw, id, cat = `condition`, C.Keyword(), C.ID(), C.Category()
+	} else {
+		// Other values may only be selected
+		// using an unqualified wildcard.
+		return r.typ == `*` && len(r.preds) == 0
+	}
+
+	for i := 0; i < len(r.preds); i++ {
+		var ok bool
+		switch val := r.preds[i].val; r.preds[i].key {
+		case `kind`:
+			ok = eq(kind, val)
+		case `keyword`:
+			ok = kw == val
+		case `id`:
+			ok = id == val
+		case `category`:
+			ok = cat == val
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+/*
+selChildStack returns the [Stack] whose slices are considered the children
+of x alongside a Boolean value indicative of success.
+*/
+func selChildStack(x any) (S Stack, ok bool) {
+	if S, ok = stackTypeAliasConverter(x); ok && S.IsInit() {
+		return
+	} else if C, cok := conditionTypeAliasConverter(x); cok && C.IsInit() {
+		S, ok = C.ExpressionAsStack()
+		ok = ok && S.IsInit()
+	} else {
+		ok = false
+	}
+
+	return
+}
+
+/*
+selChildren returns the non-nil children of the input node.
+*/
+func selChildren(node selNode) (children []selNode) {
+	if S, ok := selChildStack(node.val); ok {
+		for i := 1; i < S.stack.len(); i++ {
+			if slice := (*S.stack)[i]; slice != nil {
+				children = append(children, selNode{
+					val:  slice,
+					path: selPath(node.path, i-1),
+				})
+			}
+		}
+	}
+
+	return
+}
+
+/*
+selDescend appends all descendants of the input node to nodes in document
+order, returning the result.
+*/
+func selDescend(node selNode, nodes []selNode) []selNode {
+	for _, child := range selChildren(node) {
+		nodes = selDescend(child, append(nodes, child))
+	}
+
+	return nodes
+}
+
+/*
+selPath returns a new index path comprised of path and idx.
+*/
+func selPath(path []int, idx int) []int {
+	return append(append(make([]int, 0, len(path)+1), path...), idx)
+}
+
+/*
+selSort sorts the input nodes into document order, removing duplicates.
+*/
+func selSort(nodes []selNode) []selNode {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return selPathCompare(nodes[i].path, nodes[j].path) < 0
+	})
+
+	var uniq []selNode
+	for i := 0; i < len(nodes); i++ {
+		if i == 0 || selPathCompare(nodes[i-1].path, nodes[i].path) != 0 {
+			uniq = append(uniq, nodes[i])
+		}
+	}
+
+	return uniq
+}
+
+/*
+selPathCompare compares index paths a and b, returning a negative value if
+a precedes b in document order, a positive value if b precedes a, or zero
+if they are identical.
+*/
+func selPathCompare(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+
+	return len(a) - len(b)
+}
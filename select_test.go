@@ -0,0 +1,92 @@
+package stackage
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleStack_Select() {
+	filter := And().Push(
+		Cond(`objectClass`, Eq, `person`),
+		Or().Push(
+			Cond(`cn`, Eq, `Jesse`),
+			Cond(`objectClass`, Eq, `account`),
+		),
+	)
+
+	slices, _ := filter.Select(`**/Condition[keyword=objectClass]`)
+	for _, slice := range slices {
+		fmt.Println(slice)
+	}
+	// Output:
+	// objectClass = person
+	// objectClass = account
+}
+
+func TestStack_Select(t *testing.T) {
+	thisIsMyNightmare := nightmareStack()
+	mid, _ := thisIsMyNightmare.Index(1)
+
+	for idx, tst := range []struct {
+		Expr string
+		Want []string
+	}{
+		{`0`, []string{`this1`}},
+		{`1/3`, []string{`keyword > ...`}},
+		{`1/0/0`, []string{`keyword = somevalue`}},
+		{`*/Stack[kind=OR]`, nil},
+		{`*`, []string{`this1`, fmt.Sprint(mid), `this2`}},
+		{`Stack[kind=or]/Stack[kind=AND]/0`, []string{`this4`, `keyword2 < someothervalue`}},
+		{`**/Condition[keyword=keyword]`, []string{`keyword = somevalue`, `keyword > ...`}},
+		{`**/Condition[keyword=keyword2]`, []string{`keyword2 < someothervalue`}},
+		{`**/**/Condition[kind=condition][keyword=ssf]`, []string{`ssf >= 128`}},
+		{`**/Stack[kind=not]`, []string{`dayofweek != Wednesday OR ssf >= 128 OR greeting !=`}},
+	} {
+		slices, err := thisIsMyNightmare.Select(tst.Expr)
+		if err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		}
+
+		if len(slices) != len(tst.Want) {
+			t.Errorf("%s failed [idx:%d]: want '%d' slices, got '%d'",
+				t.Name(), idx, len(tst.Want), len(slices))
+			return
+		}
+
+		for i, slice := range slices {
+			if got := fmt.Sprint(slice); got != tst.Want[i] {
+				t.Errorf("%s failed [idx:%d,%d]: want '%s', got '%s'",
+					t.Name(), idx, i, tst.Want[i], got)
+				return
+			}
+		}
+	}
+}
+
+func TestStack_Select_errors(t *testing.T) {
+	stk := And().Push(`this`, Or().Push(`that`))
+
+	for idx, tst := range []struct {
+		Expr string
+		Want string
+	}{
+		{``, `column 1: empty selector expression`},
+		{`1//0`, `column 3: empty selector step`},
+		{`1/Thing`, `column 3: unsupported selector step 'Thing'`},
+		{`Stack[kind=OR`, `column 6: unterminated predicate`},
+		{`Stack[kind=OR]x`, `column 15: expected '[', found 'x'`},
+		{`*/Condition[kind=OR,color=blue]`, `column 21: unsupported predicate key 'color'`},
+		{`Stack[kind]`, `column 7: malformed predicate 'kind'; want key=value`},
+	} {
+		if _, err := stk.Select(tst.Expr); err == nil || err.Error() != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%v'", t.Name(), idx, tst.Want, err)
+			return
+		}
+	}
+
+	var zero Stack
+	if _, err := zero.Select(`*`); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+	}
+}
@@ -1,5 +1,21 @@
 package stackage
 
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"hash/fnv"
+	"io"
+	"math/rand"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
 /*
 Stack embeds slices of any ([]any) in pointer form
 and extends methods allowing convenient interaction
@@ -15,6 +31,19 @@ is embedded (in pointer form) within instances of [Stack].
 */
 type stack []any
 
+/*
+NewStack initializes and returns a new instance of [Stack] of the specified
+[StackKind], as with the [And], [Or], [Not], [List] and [Basic] functions.
+An uninitialized [Stack] is returned if kind is [KindInvalid] or unknown.
+*/
+func NewStack(kind StackKind, capacity ...int) (r Stack) {
+	if typ := kind.stackType(); typ != 0 {
+		r = Stack{newStack(typ, false, capacity...)}
+	}
+
+	return
+}
+
 /*
 List initializes and returns a new instance of [Stack]
 configured as a simple list. [Stack] instances of this
@@ -83,6 +112,7 @@ func newStack(t stackType, fifo bool, c ...int) *stack {
 
 	cfg.typ = t
 	cfg.ord = fifo
+	cfg.ver = new(atomic.Uint64)
 
 	if len(c) > 0 {
 		if c[0] > 0 {
@@ -95,10 +125,88 @@ func newStack(t stackType, fifo bool, c ...int) *stack {
 
 	st = append(st, cfg)
 	instance := &st
+	cfg.own = instance
 
 	return instance
 }
 
+/*
+sibling returns a new, empty *stack instance of the same kind as the
+receiver, bearing the receiver's ordering and presentation configuration
+(flags, encapsulation, symbol, delimiter, padding style, type stringers and
+presentation policy). Capacity, read-only state, policies (other than the
+presentation policy), hooks and logging are not inherited.
+*/
+func (r *stack) sibling() *stack {
+	sc, _ := r.config()
+	sib := newStack(sc.typ, sc.ord)
+	nc, _ := sib.config()
+
+	nc.opt = sc.opt &^ ronly
+	nc.enc = sc.enc
+	nc.sym = sc.sym
+	nc.sst = sc.sst
+	nc.ljc = sc.ljc
+	nc.ljs = sc.ljs
+	nc.pst = sc.pst
+	nc.spd = sc.spd
+	nc.tsf = sc.tsf
+	nc.rpf = sc.rpf
+	nc.inh = sc.inh
+
+	return sib
+}
+
+/*
+clone returns a deep copy of the receiver, including its configuration
+(see nodeConfig.clone). Nested [Stack] and [Condition] instances, as well
+as their aliases, are cloned in turn; all other slices are copied as-is.
+The clones map associates each instance already cloned with its clone,
+thereby preserving any cyclical references.
+*/
+func (r *stack) clone(clones map[*stack]*stack) *stack {
+	if c, found := clones[r]; found {
+		return c
+	}
+
+	sc, _ := r.config()
+	c := make(stack, 1, r.len())
+	c[0] = sc.clone()
+	c[0].(*nodeConfig).own = &c
+	clones[r] = &c
+
+	for i := 1; i < r.len(); i++ {
+		c = append(c, cloneValue((*r)[i], clones))
+	}
+
+	if cc, _ := c.config(); cc.mtx != nil {
+		(&c).register(cc)
+	}
+
+	return &c
+}
+
+/*
+cloneValue returns a deep copy of x if it is a [Stack] or [Condition], or
+a type alias of either, else x as-is. Values which merely convert to such
+types, such as pointers or [StackCaster] qualifiers, are also returned
+as-is. See stack.clone regarding clones.
+*/
+func cloneValue(x any, clones map[*stack]*stack) any {
+	if x == nil {
+		return x
+	}
+
+	typ := typOf(x)
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() && nativeStack.ConvertibleTo(typ) {
+		return valOf(Stack{S.stack.clone(clones)}).Convert(typ).Interface()
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() && nativeCondition.ConvertibleTo(typ) {
+		return valOf(Condition{C.condition.clone(clones)}).Convert(typ).Interface()
+	}
+
+	return x
+}
+
 /*
 IsEmpty returns a Boolean value indicative of a receiver length of zero
 (0).  This method wraps a call of [Stack.Len] == 0, and is only present
@@ -297,29 +405,96 @@ func (r *stack) setLessFunc(function ...LessFunc) {
 /*
 Swap implements the func(int,int) signature required by the [sort.Interface]
 signature.
+
+See also the [Stack.SwapOK] method.
 */
 func (r Stack) Swap(i, j int) {
+	_ = r.SwapOK(i, j)
+}
+
+/*
+SwapOK behaves identically to [Stack.Swap], except that a Boolean value
+is returned indicative of whether the swap actually occurred. Both i and
+j must fall within the user range of the receiver. Negative indices are
+honored only when [Stack.NegativeIndices] is enabled.
+*/
+func (r Stack) SwapOK(i, j int) (ok bool) {
 	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.stack.swap(i, j)
+		if !r.getState(ronly) && !r.orderLocked(`swap`) {
+			ok = r.stack.swap(i, j)
 		}
 	}
+	return
+}
+
+/*
+SetOrderLock sets the order lock bit within the receiver. When set upon a
+FIFO [Stack], operations which would reorder existing slices -- such as
+[Stack.Reverse] and [Stack.Swap], and therefore any [sort] operation --
+are refused, and an error wrapping [ErrOrderLocked] is set within the
+receiver. Appending and removal, such as via [Stack.Push] and [Stack.Pop],
+remain unaffected.
+
+This bit has no effect upon LIFO instances. See [Stack.SetFIFO].
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the order lock bit (i.e.: true->false and
+false->true)
+*/
+func (r Stack) SetOrderLock(state ...bool) Stack {
+	r.setState(olock, state...)
+	return r
+}
+
+/*
+orderLocked returns a Boolean value indicative of whether the receiver is
+an order-locked FIFO instance, in which case an error is set describing the
+refused operation (op).
+*/
+func (r Stack) orderLocked(op string) (locked bool) {
+	if locked = r.getState(olock) && r.stack.isFIFO(); locked {
+		r.setErr(wrapErr(ErrOrderLocked, "%T is order-locked; cannot %s", r, op))
+	}
+
+	return
 }
 
-func (r *stack) swap(i, j int) {
-	if ok := i <= r.ulen(); !ok {
+/*
+swap is a private method called by [Stack.SwapOK].
+*/
+func (r *stack) swap(i, j int) (ok bool) {
+	defer r.touch()
+	if i, ok = r.swapIndex(i); !ok {
 		return
-	} else if ok = j <= r.ulen(); !ok {
+	} else if j, ok = r.swapIndex(j); !ok {
 		return
 	}
 
-	i++
-	j++
-
 	r.lock()
 	defer r.unlock()
 
 	(*r)[i], (*r)[j] = (*r)[j], (*r)[i]
+	r.metaSwap(i-1, j-1)
+
+	return
+}
+
+/*
+swapIndex returns the raw (config-offset) index for the user index (i)
+alongside a Boolean value indicative of whether the index is in range.
+*/
+func (r stack) swapIndex(i int) (idx int, ok bool) {
+	L := r.ulen()
+	if i < 0 {
+		if ok = r.positive(negidx) && -i <= L; ok {
+			idx = factorNegIndex(i, L)
+		}
+	} else if ok = i < L; ok {
+		idx = i + 1
+	}
+
+	return
 }
 
 /*
@@ -372,6 +547,59 @@ func (r Stack) Auxiliary() (aux Auxiliary) {
 	return
 }
 
+/*
+SetAuxKey associates key with value within the receiver's [Auxiliary]
+instance, which is allocated if needed.
+
+When mutex support is enabled (see [Stack.SetMutex]), this method routes
+through the receiver's lock and is safe for concurrent use alongside the
+[Stack.GetAuxKey] and [Stack.UnsetAuxKey] methods. Direct access to the
+map returned by [Stack.Auxiliary] remains unsynchronized.
+*/
+func (r Stack) SetAuxKey(key string, value any) Stack {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.setAuxKey(key, value)
+		}
+	}
+	return r
+}
+
+/*
+GetAuxKey returns the value associated with key within the receiver's
+[Auxiliary] instance, alongside a presence-indicative Boolean value.
+
+When mutex support is enabled, this method routes through the receiver's
+lock. See also [Stack.SetAuxKey].
+*/
+func (r Stack) GetAuxKey(key string) (value any, ok bool) {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+		value, ok = r.stack.auxiliary().Get(key)
+	}
+	return
+}
+
+/*
+UnsetAuxKey removes key from the receiver's [Auxiliary] instance, if
+found. When mutex support is enabled, this method routes through the
+receiver's lock. See also [Stack.SetAuxKey].
+*/
+func (r Stack) UnsetAuxKey(key string) Stack {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+		if !r.getState(ronly) {
+			r.stack.auxiliary().Unset(key)
+		}
+	}
+	return r
+}
+
 /*
 auxiliary is a private method called by [Stack.Auxiliary].
 */
@@ -425,11 +653,19 @@ changed nor toggled ever again for this instance and shall not be
 subject to any override controls.
 
 In short, once you go FIFO, you cannot go back.
+
+FIFO behavior is mutually exclusive with priority behavior. If the
+receiver is in priority mode (see [Stack.SetPriority]), a value of
+true is refused and an error is set within the receiver.
 */
 func (r Stack) SetFIFO(fifo bool) Stack {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.stack.setFIFO(fifo)
+			if sc, _ := r.stack.config(); fifo && sc.pri {
+				r.setErr(errorf("FIFO mode is mutually exclusive with priority mode"))
+			} else {
+				r.stack.setFIFO(fifo)
+			}
 		}
 	}
 	return r
@@ -443,6 +679,60 @@ func (r *stack) setFIFO(fifo bool) {
 
 }
 
+/*
+SetPriority sets the priority setting within the receiver. When enabled,
+[Stack.Pop] removes the least slice, as judged by [Stack.Less], rather than
+the slice at either end of the receiver. Of several equally least slices,
+the left-most is removed. The same slice is returned by [Stack.Front] and
+[Stack.Peek], and is that considered by [Stack.PopIf], [Stack.PopWhile]
+and [Stack.PopWait].
+
+Slices are appended as usual, and the relative order of those remaining is
+preserved, thus [Stack.Index] and [Stack.String] are unaffected. Selection
+is conducted upon each removal, at a cost of O(n) calls of [Stack.Less]; if
+the receiver is locking-enabled, the lock is held meanwhile, thus the closure
+set via [Stack.SetLessFunc] must not call methods which lock the receiver.
+
+Priority behavior is mutually exclusive with FIFO behavior. If the receiver
+is in FIFO mode (see [Stack.SetFIFO]), enabling this setting is refused and
+an error is set within the receiver.
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetPriority(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			pri := !sc.pri
+			if len(state) > 0 {
+				pri = state[0]
+			}
+
+			if pri && sc.ord {
+				r.setErr(errorf("priority mode is mutually exclusive with FIFO mode"))
+			} else {
+				sc.pri = pri
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+IsPriority returns a Boolean value indicative of whether the receiver is
+in priority mode. See [Stack.SetPriority].
+*/
+func (r Stack) IsPriority() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.pri
+	}
+	return
+}
+
 /*
 Err returns the error residing within the receiver, or nil
 if no error condition has been declared.
@@ -480,6 +770,92 @@ func (r Stack) SetErr(err error) Stack {
 	return r
 }
 
+/*
+SetErrorAccumulation enables (true) or disables (false) the accumulation
+of errors within the receiver. While enabled, each non-nil error set within
+the receiver -- whether by way of [Stack.SetErr] or internally, such as upon
+a refused push -- is appended to a bounded error history, along with the
+time of occurrence and, for instrumented methods, the operation name. The
+history is retrieved using [Stack.Errs].
+
+[Stack.Err] continues to return the most recent error only. Disabling this
+setting halts accumulation, but does not clear the history; use the method
+[Stack.ClearErrs] for that purpose.
+
+A Boolean input value explicitly sets the accumulation state as intended.
+Execution without a Boolean input value will *TOGGLE* the current state
+of accumulation (i.e.: true->false and false->true)
+*/
+func (r Stack) SetErrorAccumulation(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.setAccumulation(state...)
+		}
+	}
+	return r
+}
+
+/*
+IsAccumulatingErrors returns a Boolean value indicative of whether error
+accumulation is enabled within the receiver. See [Stack.SetErrorAccumulation].
+*/
+func (r Stack) IsAccumulatingErrors() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.erh != nil && sc.erh.on
+	}
+	return
+}
+
+/*
+SetErrorHistorySize assigns the maximum number of errors retained within
+the error history of the receiver, which defaults to sixteen (16). Once
+the maximum is reached, each newly accumulated error replaces the oldest.
+Upon reduction, only the most recent errors are retained.
+
+A zero or negative value restores the default.
+*/
+func (r Stack) SetErrorHistorySize(n int) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if n <= 0 {
+				n = defaultErrHistorySize
+			}
+			sc, _ := r.stack.config()
+			sc.history().resize(n)
+		}
+	}
+	return r
+}
+
+/*
+Errs returns the errors accumulated within the receiver, from oldest to
+newest. Each error is an instance of [ErrorRecord]. See the method
+[Stack.SetErrorAccumulation].
+*/
+func (r Stack) Errs() (errs []error) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		errs = sc.erh.list()
+	}
+	return
+}
+
+/*
+ClearErrs discards all errors accumulated within the receiver. The error
+returned by [Stack.Err] is not affected.
+
+This may be used regardless of [Stack.IsReadOnly] status.
+*/
+func (r Stack) ClearErrs() Stack {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		sc.erh.clear()
+	}
+	return r
+}
+
 /*
 setErr assigns an error instance, whether nil or not, to
 the underlying receiver configuration.
@@ -519,191 +895,271 @@ user content operations (see the [Stack.Err] method).
 */
 func (r Stack) Valid() (err error) {
 	if r.stack == nil {
-		err = errorf("embedded instance is nil")
+		err = wrapErr(ErrNotInitialized, "embedded instance is nil")
 	} else if !r.stack.valid() {
-		err = errorf("embedded instance is invalid")
+		err = wrapErr(ErrNoConfig, "embedded instance is invalid")
 	}
 
 	return
 }
 
 /*
-valid is a private method called by [Stack.Valid].
+ValidDeep returns an error if the receiver, or any nested [Stack] or
+[Condition] instance (or alias of either), fails validation. Nested
+instances found within [Condition] expressions are included.
+
+Each failure is described using the traversal path of the offending
+instance, as understood by [Stack.Traverse], such as:
+
+	slice [1][0]: keyword value is zero
+
+Each [Condition] is also checked against the [OperatorRules] of the [Stack]
+in which it resides, if set (see [Stack.SetOperatorRules]).
+
+A [Stack] which contains itself, whether directly or not, is reported
+using an error wrapping [ErrCycle], and is not descended again.
+
+All failures are aggregated into the returned error using [errors.Join].
+Unlike [Stack.Valid], the error produced by any [ValidityPolicy] is
+reported as-is.
 */
-func (r *stack) valid() (is bool) {
-	if r.isInit() {
-		// try to see if the user provided a
-		// validity function
-		stk := Stack{r}
-		if meth := stk.getValidityPolicy(); meth != nil {
-			if err := meth(r); err != nil {
-				return
-			}
-		}
-		is = true
+func (r Stack) ValidDeep() (err error) {
+	if err = r.validErr(); err == nil {
+		var errs []error
+		r.stack.validDeep(nil, visitSet{}, &errs)
+		err = errors.Join(errs...)
 	}
 
 	return
 }
 
 /*
-IsInit returns a Boolean value indicative of whether the
-receiver has been initialized using any of the following
-package-level functions:
+validDeep is a private method called by [Stack.ValidDeep]. Each failure
+found beneath the receiver, whose path is indicated, is appended to errs.
+The seen set contains those instances present along the current path.
+*/
+func (r *stack) validDeep(path []int, seen visitSet, errs *[]error) {
+	if seen[r] {
+		loc := `receiver`
+		if len(path) > 0 {
+			loc = `slice ` + pathString(path)
+		}
+		*errs = append(*errs, wrapErr(ErrCycle, "%s: stack contains itself", loc))
+		return
+	}
+	seen[r] = true
+	defer delete(seen, r)
 
-  - [And]
-  - [Or]
-  - [Not]
-  - [List]
-  - [Basic]
+	for i := 1; i < r.len(); i++ {
+		p := append(append([]int{}, path...), i-1)
+		validSlice((*r)[i], p, seen, errs)
+		if err := r.checkRules((*r)[i]); err != nil {
+			*errs = append(*errs, errorf("slice %s: %v", pathString(p), err))
+		}
+	}
+}
 
-This method does not take into account the presence (or
-absence) of any user-provided values (e.g.: a length of
-zero (0)) can still return true.
+/*
+validSlice appends the validation failure(s) of x -- if a [Stack] or
+[Condition] (or alias of either) -- to errs, recursing as needed. The
+path of x is indicated. See stack.validDeep regarding seen.
 */
-func (r Stack) IsInit() (is bool) {
-	if !r.IsZero() {
-		is = r.stack.isInit()
+func validSlice(x any, path []int, seen visitSet, errs *[]error) {
+	var pfx string
+	if len(path) > 0 {
+		pfx = `slice ` + pathString(path) + `: `
+	}
+
+	if S, ok := stackTypeAliasConverter(x); ok {
+		if err := S.validErr(); err != nil {
+			*errs = append(*errs, errorf("%s%v", pfx, err))
+		} else {
+			S.stack.validDeep(path, seen, errs)
+		}
+	} else if C, ok := conditionTypeAliasConverter(x); ok {
+		if err := C.Valid(); err != nil {
+			*errs = append(*errs, errorf("%s%v", pfx, err))
+		} else if S, sok := C.ExpressionAsStack(); sok {
+			// condition expressions are transparent
+			// during traversal, thus path is reused.
+			validSlice(S, path, seen, errs)
+		}
 	}
-	return
 }
 
 /*
-isInit is a private method called by [Stack.IsInit].
+validErr returns the error produced by [Stack.Valid], or by the
+[ValidityPolicy] of the receiver, if set.
 */
-func (r *stack) isInit() bool {
-	//var err error
-	_, err := r.config()
-	return err == nil
+func (r Stack) validErr() (err error) {
+	if err = r.Valid(); err != nil && r.stack != nil {
+		if meth := r.getValidityPolicy(); meth != nil && r.stack.isInit() {
+			err = meth(r.stack)
+		}
+	}
+
+	return
 }
 
 /*
-SetLogger assigns the specified logging facility to the receiver
-instance.
-
-Logging is available but is set to discard all events by default.
+SetValidateOnPush sets the push validation bit within the receiver. When
+set, each [Stack] or [Condition] (or alias of either) pushed into the
+receiver is first checked using [Stack.ValidDeep] or [Condition.Valid]
+respectively. The first invalid value -- and any values that follow it --
+are refused, and the failure is set within the receiver for inspection
+via [Stack.Err].
 
-An active logging subsystem within the receiver supercedes the
-default package logger.
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the push validation bit (i.e.: true->false
+and false->true)
+*/
+func (r Stack) SetValidateOnPush(state ...bool) Stack {
+	r.setState(vpush, state...)
+	return r
+}
 
-The following types/values are permitted:
+/*
+SetOperatorContext assigns the context value required of the [Operator]
+of each [Condition] (or [Condition] alias) pushed into the receiver. When
+set, the first [Condition] whose operator bears any other context -- and
+any values that follow it -- are refused, and the failure is set within
+the receiver for inspection via [Stack.Err]. Values other than [Condition]
+instances are not affected.
 
-  - string: `none`, `off`, `null`, `discard` will turn logging off
-  - string: `stdout` will set basic STDOUT logging
-  - string: `stderr` will set basic STDERR logging
-  - int: 0 will turn logging off
-  - int: 1 will set basic STDOUT logging
-  - int: 2 will set basic STDERR logging
-  - *[log.Logger]: user-defined *[log.Logger] instance will be set; it should not be nil
+This is useful when mixing [ComparisonOperator] values with user-defined
+[Operator] qualifiers, such as matching rules, which must not co-mingle.
 
-Case is not significant in the string matching process.
+A zero string disables this check. See also [Condition.SetOperatorContext].
 */
-func (r Stack) SetLogger(logger any) Stack {
+func (r Stack) SetOperatorContext(ctx string) Stack {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.stack.setLogger(logger)
+			sc, _ := r.stack.config()
+			sc.occ = ctx
 		}
 	}
 	return r
 }
 
 /*
-Transfer will iterate the receiver (r) and add all slices contained
-therein to the destination instance (dest), which must be a previously
-initialized [Stack] or [Stack]-alias instance, else false is returned.
-
-If capacity constraints are in-force within the destination instance,
-and the transfer request cannot proceed due to it being larger than
-the sum number of available slices, false is returned.
-
-If [Stack.IsInit] returns false, this method returns false, as there
-is nothing to transfer.
-
-The receiver instance (r) is not modified in any way as a result of
-calling this method. If the receiver (source) should undergo a call to
-its [Stack.Reset] or [Stack.Free] methods following a call to the this
-method, only the source will be emptied, and all of the slices that have
-since been transferred instance shall remain in the destination instance.
-
-A return value of true indicates a successful transfer.
+OperatorContext returns the operator context value set within the receiver,
+if any. See also the [Stack.SetOperatorContext] method.
 */
-func (r Stack) Transfer(dest any) (ok bool) {
+func (r Stack) OperatorContext() (ctx string) {
 	if r.IsInit() {
-		if s, sok := stackTypeAliasConverter(dest); sok {
-			if !s.getState(ronly) {
-				ok = r.transfer(s.stack)
-			}
-		}
+		sc, _ := r.stack.config()
+		ctx = sc.occ
 	}
-
 	return
 }
 
 /*
-transfer is a private method executed by the [Stack.Transfer]
-method. It will return a *stack instance containing the same
-slices as the receiver (r). Configuration is not copied, nor
-is a destination *stack subject to initialization within this
-method. Thus, the user must submit a *stack instance ready to
-receive slices immediately.
+SetOperatorRules assigns a copy of the input [OperatorRules] to the receiver.
+When set, the first [Condition] (or [Condition] alias) pushed into the receiver
+whose keyword and [Operator] are not permitted by the rules -- and any values
+that follow it -- are refused, and the failure is set within the receiver for
+inspection via [Stack.Err]. [Stack.ValidDeep] reports any such [Condition]
+found within the receiver, or within any nested [Stack] bearing its own rules.
 
-Capacity enforcement is honored. If the source (r) contains
-more slices than a non-zero destination capacity allows, the
-operation is canceled outright and false is returned.
+Only [Condition] instances residing directly within the receiver are checked,
+and any [Condition] bearing its own rules (see [Condition.SetOperatorRules])
+is checked against those instead. A nil map removes the rules.
 */
-func (r *stack) transfer(dest *stack) (ok bool) {
-
-	// if a capacity was set, make sure
-	// the destination can handle it...
-	if dest.cap() > 0 {
-		if r.ulen() > dest.cap()-r.ulen() {
-			// capacity is in-force, and
-			// there are too many slices
-			// to xfer.
-			// err := errorf("failed: capacity violation"))
-			return
+func (r Stack) SetOperatorRules(rules OperatorRules) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.opr = rules.clone()
 		}
 	}
+	return r
+}
 
-	// xfer slices, without any regard for
-	// nilness. Slice type is not subject
-	// to discrimination.
-	for i := 0; i < r.ulen(); i++ {
-		sl, _, _ := r.index(i) // cfg offset handled by index method
-		dest.push(sl)
+/*
+OperatorRules returns a copy of the [OperatorRules] set within the receiver,
+if any. See also the [Stack.SetOperatorRules] method.
+*/
+func (r Stack) OperatorRules() (rules OperatorRules) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		rules = sc.opr.clone()
 	}
+	return
+}
 
-	// return result
-	ok = dest.ulen() >= r.ulen()
+/*
+checkRules returns an error if x is a [Condition] (or alias) whose keyword
+and [Operator] are not permitted by the [OperatorRules] of the receiver. A
+[Condition] bearing its own rules is not checked, as those take precedence.
+*/
+func (r *stack) checkRules(x any) (err error) {
+	sc, _ := r.config()
+	if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() && sc.opr != nil {
+		if C.condition.cfg.opr == nil {
+			err = sc.opr.Check(C.condition.kw, C.condition.op)
+		}
+	}
 
 	return
 }
 
 /*
-Replace will overwrite slice idx using value x and returns a Boolean
-value indicative of success.
+SetMaxDepth assigns the maximum nesting depth permitted beneath the receiver,
+as reported by [Stack.Depth]. When set, the first [Stack] (or [Stack] alias)
+pushed into the receiver -- whether directly or as a [Condition] expression
+-- which would exceed the maximum is refused, alongside any values that
+follow it, and the failure is set within the receiver for inspection via
+[Stack.Err].
 
-If slice i does not exist (e.g.: idx > receiver len), then nothing is
-altered and a false Boolean value is returned.
+For example, a maximum of two (2) permits the push of a [Stack] which itself
+contains a [Stack], but not one which contains a [Stack] within a [Stack].
 
-Use of the Replace method shall not result in fragmentation of the
-receiver instance; this method does not honor any attempt to replace
-any receiver slice value with nil.
+A zero or negative value disables this check.
 */
-func (r Stack) Replace(x any, idx int) (ok bool) {
-	if r.IsInit() && x != nil {
+func (r Stack) SetMaxDepth(n int) Stack {
+	if r.IsInit() {
 		if !r.getState(ronly) {
-			ok = r.stack.replace(x, idx)
+			sc, _ := r.stack.config()
+			sc.mxd = n
 		}
 	}
+	return r
+}
 
+/*
+Depth returns the maximum nesting depth beneath the receiver. A receiver
+which contains no [Stack] instances (or aliases thereof), whether directly
+or within [Condition] expressions, returns zero (0). Each level of nesting
+beneath the receiver increments this value by one (1).
+
+Instances encountered more than once along a single path, such as those
+which contain themselves, are not descended again.
+*/
+func (r Stack) Depth() (d int) {
+	if r.IsInit() {
+		r.lock()
+		defer r.unlock()
+
+		d = r.stack.depth(make(map[*stack]bool))
+	}
 	return
 }
 
-func (r *stack) replace(x any, i int) (ok bool) {
-	if r != nil {
-		if ok = i+1 <= r.ulen(); ok {
-			(*r)[i+1] = x
+/*
+depth is a private method called by [Stack.Depth]. The seen map guards
+against cyclical structures.
+*/
+func (r *stack) depth(seen map[*stack]bool) (d int) {
+	if seen[r] {
+		return
+	}
+	seen[r] = true
+	defer delete(seen, r)
+
+	for i := 1; i < r.len(); i++ {
+		if sd := sliceDepth((*r)[i], seen); sd > d {
+			d = sd
 		}
 	}
 
@@ -711,282 +1167,531 @@ func (r *stack) replace(x any, i int) (ok bool) {
 }
 
 /*
-Insert will insert value x to become the left index. For example,
-using zero (0) as left shall result in value x becoming the first
-slice within the receiver.
-
-This method returns a Boolean value indicative of success. A value
-of true indicates the receiver length became longer by one (1).
-
-This method does not currently respond to forward/negative index
-support. An integer value less than or equal to zero (0) shall
-become zero (0). An integer value that exceeds the length of the
-receiver shall become index len-1. A value that falls within the
-bounds of the receiver's current length is inserted as intended.
-
-Use of the Insert method shall not result in fragmentation of the
-receiver instance, as any nil x value shall be discarded and not
-considered for insertion into the stack.
+sliceDepth returns the nesting depth contributed by slice x: one (1) more
+than the depth of x if x is a [Stack] (or a [Condition] bearing a [Stack]
+expression), else zero (0).
 */
-func (r Stack) Insert(x any, left int) (ok bool) {
-	if r.IsInit() && x != nil {
-		if !r.getState(ronly) {
-			ok = r.stack.insert(x, left)
+func sliceDepth(x any, seen map[*stack]bool) (d int) {
+	S, ok := stackTypeAliasConverter(x)
+	if !ok {
+		if C, cok := conditionTypeAliasConverter(x); cok && C.IsInit() {
+			S, ok = C.ExpressionAsStack()
 		}
 	}
+
+	if ok && S.IsInit() {
+		d = 1 + S.stack.depth(seen)
+	}
+
 	return
 }
 
 /*
-insert is a private method called by [Stack.Insert].
+nestedConditions appends each [Condition] found beneath the receiver to
+conds, descending nested stacks and [Condition] expressions alike, and
+returns the result. See stack.depth regarding seen.
 */
-func (r *stack) insert(x any, left int) (ok bool) {
-	// note the len before we start
-	var u1 int = r.ulen()
-
-	// bail out if a capacity has been set and
-	// would be breached by this insertion.
-	if u1+1 > r.cap()-1 && r.cap() != 0 {
-		//err := errorf("failed: capacity violation")
-		return
+func (r *stack) nestedConditions(seen map[*stack]bool, conds []Condition) []Condition {
+	if seen[r] {
+		return conds
 	}
+	seen[r] = true
+	defer delete(seen, r)
 
-	r.lock()
-	defer r.unlock()
+	for i := 1; i < r.len(); i++ {
+		S, ok := stackTypeAliasConverter((*r)[i])
+		if C, cok := conditionTypeAliasConverter((*r)[i]); cok && C.IsInit() {
+			conds = append(conds, C)
+			S, ok = C.ExpressionAsStack()
+		}
 
-	cfg, _ := r.config()
+		if ok && S.IsInit() {
+			conds = S.stack.nestedConditions(seen, conds)
+		}
+	}
 
-	// If left is greater-than-or-equal
-	// to the user length, just push.
-	if u1-1 < left {
-		*r = append(*r, x)
+	return conds
+}
 
-		// Verify something was added
-		ok = u1+1 == r.ulen()
-		return
-	}
+/*
+screenPush returns the leading members of x which pass validation, per
+[Stack.SetValidateOnPush], which bear the required operator context, per
+[Stack.SetOperatorContext], which bear permitted operators, per
+[Stack.SetOperatorRules], and which do not exceed the maximum depth, per
+[Stack.SetMaxDepth]. Any failure is set within the receiver.
+*/
+func (r *stack) screenPush(x []any) []any {
+	sc, _ := r.config()
+	for i := 0; i < len(x); i++ {
+		if sc.mxd > 0 {
+			if d := sliceDepth(x[i], map[*stack]bool{r: true}); d > sc.mxd {
+				r.setErr(errorf("push refused: nesting depth %d exceeds maximum %d", d, sc.mxd))
+				return x[:i]
+			}
+		}
 
-	var R stack = make(stack, 0)
-	left += 1
+		if C, ok := conditionTypeAliasConverter(x[i]); ok && len(sc.occ) > 0 {
+			if op := C.Operator(); op == nil || op.Context() != sc.occ {
+				r.setErr(errorf("push refused: %v", operatorContextErr(op, sc.occ)))
+				return x[:i]
+			}
+		}
 
-	// If left is less-than-or-equal to
-	// zero (0), we'll use a new stack
-	// alloc (R) and move items into it
-	// in the appropriate order. The
-	// new element will be the first
-	// user slice.
-	if left <= 1 {
-		left = 1
-		R = append(R, cfg)
-		R = append(R, x)
-		R = append(R, (*r)[left:]...)
+		if err := r.checkRules(x[i]); err != nil {
+			r.setErr(errorf("push refused: %v", err))
+			return x[:i]
+		}
 
-		// If left falls within the user length,
-		// append all elements up to and NOT
-		// including the desired index, and also
-		// append everything after desired index.
-		// This leaves a slot into which we can
-		// drop the new element (x)
-	} else {
-		R = append((*r)[:left+1], (*r)[left:]...)
-		R[left] = x
+		if r.positive(vpush) {
+			var errs []error
+			if validSlice(x[i], nil, visitSet{}, &errs); len(errs) > 0 {
+				r.setErr(errorf("push refused: %v", errors.Join(errs...)))
+				return x[:i]
+			}
+		}
 	}
 
-	// Verify something was added
-	*r = R
-	ok = u1+1 == r.ulen()
+	return x
+}
+
+/*
+valid is a private method called by [Stack.Valid].
+*/
+func (r *stack) valid() (is bool) {
+	if r.isInit() {
+		// try to see if the user provided a
+		// validity function
+		stk := Stack{r}
+		if meth := stk.getValidityPolicy(); meth != nil {
+			if err := meth(r); err != nil {
+				return
+			}
+		}
+		is = true
+	}
 
 	return
 }
 
 /*
-Free frees the receiver instance entirely, including the underlying
-configuration. An error is returned if the instance is read-only or
-uninitialized.
+IsInit returns a Boolean value indicative of whether the
+receiver has been initialized using any of the following
+package-level functions:
+
+  - [And]
+  - [Or]
+  - [Not]
+  - [List]
+  - [Basic]
 
-See also [Stack.Reset].
+This method does not take into account the presence (or
+absence) of any user-provided values (e.g.: a length of
+zero (0)) can still return true.
 */
-func (r *Stack) Free() (err error) {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.stack = nil
-			return
-		}
-		err = errorf("%T is read-only; cannot free", r)
+func (r Stack) IsInit() (is bool) {
+	if !r.IsZero() {
+		is = r.stack.isInit()
 	}
-
 	return
 }
 
 /*
-Reset will silently iterate and delete each slice found within
-the receiver, leaving it unpopulated but still retaining its
-active configuration. Nothing is returned.  No action is taken
-if the receiver is empty.
+isInit is a private method called by [Stack.IsInit].
+*/
+func (r *stack) isInit() bool {
+	//var err error
+	_, err := r.config()
+	return err == nil
+}
+
+/*
+SetLogger assigns the specified logging facility to the receiver
+instance.
+
+Logging is available but is set to discard all events by default.
+
+An active logging subsystem within the receiver supercedes the
+default package logger.
+
+The following types/values are permitted:
+
+  - string: `none`, `off`, `null`, `discard` will turn logging off
+  - string: `stdout` will set basic STDOUT logging
+  - string: `stderr` will set basic STDERR logging
+  - int: 0 will turn logging off
+  - int: 1 will set basic STDOUT logging
+  - int: 2 will set basic STDERR logging
+  - *[log.Logger]: user-defined *[log.Logger] instance will be set; it should not be nil
+  - *[slog.Logger]: user-defined *[slog.Logger] instance will be set, receiving structured events
+  - [slog.Handler]: user-defined [slog.Handler] instance will be wrapped in a new *[slog.Logger]
+
+Case is not significant in the string matching process.
 
-See also [Stack.Free].
+Structured events bear the id, kind, op (operation name), index and
+error (if any) fields, and use the operation name as the message.
 */
-func (r Stack) Reset() {
+func (r Stack) SetLogger(logger any) Stack {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.stack.reset()
+			r.stack.setLogger(logger)
 		}
 	}
+	return r
 }
 
 /*
-reset is a private method called by [Stack.Reset].
+SetLoggerDeep behaves as [Stack.SetLogger] does, except that the logger is
+also assigned to every nested [Stack] -- including those found within
+[Condition] expressions -- as well as to the [Condition] instances
+themselves. Read-only instances are silently skipped, though their
+contents are not. No other configuration is altered.
 */
-func (r *stack) reset() {
-	var ct int = 0
-	for i := r.ulen(); i > 0; i-- {
-		ct++
-		r.remove(i - 1)
+func (r Stack) SetLoggerDeep(logger any) Stack {
+	if r.IsInit() {
+		r.SetLogger(logger)
+		r.stack.eachDeep(visitSet{r.stack: true},
+			func(S Stack) { S.SetLogger(logger) },
+			func(C Condition) {
+				if !C.IsReadOnly() {
+					C.SetLogger(logger)
+				}
+			})
 	}
+	return r
 }
 
 /*
-Remove will remove and return the Nth slice from the index,
-along with a success-indicative Boolean value. A value of
-true indicates the receiver length became shorter by one (1).
+SetLogLevelDeep behaves as [Stack.SetLogLevel] does, except that the
+specified [LogLevel] instance(s) are also enabled within every nested
+[Stack] and [Condition], as described by [Stack.SetLoggerDeep].
+*/
+func (r Stack) SetLogLevelDeep(l ...any) Stack {
+	if r.IsInit() {
+		r.SetLogLevel(l...)
+		r.stack.eachDeep(visitSet{r.stack: true},
+			func(S Stack) { S.SetLogLevel(l...) },
+			func(C Condition) { C.SetLogLevel(l...) })
+	}
+	return r
+}
 
-Use of the Remove method shall not result in fragmentation of
-the stack: gaps resulting from the removal of slice instances
-shall immediately be "collapsed" using the subsequent slices
-available.  No action is taken if the receiver is empty or
-read-only.
+/*
+UnsetLogLevelDeep behaves as [Stack.UnsetLogLevel] does, except that the
+specified [LogLevel] instance(s) are also disabled within every nested
+[Stack] and [Condition], as described by [Stack.SetLoggerDeep].
 */
-func (r Stack) Remove(idx int) (slice any, ok bool) {
+func (r Stack) UnsetLogLevelDeep(l ...any) Stack {
 	if r.IsInit() {
-		if !r.getState(ronly) {
-			slice, ok = r.stack.remove(idx)
-		}
+		r.UnsetLogLevel(l...)
+		r.stack.eachDeep(visitSet{r.stack: true},
+			func(S Stack) { S.UnsetLogLevel(l...) },
+			func(C Condition) { C.UnsetLogLevel(l...) })
 	}
-	return
+	return r
 }
 
 /*
-remove is a private method called by [Stack.Remove].
+eachDeep executes stk for each [Stack] found beneath the receiver, including
+those found within [Condition] expressions, and cnd for each [Condition].
+Instances present within the seen set are not visited again.
 */
-func (r *stack) remove(idx int) (slice any, ok bool) {
+func (r *stack) eachDeep(seen visitSet, stk func(Stack), cnd func(Condition)) {
+	for i := 1; i < r.len(); i++ {
+		var inner Stack
+		if S, ok := stackTypeAliasConverter((*r)[i]); ok {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter((*r)[i]); ok && C.IsInit() {
+			cnd(C)
+			inner, _ = C.ExpressionAsStack()
+		}
 
-	var found bool
-	var index int
-	if slice, index, found = r.index(idx); found {
-		// note the len before we start
-		var u1 int = r.ulen()
-		var contents []any
-		var preserved int
+		if inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			stk(inner)
+			inner.stack.eachDeep(seen, stk, cnd)
+		}
+	}
+}
 
-		// zero out everything except the config slice
-		cfg, _ := r.config()
+/*
+Transfer will iterate the receiver (r) and add all slices contained
+therein to the destination instance (dest), which must be a previously
+initialized [Stack] or [Stack]-alias instance.
 
-		var R stack = make(stack, 0)
-		R = append(R, cfg)
+The number of slices transferred is returned alongside an error, if any.
 
-		r.lock()
-		defer r.unlock()
+If capacity constraints are in-force within the destination instance,
+and the transfer request cannot proceed due to it being larger than
+the number of available slices, no slices are transferred and an error
+wrapping [ErrCapacityViolation] is returned. If the destination's
+[PushPolicy] rejects one or more slices, the number of slices actually
+transferred is returned alongside an error. Nil slices are not transferred,
+and are not counted.
 
-		// Gather what we want to keep.
-		for i := 1; i < r.len(); i++ {
-			if index != i {
-				preserved++
-				contents = append(contents, (*r)[i])
-			}
-		}
+The receiver instance (r) is not modified in any way as a result of
+calling this method. If the receiver (source) should undergo a call to
+its [Stack.Reset] or [Stack.Free] methods following a call to the this
+method, only the source will be emptied, and all of the slices that have
+since been transferred instance shall remain in the destination instance.
+See also [Stack.Move] and [Stack.TransferN].
+*/
+func (r Stack) Transfer(dest any) (count int, err error) {
+	return r.transferTo(dest, -1, false)
+}
 
-		R = append(R, contents...)
+/*
+TransferN behaves identically to [Stack.Transfer], except that only the
+first n slices of the receiver are transferred. If n exceeds the length
+of the receiver, all slices are transferred.
+*/
+func (r Stack) TransferN(dest any, n int) (count int, err error) {
+	if n < 0 {
+		n = 0
+	}
+	return r.transferTo(dest, n, false)
+}
 
-		*r = R
+/*
+Move behaves identically to [Stack.Transfer], except that the receiver
+is reset, as with [Stack.Reset], once all of its slices have been added
+to the destination instance. The transfer and reset are conducted while
+the receiver is locked, thus no other operation shall observe slices as
+being present in both instances, provided that locking is enabled for
+the receiver. See [Stack.SetMutex].
 
-		// make sure we succeeded both in non-nilness
-		// and in the expected integer length change.
-		ok = slice != nil && u1-1 == r.ulen()
+The receiver is only reset if every non-nil slice was transferred successfully.
+An error wrapping [ErrReadOnly] is returned if either the receiver or the
+destination is read-only.
+*/
+func (r Stack) Move(dest any) (count int, err error) {
+	return r.transferTo(dest, -1, true)
+}
+
+/*
+transferTo is a private method called by [Stack.Transfer], [Stack.TransferN]
+and [Stack.Move]. A negative n indicates all slices are to be transferred.
+*/
+func (r Stack) transferTo(dest any, n int, move bool) (count int, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "source stack instance is nil")
+		return
+	}
+
+	op := `transfer`
+	if move {
+		op = `move`
+	}
+	r.stack.called(op, 1)
+	defer r.stack.ended()
+
+	s, ok := stackTypeAliasConverter(dest)
+	if !ok || !s.IsInit() {
+		err = wrapErr(ErrNotInitialized, "destination %T instance is nil or invalid", dest)
+		return
+	} else if s.stack == r.stack {
+		err = errorf("source and destination instances are identical")
+		return
+	} else if s.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot transfer", dest)
+		return
+	} else if move && r.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot move", r)
+		return
+	}
+
+	before := s.ulen()
+	var reset bool
+	count, reset, err = r.stack.transfer(s.stack, n, move)
+	s.stack.changedFrom(`transfer`, before)
+	s.stack.settled(`transfer`, before)
+	if reset {
+		r.stack.changed(`reset`, -1, nil)
+		r.stack.settled(`reset`, count)
 	}
 
 	return
 }
 
 /*
-SetParen sets the string-encapsulation bit for parenthetical
-expression within the receiver. The receiver shall undergo
-parenthetical encapsulation ( (...) ) during the string
-representation process. Individual string values shall not
-be encapsulated in parenthesis, only the whole (current)
-stack.
+Merge combines the slices of src, which must be a [Stack] or [Stack]-alias
+instance, into the receiver. The [MergePolicy] is consulted for each incoming
+slice, alongside the existing slice within the receiver to which it is equal
+per the rules described in [Stack.IsEqual], if any. A nil policy results in a
+deduplicating merge, wherein incoming slices already present are skipped.
 
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the encapsulation bit (i.e.: true->false
-and false->true)
+The order of the receiver's existing slices is preserved; new slices are
+appended in the order in which they appear within src.
+
+The number of slices actually added to the receiver is returned alongside
+an error, which shall wrap [ErrReadOnly] if the receiver is read-only, or
+[ErrCapacityViolation] if the receiver's capacity was reached. In the latter
+case, slices added prior to the violation are retained.
 */
-func (r Stack) SetParen(state ...bool) Stack {
-	r.setState(parens, state...)
-	return r
+func (r Stack) Merge(src any, policy MergePolicy) (added int, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "Not initialized")
+		return
+	} else if r.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot merge", r)
+		return
+	}
+
+	S, ok := stackTypeAliasConverter(src)
+	if !ok || !S.IsInit() {
+		err = errorf("%T is not a valid Stack; cannot merge", src)
+		return
+	}
+
+	if policy == nil {
+		policy = mergeDefault
+	}
+
+	before := r.ulen()
+	added, err = r.stack.merge(S.stack, policy)
+	r.stack.changedFrom(`merge`, before)
+
+	return
 }
 
 /*
-Deprecated: Use [Stack.SetParen].
+mergeDefault is the default [MergePolicy] used by [Stack.Merge]. Incoming
+slices are appended only if not already present.
 */
-func (r Stack) Paren(state ...bool) Stack {
-	return r.SetParen(state...)
+func mergeDefault(existing, incoming any) (any, bool) {
+	return incoming, existing != nil
 }
 
 /*
-IsNesting returns a Boolean value indicative of whether
-at least one (1) slice member is either a [Stack] or [Stack]
-type alias. If true, this indicates the relevant slice
-descends into another hierarchical (nested) context.
+merge is a private method called by [Stack.Merge].
 */
-func (r Stack) IsNesting() (is bool) {
-	if r.IsInit() {
-		is = r.stack.isNesting()
+func (r *stack) merge(src *stack, policy MergePolicy) (added int, err error) {
+	defer r.touch()
+	// snapshot the incoming slices, in case
+	// src and the receiver are one and the
+	// same.
+	incoming := make([]any, src.ulen())
+	copy(incoming, (*src)[1:])
+
+	r.lock()
+	defer r.unlock()
+
+	for i := 0; i < len(incoming) && err == nil; i++ {
+		var existing any
+		var eidx int = -1
+		for j := 1; j < r.len() && eidx == -1; j++ {
+			if valuesEqual((*r)[j], incoming[i]) == nil {
+				existing, eidx = (*r)[j], j
+			}
+		}
+
+		keep, skip := policy(existing, incoming[i])
+		if skip {
+			continue
+		} else if eidx != -1 {
+			(*r)[eidx] = keep
+			r.metaRenew(eidx-1, ``)
+		} else if r.isFull() {
+			err = wrapErr(ErrCapacityViolation, "failed: capacity violation")
+		} else {
+			*r = append(*r, keep)
+			r.metaInsert(r.ulen()-1, ``)
+			added++
+		}
 	}
-	return
 
+	return
 }
 
 /*
-isNesting is a private method called by [Stack.IsNesting].
+Strings returns the string slices of the receiver, in order, alongside an
+error. An error, which identifies the offending index and type, is returned
+if any non-nil slice is not a string. Nil slices are skipped.
+*/
+func (r Stack) Strings() (vals []string, err error) {
+	vals = make([]string, 0)
+	err = r.extract(func(slice any) (ok bool) {
+		var val string
+		if val, ok = slice.(string); ok {
+			vals = append(vals, val)
+		}
+		return
+	})
 
-When called, this method returns a Boolean value indicative
-of whether the receiver contains one (1) or more slice elements
-that match either of the following conditions:
+	return
+}
 
-  - Slice type is a [Stack] native type instance, OR ...
-  - Slice type is a [Stack] type-aliased instance
+/*
+Ints returns the int slices of the receiver, in order, alongside an error.
+An error, which identifies the offending index and type, is returned if any
+non-nil slice is not an int. Nil slices are skipped.
+*/
+func (r Stack) Ints() (vals []int, err error) {
+	vals = make([]int, 0)
+	err = r.extract(func(slice any) (ok bool) {
+		var val int
+		if val, ok = slice.(int); ok {
+			vals = append(vals, val)
+		}
+		return
+	})
 
-A return value of true is thrown at the first of either
-occurrence. Length of matched candidates is not significant
-during the matching process.
+	return
+}
+
+/*
+Float64s returns the float64 slices of the receiver, in order, alongside an
+error. An error, which identifies the offending index and type, is returned
+if any non-nil slice is not a float64. Nil slices are skipped.
 */
-func (r stack) isNesting() (is bool) {
+func (r Stack) Float64s() (vals []float64, err error) {
+	vals = make([]float64, 0)
+	err = r.extract(func(slice any) (ok bool) {
+		var val float64
+		if val, ok = slice.(float64); ok {
+			vals = append(vals, val)
+		}
+		return
+	})
 
-	// start iterating at index #1, thereby
-	// skipping the configuration slice.
-	for i := 1; i < r.len(); i++ {
+	return
+}
 
-		// perform a type switch on the
-		// current index, thereby allowing
-		// evaluation of slice types.
-		switch tv := r[i].(type) {
+/*
+Bools returns the bool slices of the receiver, in order, alongside an error.
+An error, which identifies the offending index and type, is returned if any
+non-nil slice is not a bool. Nil slices are skipped.
+*/
+func (r Stack) Bools() (vals []bool, err error) {
+	vals = make([]bool, 0)
+	err = r.extract(func(slice any) (ok bool) {
+		var val bool
+		if val, ok = slice.(bool); ok {
+			vals = append(vals, val)
+		}
+		return
+	})
 
-		// native Stack instance
-		case Stack:
-			is = true
+	return
+}
 
-		// type alias stack instnaces, since
-		// we have no knowledge of them here,
-		// will be matched in default using
-		// the stackTypeAliasConverter func.
-		default:
+/*
+extract is a private method called by [Stack.Strings], [Stack.Ints],
+[Stack.Float64s] and [Stack.Bools]. The input closure is executed for
+each non-nil slice, and shall return false if the slice is not of the
+desired type.
+*/
+func (r Stack) extract(fn func(any) bool) (err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+		return
+	}
 
-			// If convertible is true, we know the
-			// instance (tv) is a stack alias.
-			_, is = stackTypeAliasConverter(tv)
-		}
+	r.stack.lock()
+	defer r.stack.unlock()
 
-		if is {
+	for i := 1; i < r.stack.len(); i++ {
+		if slice := (*r.stack)[i]; slice != nil && !fn(slice) {
+			err = errorf("slice %d is of unexpected type %T", i-1, slice)
 			break
 		}
 	}
@@ -995,738 +1700,3738 @@ func (r stack) isNesting() (is bool) {
 }
 
 /*
-IsParen returns a Boolean value indicative of whether the
-receiver is parenthetical.
+ListFromStrings returns a new [List] [Stack] populated with vals.
 */
-func (r Stack) IsParen() bool {
-	return r.getState(parens)
+func ListFromStrings(vals []string) Stack {
+	S := List()
+	for i := 0; i < len(vals); i++ {
+		S.Push(vals[i])
+	}
+	return S
 }
 
 /*
-SetFold will fold the case of logical Boolean operators which
-are not represented through symbols. For example, `AND` becomes
-`and`, or vice versa. This won't have any effect on List-based
-receivers, or if symbols are used in place of said Boolean words.
-
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the case-folding bit (i.e.: true->false
-and false->true)
+ListFromInts returns a new [List] [Stack] populated with vals.
 */
-func (r Stack) SetFold(state ...bool) Stack {
-	r.setState(cfold, state...)
-	return r
+func ListFromInts(vals []int) Stack {
+	S := List()
+	for i := 0; i < len(vals); i++ {
+		S.Push(vals[i])
+	}
+	return S
 }
 
 /*
-Deprecated: Use [Stack.SetFold].
+ListFromFloat64s returns a new [List] [Stack] populated with vals.
 */
-func (r Stack) Fold(state ...bool) Stack {
-	return r.SetFold(state...)
+func ListFromFloat64s(vals []float64) Stack {
+	S := List()
+	for i := 0; i < len(vals); i++ {
+		S.Push(vals[i])
+	}
+	return S
 }
 
 /*
-SetNegativeIndices will enable negative index support when using
-the [Stack.Index] method extended by this type. See the method
-documentation for further details.
-
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the negative indices bit (i.e.: true->false
-and false->true)
+ListFromBools returns a new [List] [Stack] populated with vals.
 */
-func (r Stack) SetNegativeIndices(state ...bool) Stack {
-	r.setState(negidx, state...)
-	return r
+func ListFromBools(vals []bool) Stack {
+	S := List()
+	for i := 0; i < len(vals); i++ {
+		S.Push(vals[i])
+	}
+	return S
 }
 
 /*
-Deprecated: Use [Stack.SetForwardIndices].
+Chunk returns consecutive [Stack] instances, each containing at most size
+slices of the receiver, in order. Each instance is of the same kind as the
+receiver and inherits its ordering and presentation configuration, but not
+its capacity.
+
+The receiver is not modified, and slices are referenced rather than copied.
+A size of zero (0) or less results in a nil return value, while an empty
+receiver results in a zero length return value.
 */
-func (r Stack) NegativeIndices(state ...bool) Stack {
-	return r.SetNegativeIndices(state...)
-}
+func (r Stack) Chunk(size int) (chunks []Stack) {
+	if r.IsInit() && size > 0 {
+		r.stack.lock()
+		defer r.stack.unlock()
 
-/*
-SetForwardIndices will enable forward index support when using
-the [Stack.Index] method extended by this type. See the method
-documentation for further details.
+		chunks = make([]Stack, 0, (r.ulen()+size-1)/size)
+		for i := 0; i < r.ulen(); i += size {
+			end := i + size
+			if end > r.ulen() {
+				end = r.ulen()
+			}
+			chunks = append(chunks, r.stack.slice(i, end))
+		}
+	}
 
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the forward indices bit (i.e.: true->false
-and false->true)
-*/
-func (r Stack) SetForwardIndices(state ...bool) Stack {
-	r.setState(fwdidx, state...)
-	return r
+	return
 }
 
 /*
-Deprecated: Use [Stack.SetForwardIndices].
+Split returns two (2) [Stack] instances, the first (left) containing
+the slices of the receiver preceding user index idx, and the second
+(right) containing the slice at index idx and all that follow. Each
+instance is of the same kind as the receiver and inherits its ordering
+and presentation configuration, but not its capacity.
+
+The receiver is not modified, and slices are referenced rather than copied.
+An idx value which is negative, or which exceeds the receiver length, results
+in a false Boolean value. Splitting at the receiver length is permitted, and
+produces an empty right instance.
 */
-func (r Stack) ForwardIndices(state ...bool) Stack {
-	return r.SetForwardIndices(state...)
+func (r Stack) Split(idx int) (left Stack, right Stack, ok bool) {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		if ok = 0 <= idx && idx <= r.ulen(); ok {
+			left = r.stack.slice(0, idx)
+			right = r.stack.slice(idx, r.ulen())
+		}
+	}
+
+	return
 }
 
 /*
-SetDelimiter accepts input characters (as string, or a single rune) for use
-in controlled [Stack] value joining when the underlying [Stack] type is a LIST.
-In such a case, the input value shall be used for delimitation of all slice
-values during the string representation process.
+GroupBy returns a new [Stack] of the same kind as the receiver, in which
+the slices of the receiver are organized into nested [Stack] instances of
+the specified [StackKind] -- one (1) per distinct string value returned by
+the key closure, in order of first encounter. Each such group contains the
+slices which produced its key value, in their original order.
 
-A zero string, the NTBS (NULL) character -- ASCII #0 -- or nil, shall unset
-this value within the receiver.
+Slices for which key returns a zero string are not grouped, and instead
+remain at the top level, positioned amongst the groups according to their
+original order.
 
-If this method is executed using any other stack type, the operation has no
-effect. If using Boolean AND, OR or NOT stacks and a character delimiter is
-preferred over a Boolean WORD, see the [Stack.Symbol] method.
+The new instance, as well as each group, inherits the ordering and
+presentation configuration of the receiver, but not its capacity. Groups
+of a kind other than that of the receiver do not inherit its symbol; the
+symbol assigned to their kind via [Stack.SetSymbols], if any, is used in
+its place. The receiver is not modified, and slices are referenced rather
+than copied.
+
+An uninitialized [Stack] is returned if key is nil, or if kind is invalid.
 */
-func (r Stack) SetDelimiter(x any) Stack {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.stack.setListDelimiter(x)
-		}
+func (r Stack) GroupBy(key func(any) string, kind StackKind) (S Stack) {
+	if typ := kind.stackType(); r.IsInit() && key != nil && typ != 0 {
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		S = Stack{r.stack.groupBy(key, typ)}
 	}
 
-	return r
+	return
 }
 
 /*
-assertListDelimiter is a private function called (indirectly)
-by the [Stack.SetDelimiter] method for the purpose of handing
-type assertion for values that may express a particular value
-intended to serve as delimiter character for a LIST [Stack] when
-string representation is requested.
+groupBy is a private method called by [Stack.GroupBy].
 */
-func assertListDelimiter(x any) (v string) {
-	if x != nil {
-		switch tv := x.(type) {
-		case string:
-			v = tv
-		case rune:
-			if tv != rune(0) {
-				v = string(tv)
+func (r *stack) groupBy(key func(any) string, typ stackType) *stack {
+	grouped := r.sibling()
+	groups := make(map[string]*stack)
+
+	for i := 1; i < r.len(); i++ {
+		slice := (*r)[i]
+		k := key(slice)
+		if len(k) == 0 {
+			*grouped = append(*grouped, slice)
+			continue
+		}
+
+		group, found := groups[k]
+		if !found {
+			group = r.sibling()
+			gc, _ := group.config()
+			if gc.typ != typ {
+				// the receiver's symbol denotes its own kind
+				gc.sym = gc.sst[typ]
 			}
+			gc.typ = typ
+			groups[k] = group
+			*grouped = append(*grouped, Stack{group})
 		}
+		*group = append(*group, slice)
 	}
 
-	return
+	return grouped
 }
 
 /*
-Delimiter returns the delimiter string value currently set
-within the receiver instance.
+Union returns a new [Stack] containing the distinct slices of the receiver,
+followed by those slices of o -- a [Stack] or [Stack]-alias instance -- not
+already present. Neither the receiver nor o is modified.
+
+Membership is determined per the rules described in [Stack.IsEqual], thus
+nested [Stack] and [Condition] slices participate as whole values, and are
+not examined element-wise. As each slice is compared with every slice of
+the opposing instance, as well as those already admitted to the result, the
+cost is O(n*m).
+
+The new instance inherits the kind, ordering and presentation configuration
+of the receiver, but not its capacity. Slices are referenced rather than
+copied.
+
+An uninitialized receiver results in an uninitialized [Stack]. If o is not
+an initialized [Stack] or [Stack]-alias, the result bears no slices and an
+error, which wraps [ErrNotInitialized], accessible via [Stack.Err].
 */
-func (r Stack) Delimiter() string {
-	return r.stack.getListDelimiter()
+func (r Stack) Union(o any) Stack {
+	return r.setOperation(`union`, o)
 }
 
 /*
-setListDelimiter is a private method called by [Stack.SetDelimiter]
+Intersection returns a new [Stack] containing the distinct slices of the
+receiver which are also present within o, a [Stack] or [Stack]-alias
+instance. Neither the receiver nor o is modified.
+
+See [Stack.Union] for details relating to membership, cost, configuration
+inheritance and error handling.
 */
-func (r *stack) setListDelimiter(x any) {
-	sc, _ := r.config()
-	sc.setListDelimiter(assertListDelimiter(x))
+func (r Stack) Intersection(o any) Stack {
+	return r.setOperation(`intersection`, o)
 }
 
 /*
-getListDelimiter is a private method called by [Stack.Delimiter].
+Difference returns a new [Stack] containing the distinct slices of the
+receiver which are not present within o, a [Stack] or [Stack]-alias
+instance. Neither the receiver nor o is modified.
+
+See [Stack.Union] for details relating to membership, cost, configuration
+inheritance and error handling.
 */
-func (r *stack) getListDelimiter() string {
-	sc, _ := r.config()
-	delim := sc.getListDelimiter()
-	return delim
+func (r Stack) Difference(o any) Stack {
+	return r.setOperation(`difference`, o)
 }
 
 /*
-SetEncap accepts input characters for use in controlled [Stack] value
-encapsulation.
-
-A single string value will be used for both L and R encapsulation.
-
-An instance of []string with two (2) values will be used for L and R
-encapsulation using the first and second slice values respectively.
-
-An instance of []string with only one (1) value is identical to the
-act of providing a single string value, in that both L and R will use
-the one value.
+setOperation is a private method called by [Stack.Union], [Stack.Intersection]
+and [Stack.Difference].
 */
-func (r Stack) SetEncap(x ...any) Stack {
+func (r Stack) setOperation(op string, o any) (S Stack) {
 	if r.IsInit() {
-		if !r.getState(ronly) {
-			r.stack.setEncap(x...)
+		O, ok := stackTypeAliasConverter(o)
+
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		if S = (Stack{r.stack.sibling()}); !ok || !O.IsInit() {
+			S.stack.setErr(wrapErr(ErrNotInitialized,
+				"%T is not a valid Stack; cannot perform %s", o, op))
+		} else {
+			S.stack.setOperation(op, (*r.stack)[1:], (*O.stack)[1:])
 		}
 	}
 
-	return r
+	return
 }
 
 /*
-Deprecated: Use [Stack.SetEncap].
+setOperation appends the outcome of the set operation (op) upon the
+slices of a and b to the receiver, skipping those already present.
 */
-func (r Stack) Encap(x ...any) Stack {
-	return r.SetEncap(x...)
+func (r *stack) setOperation(op string, a, b []any) {
+	defer r.touch()
+	for i := 0; i < len(a); i++ {
+		in := containsValue(b, a[i])
+		if (op == `intersection` && !in) || (op == `difference` && in) {
+			continue
+		} else if !containsValue((*r)[1:], a[i]) {
+			*r = append(*r, a[i])
+		}
+	}
+
+	if op == `union` {
+		for i := 0; i < len(b); i++ {
+			if !containsValue((*r)[1:], b[i]) {
+				*r = append(*r, b[i])
+			}
+		}
+	}
 }
 
 /*
-setEncap is a private method called by [Stack.Encap].
+slice is a private method called by [Stack.Chunk] and [Stack.Split]. It
+returns a sibling [Stack] containing the user slices from index i up to,
+but not including, index j.
 */
-func (r *stack) setEncap(x ...any) {
-	sc, _ := r.config()
-	sc.setEncap(x...)
+func (r *stack) slice(i, j int) Stack {
+	sib := r.sibling()
+	*sib = append(*sib, (*r)[i+1:j+1]...)
+	return Stack{sib}
 }
 
 /*
-IsEncap returns a Boolean value indicative of whether value encapsulation
-characters have been set within the receiver.
+transfer is a private method executed by the [Stack.Transfer],
+[Stack.TransferN] and [Stack.Move] methods. It will add the first
+n slices of the receiver (r) to dest, or all slices if n is
+negative. Configuration is not copied, nor is a destination
+*stack subject to initialization within this method. Thus, the
+user must submit a *stack instance ready to receive slices
+immediately.
+
+Capacity enforcement is honored. If the requested slices exceed
+the number of slices available within a capacity-limited dest,
+the operation is canceled outright.
+
+If move is true, and all requested slices were transferred, the
+receiver is reset while still locked, and reset is returned as
+true.
 */
-func (r Stack) IsEncap() (is bool) {
-	if r.IsInit() {
-		is = len(r.stack.getEncap()) > 0
+func (r *stack) transfer(dest *stack, n int, move bool) (count int, reset bool, err error) {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
+
+	if n < 0 || n > r.ulen() {
+		n = r.ulen()
+	}
+
+	// Slice type is not subject to discrimination,
+	// but nil slices are neither transferred nor
+	// counted.
+	xfer := make([]any, 0, n)
+	for i := 1; i <= n; i++ {
+		if (*r)[i] != nil {
+			xfer = append(xfer, (*r)[i])
+		}
+	}
+
+	// if a capacity was set, make sure the
+	// destination's remaining availability
+	// can handle it...
+	if avail := dest.cap() - dest.len(); dest.cap() > 0 && len(xfer) > avail {
+		// capacity is in-force, and
+		// there are too many slices
+		// to xfer.
+		err = wrapErr(ErrCapacityViolation,
+			"failed: capacity violation; %d slices requested, %d available", len(xfer), avail)
+		dest.setErr(err)
+		return
 	}
+
+	before := dest.ulen()
+	dest.push(xfer...)
+
+	if count = dest.ulen() - before; count < len(xfer) {
+		err = errorf("transfer incomplete; %d of %d slices transferred", count, len(xfer))
+	} else if move {
+		*r = append(make(stack, 0, 1), (*r)[0])
+		r.metaReset()
+		reset = true
+	}
+
 	return
 }
 
 /*
-getEncap returns the current value encapsulation character pattern
-set within the receiver instance.
-*/
-func (r *stack) getEncap() (encs [][]string) {
-	sc, _ := r.config()
-	encs = sc.enc
+Replace will overwrite slice idx using value x and returns a Boolean
+value indicative of success. This method wraps [Stack.Exchange], and
+discards the previous value.
+
+If slice i does not exist (e.g.: idx > receiver len), then nothing is
+altered and a false Boolean value is returned.
+
+Use of the Replace method shall not result in fragmentation of the
+receiver instance; this method does not honor any attempt to replace
+any receiver slice value with nil.
 
+As with [Stack.Exchange], the value x is subject to the push policy in
+effect, if any.
+*/
+func (r Stack) Replace(x any, idx int) (ok bool) {
+	_, ok = r.Exchange(x, idx)
 	return
 }
 
 /*
-SetID assigns the provided string value (or lack thereof) to the receiver.
-This is optional, and is usually only needed in complex structures in which
-"labeling" certain components may be advantageous. It has no effect on an
-evaluation, nor should a name ever cause a validity check to fail.
+Exchange will overwrite slice idx using value x, returning the value that
+was displaced alongside a Boolean value indicative of success. The read and
+write occur under a single lock, if mutex support is enabled for the receiver.
 
-If the string `_random` is provided, a 24-character alphanumeric string is
-randomly generated using math/rand and assigned as the ID.
+When negative index support is enabled (see [Stack.NegativeIndices]), a
+negative idx value is resolved in the same manner as [Stack.Index]. If
+slice idx does not exist, nothing is altered and nil is returned alongside
+a Boolean value of false.
+
+As with [Stack.Replace], a nil x value is not honored, nor are read-only
+receivers altered.
+
+The value x is subject to the [PushPolicy] or [PushPolicyContext] in effect,
+if any, unless exempted via [Stack.SetPolicyExemptReplace]. A refused value
+is not written, and the policy's error is set within the receiver.
 */
-func (r Stack) SetID(id string) Stack {
-	if r.IsInit() {
+func (r Stack) Exchange(x any, idx int) (prev any, ok bool) {
+	if r.IsInit() && x != nil {
 		if !r.getState(ronly) {
-			r.stack.setID(id)
+			r.stack.called(`replace`, 2)
+			defer r.stack.ended()
+			var i int
+			if i, ok = r.stack.swapIndex(idx); ok {
+				if r.stack.admit(x, i-1) != nil {
+					return nil, false
+				}
+				r.stack.lock()
+				if r.stack.refuseDuplicate(x, i-1) != nil {
+					r.stack.unlock()
+					return nil, false
+				} else if prev, ok = r.stack.exchange(x, i-1); ok {
+					r.stack.metaRenew(i-1, ``)
+				}
+				r.stack.unlock()
+				if ok {
+					r.stack.changed(`replace`, i-1, x)
+				}
+			}
 		}
 	}
 
-	return r
+	return
 }
 
 /*
-setID is a private method called by [Stack.SetID].
+exchange is a private method called by [Stack.Exchange] and, by way of
+stack.replace, during reveal processing. The caller is expected to hold
+the receiver's lock, if applicable. The user index i must not be negative.
 */
-func (r *stack) setID(id string) {
-	sc, _ := r.config()
-	if lc(id) == `_random` {
-		id = randomID(randIDSize)
-	} else if lc(id) == `_addr` {
-		id = ptrString(r)
+func (r *stack) exchange(x any, i int) (prev any, ok bool) {
+	defer r.touch()
+	if r != nil {
+		if ok = 0 <= i && i+1 <= r.ulen(); ok {
+			prev = (*r)[i+1]
+			(*r)[i+1] = x
+			r.dropUnique()
+		}
 	}
 
-	r.lock()
-	defer r.unlock()
+	return
+}
 
-	sc.setID(id)
+/*
+replace is a private method which wraps stack.exchange, discarding the
+previous value.
+*/
+func (r *stack) replace(x any, i int) (ok bool) {
+	_, ok = r.exchange(x, i)
+	return
 }
 
 /*
-SetCategory assigns the provided string to the stack's internal category
-value. This allows for a means of identifying a particular kind of stack
-in the midst of many.
+Insert will insert value x to become the left index. For example,
+using zero (0) as left shall result in value x becoming the first
+slice within the receiver.
+
+This method returns a Boolean value indicative of success. A value
+of true indicates the receiver length became longer by one (1).
+
+When negative index support is enabled (see [Stack.NegativeIndices]),
+a negative left value is resolved in the same manner as [Stack.Index],
+thus -1 shall result in value x being inserted before the final slice.
+An unresolvable negative value, or any negative value when such support
+is disabled, shall become zero (0). An integer value that exceeds the
+length of the receiver shall become index len-1, which is consistent
+with forward index support (see [Stack.ForwardIndices]). A value that
+falls within the bounds of the receiver's current length is inserted as
+intended.
+
+Use of the Insert method shall not result in fragmentation of the
+receiver instance, as any nil x value shall be discarded and not
+considered for insertion into the stack.
+
+The value x is subject to the [PushPolicy] or [PushPolicyContext] in effect,
+if any, unless exempted via [Stack.SetPolicyExemptReplace]. A refused value
+is not inserted, and the policy's error is set within the receiver.
+
+See also the [Stack.InsertAfter] method.
 */
-func (r Stack) SetCategory(cat string) Stack {
-	if r.IsInit() {
+func (r Stack) Insert(x any, left int) (ok bool) {
+	if r.IsInit() && x != nil {
 		if !r.getState(ronly) {
-			r.stack.setCat(cat)
+			before := r.stack.called(`insert`, 2)
+			defer r.stack.ended()
+			left = r.stack.insertIndex(left)
+			if err := r.stack.admit(x, min(left, r.ulen())); err == nil {
+				r.stack.lock()
+				ok = r.stack.insert(x, left)
+				r.stack.unlock()
+				if ok {
+					// determine where x actually landed
+					if L := r.ulen(); left > L-1 {
+						left = L - 1
+					}
+					r.stack.changed(`insert`, left, x)
+				}
+			}
+			r.stack.settled(`insert`, before)
 		}
 	}
-	return r
+	return
 }
 
 /*
-setCat is a private method called by [Stack.SetCategory].
+InsertAfter will insert value x immediately to the right of the slice
+found at index idx. For example, using len-1 as idx is equivalent to
+an append operation.
+
+When negative index support is enabled, a negative idx is resolved to
+its slot (len+idx) in the same manner as [Stack.Insert], thus -1 shall
+result in value x being inserted before the final slice.
+
+This method returns a Boolean value indicative of success, and otherwise
+behaves identically to [Stack.Insert].
 */
-func (r *stack) setCat(cat string) {
-	sc, _ := r.config()
-	sc.setCat(cat)
+func (r Stack) InsertAfter(x any, idx int) (ok bool) {
+	if idx < 0 && r.getState(negidx) {
+		return r.Insert(x, idx)
+	}
+
+	return r.Insert(x, idx+1)
 }
 
 /*
-Category returns the categorical label string value assigned to the receiver,
-if set, else a zero string.
+IsSorted returns a Boolean value indicative of whether the user slices of
+the receiver are in sorted order per [Stack.Less], and thus per the closure
+set via [Stack.SetLessFunc], if any. The receiver is not modified.
 */
-func (r Stack) Category() (cat string) {
+func (r Stack) IsSorted() (is bool) {
 	if r.IsInit() {
-		cat = r.stack.getCat()
+		is = sort.IsSorted(r)
 	}
 	return
 }
 
 /*
-getCat is a private method called by [Stack.Category].
-*/
-func (r *stack) getCat() (cat string) {
-	sc, _ := r.config()
-	cat = sc.cat
+SortedInsert inserts value x into the receiver at the position dictated
+by [Stack.Less], thereby keeping a sorted receiver sorted without need of
+a subsequent [sort] operation. The insertion point is found by binary
+search, and follows any slices equal to x. The final index of x is returned
+alongside a Boolean value indicative of success.
+
+If the receiver is not sorted (see [Stack.IsSorted]), x is appended instead,
+and its index is returned alongside a Boolean value of false. An index of -1
+is returned if x was not added at all.
+
+Capacity, read-only state, uniqueness and the [PushPolicy] or [PushPolicyContext]
+in effect are honored exactly as with [Stack.Insert]. As x must be present in
+order to be compared by index, any [PushPolicyContext] shall perceive x as being
+appended.
+
+The sort check, the insertion and the relocation of x are conducted under a
+single lock, if enabled (see [Stack.SetMutex]), such that concurrent callers
+cannot disturb the order between them.
+*/
+func (r Stack) SortedInsert(x any) (idx int, ok bool) {
+	idx = -1
+	if sc := r.stack.lockConfig(); sc != nil && x != nil {
+		if !sc.positive(ronly) {
+			sc.logCall(`sorted_insert`, 1)
+			defer sc.endCall()
+			var added bool
+			r.stack.lock()
+			sorted := r.IsSorted()
+			L := r.ulen()
+			if err := r.stack.admit(x, L); err == nil {
+				if added = r.stack.insert(x, L); added {
+					if idx = L; sorted {
+						idx = sort.Search(L, func(i int) bool {
+							return r.Less(L, i)
+						})
+						r.stack.move(L, idx)
+					}
+					ok = sorted
+				}
+			}
+			r.stack.unlock()
+			after := L
+			if added {
+				after++
+				sc.changed(`insert`, idx, x)
+			}
+			sc.logLen(`sorted_insert`, L, after)
+		}
+	}
 	return
 }
 
 /*
-ID returns the assigned identifier string, if set, from within the underlying
-stack configuration.
+move relocates the slice found at user index from to user index to, which
+must not exceed from, shifting all slices in between to the right. The
+caller must hold the lock.
 */
-func (r Stack) ID() (id string) {
-	id = "unspecified"
-	if r.IsInit() {
-		id = r.stack.getID()
+func (r *stack) move(from, to int) {
+	defer r.touch()
+
+	for i := from; i > to; i-- {
+		(*r)[i+1], (*r)[i] = (*r)[i], (*r)[i+1]
+		r.metaSwap(i, i-1)
 	}
-	return
 }
 
 /*
-getID is a private method called by [Stack.ID].
+insertIndex returns the resolved insertion index for the left input value
+in accordance with any negative index support enabled within the receiver.
 */
-func (r *stack) getID() string {
-	sc, _ := r.config()
-	return sc.id
+func (r stack) insertIndex(left int) int {
+	if left < 0 {
+		if L := r.ulen(); r.positive(negidx) && -left <= L {
+			return L + left
+		}
+		return 0
+	}
+
+	return left
 }
 
 /*
-SetLeadOnce sets the lead-once bit within the receiver. This
-causes two (2) things to happen:
+insert is a private method called by [Stack.Insert] and [Stack.SortedInsert].
+The caller must hold the lock.
+*/
+func (r *stack) insert(x any, left int) (ok bool) {
+	defer r.touch()
+	// note the len before we start
+	var u1 int = r.ulen()
 
-  - Only use the configured operator once in a stack, and ...
-  - Only use said operator at the very beginning of the stack string value
+	// bail out if a capacity has been set and
+	// would be breached by this insertion.
+	if u1+1 > r.cap()-1 && r.cap() != 0 {
+		r.setErr(wrapErr(ErrCapacityViolation, "failed: capacity violation"))
+		return
+	}
 
-Execution without a Boolean input value will *TOGGLE* the
-current state of the lead-once bit (i.e.: true->false and
-false->true)
-*/
-func (r Stack) SetLeadOnce(state ...bool) Stack {
-	r.setState(lonce, state...)
-	return r
+	if r.refuseDuplicate(x, -1) != nil {
+		return
+	}
+
+	cfg, _ := r.config()
+
+	// If left is greater-than-or-equal
+	// to the user length, just push.
+	if u1-1 < left {
+		*r = append(*r, x)
+		r.metaInsert(u1, ``)
+
+		// Verify something was added
+		ok = u1+1 == r.ulen()
+		return
+	}
+
+	var R stack = make(stack, 0)
+	left += 1
+
+	// If left is less-than-or-equal to
+	// zero (0), we'll use a new stack
+	// alloc (R) and move items into it
+	// in the appropriate order. The
+	// new element will be the first
+	// user slice.
+	if left <= 1 {
+		left = 1
+		R = append(R, cfg)
+		R = append(R, x)
+		R = append(R, (*r)[left:]...)
+
+		// If left falls within the user length,
+		// append all elements up to and NOT
+		// including the desired index, and also
+		// append everything after desired index.
+		// This leaves a slot into which we can
+		// drop the new element (x)
+	} else {
+		R = append((*r)[:left+1], (*r)[left:]...)
+		R[left] = x
+	}
+
+	// Verify something was added
+	*r = R
+	r.metaInsert(left-1, ``)
+	ok = u1+1 == r.ulen()
+
+	return
 }
 
 /*
-Deprecated: Use [Stack.SetLeadOnce].
+Free frees the receiver instance entirely, including the underlying
+configuration. An error is returned if the instance is read-only or
+uninitialized.
+
+The [Auxiliary] map and all closures (policies, hooks, stringers and
+the like) held by the configuration are released, such that any values
+they reference may be reclaimed. Note that this affects all references
+to the same underlying instance.
+
+See also [Stack.Reset] and [Stack.FreeDeep].
 */
-func (r Stack) LeadOnce(state ...bool) Stack {
-	return r.SetLeadOnce(state...)
+func (r *Stack) Free() (err error) {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.release()
+			r.stack = nil
+			return
+		}
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot free", r)
+	}
+
+	return
 }
 
 /*
-SetNoPadding sets the no-space-padding bit within the receiver.
-String values within the receiver shall not be padded using
-a single space character (ASCII #32).
+FreeDeep frees the receiver as [Stack.Free] does, having first freed all
+nested content from the bottom up: each [Stack] and [Stack] type alias is
+emptied and its configuration discarded, while each [Condition] and
+[Condition] type alias has its expression value(s) cleared before being
+freed likewise, including any [Stack] expression. Any other references
+held to the freed content are rendered uninitialized.
 
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the padding bit (i.e.: true->false and
-false->true)
+An error, which names the path of the offending member, is returned if the
+receiver or any member within it is read-only, in which case nothing is
+freed. A Boolean input value of true (force) disregards read-only states.
 */
-func (r Stack) SetNoPadding(state ...bool) Stack {
-	r.setState(nspad, state...)
-	return r
-}
+func (r *Stack) FreeDeep(force ...bool) (err error) {
+	if r.IsInit() {
+		seen := visitSet{r.stack: true}
+		if len(force) == 0 || !force[0] {
+			if r.getState(ronly) {
+				err = wrapErr(ErrReadOnly, "%T is read-only; cannot free", r)
+				return
+			} else if err = r.stack.frozenDeep(nil, seen); err != nil {
+				return
+			}
+			seen = visitSet{r.stack: true}
+		}
 
-/*
-Deprecated: Use [Stack.SetNoPadding].
-*/
-func (r Stack) NoPadding(state ...bool) Stack {
-	return r.SetNoPadding(state...)
+		r.stack.freeDeep(seen)
+		r.stack = nil
+	}
+
+	return
 }
 
 /*
-SetNoNesting sets the no-nesting bit within the receiver. If
-set to true, the receiver shall ignore any [Stack] or [Stack]
-type alias instance when pushed using the [Stack.Push] method.
-In such a case, only primitives, [Condition] instances, etc.,
-shall be honored during the [Stack.Push] operation.
+frozenDeep is a private method called by [Stack.FreeDeep]. An error is
+returned describing the first read-only member found beneath the receiver,
+whose path is indicated.
+*/
+func (r *stack) frozenDeep(path []int, seen visitSet) (err error) {
+	for i := 1; i < r.len() && err == nil; i++ {
+		p := append(append([]int{}, path...), i-1)
+		x := (*r)[i]
 
-Note this will only have an effect when not using a custom
-[PushPolicy]. When using a custom [PushPolicy], the user has
-total control -- and full responsibility -- in deciding
-what may or may not be pushed.
+		var inner Stack
+		if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+			if C.getState(ronly) {
+				err = wrapErr(ErrReadOnly, "slice %s: %T is read-only; cannot free", pathString(p), x)
+				return
+			}
+			inner, _ = C.ExpressionAsStack()
+		}
 
-Also note that setting or unsetting this bit shall not, in
-any way, have an impact on pre-existing [Stack] or [Stack] type
-alias instances within the receiver. This bit only has an
-influence on the [Stack.Push] method and only when set to true.
+		if !inner.IsInit() || seen[inner.stack] {
+			continue
+		} else if inner.getState(ronly) {
+			err = wrapErr(ErrReadOnly, "slice %s: %T is read-only; cannot free", pathString(p), inner)
+		} else {
+			seen[inner.stack] = true
+			err = inner.stack.frozenDeep(p, seen)
+		}
+	}
 
-A Boolean input value explicitly sets the bit as intended.
-Execution without a Boolean input value will *TOGGLE* the
-current state of the nesting bit (i.e.: true->false and
-false->true)
-*/
-func (r Stack) SetNoNesting(state ...bool) Stack {
-	r.setState(nnest, state...)
-	return r
+	return
 }
 
 /*
-Deprecated: Use [Stack.SetNoNesting].
+freeDeep is a private method called by [Stack.FreeDeep]. The receiver is
+emptied -- thus rendering it uninitialized -- once its members are freed.
 */
-func (r Stack) NoNesting(state ...bool) Stack {
-	return r.SetNoNesting(state...)
-}
+func (r *stack) freeDeep(seen visitSet) {
+	for i := 1; i < r.len(); i++ {
+		var inner Stack
+		if S, ok := stackTypeAliasConverter((*r)[i]); ok {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter((*r)[i]); ok && C.IsInit() {
+			inner, _ = C.ExpressionAsStack()
+			C.condition.free()
+		}
 
-/*
-CanNest returns a Boolean value indicative of whether
-the no-nesting bit is unset, thereby allowing the push
-of [Stack] and/or [Stack] type alias instances.
+		if inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			inner.stack.freeDeep(seen)
+		}
+	}
 
-See also the [Stack.IsNesting] method.
-*/
-func (r Stack) CanNest() bool {
-	return r.getState(nnest)
+	if sc, err := r.config(); err == nil {
+		sc.release()
+	}
+	r.unregister()
+	*r = nil
 }
 
 /*
-IsPadded returns a Boolean value indicative of whether the
-receiver pads its contents with a SPACE char (ASCII #32).
+Reset will silently delete all slices found within the receiver,
+leaving it unpopulated but still retaining its active configuration.
+Nothing is returned.  No action is taken if the receiver is empty.
+
+See also [Stack.ResetKeepCap] and [Stack.Free].
 */
-func (r Stack) IsPadded() bool {
-	return !r.getState(nspad)
+func (r Stack) Reset() {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			before := r.stack.called(`reset`, 0)
+			defer r.stack.ended()
+			r.stack.reset()
+			r.stack.changed(`reset`, -1, nil)
+			r.stack.settled(`reset`, before)
+		}
+	}
 }
 
 /*
-SetReadOnly sets the receiver bit 'ronly' to a positive state.
-This will prevent any writes to the receiver or its underlying
-configuration.
+reset is a private method called by [Stack.Reset].
 */
-func (r Stack) SetReadOnly(state ...bool) Stack {
-	r.setState(ronly, state...)
-	return r
+func (r *stack) reset() {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
+
+	*r = append(make(stack, 0, 1), (*r)[0])
+	r.metaReset()
 }
 
 /*
-Deprecated: Use [Stack.SetReadOnly].
+ResetKeepCap behaves identically to [Stack.Reset], except that the
+existing backing array -- and therefore its capacity -- is retained
+for reuse by subsequent pushes. This may reduce allocations when a
+[Stack] is repeatedly repopulated with a similar number of slices.
 */
-func (r Stack) ReadOnly(state ...bool) Stack {
-	return r.SetReadOnly(state...)
+func (r Stack) ResetKeepCap() {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			before := r.stack.called(`reset`, 0)
+			defer r.stack.ended()
+			r.stack.resetKeepCap()
+			r.stack.changed(`reset`, -1, nil)
+			r.stack.settled(`reset`, before)
+		}
+	}
 }
 
 /*
-IsReadOnly returns a Boolean value indicative of whether the
-receiver is set as read-only.
+resetKeepCap is a private method called by [Stack.ResetKeepCap].
 */
-func (r Stack) IsReadOnly() bool {
-	return r.getState(ronly)
-}
+func (r *stack) resetKeepCap() {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
 
-/*
-SetSymbol sets the provided symbol expression, which will be a sequence
-of any characters desired, to represent various Boolean operators without
-relying on words such as "AND". If a non-zero sequence of characters is
-set, they will be used to supplant the default word-based operators within
-the given stack in which the symbol is configured.
+	// zero out user slices so that their
+	// values may be garbage collected.
+	for i := 1; i < r.len(); i++ {
+		(*r)[i] = nil
+	}
 
-Acceptable input types are string and rune.
+	*r = (*r)[:1]
+	r.metaReset()
+}
 
-Execution of this method with no arguments empty the symbol store within
-the receiver, thereby returning to the default word-based behavior.
+/*
+Remove will remove and return the Nth slice from the index,
+along with a success-indicative Boolean value. A value of
+true indicates the receiver length became shorter by one (1).
 
-This method has no effect on list-style [Stack] instances.
+Use of the Remove method shall not result in fragmentation of
+the stack: gaps resulting from the removal of slice instances
+shall immediately be "collapsed" using the subsequent slices
+available.  No action is taken if the receiver is empty or
+read-only.
 */
-func (r Stack) SetSymbol(c ...any) Stack {
+func (r Stack) Remove(idx int) (slice any, ok bool) {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.stack.setSymbol(c...)
+			before := r.stack.called(`remove`, 1)
+			defer r.stack.ended()
+			_, raw, _ := r.stack.index(idx)
+			if slice, ok = r.stack.remove(idx); ok {
+				r.stack.changed(`remove`, raw-1, slice)
+			}
+			r.stack.settled(`remove`, before)
 		}
 	}
-
-	return r
+	return
 }
 
 /*
-Deprecated: Use [Stack.SetSymbol].
-*/
-func (r Stack) Symbol(c ...any) Stack {
-	return r.SetSymbol(c...)
-}
+RemoveRange removes and returns the slices found within the inclusive
+range of user indices from and to, alongside a success-indicative Boolean
+value. The removal is conducted in a single pass, under a single lock if
+mutex support is enabled for the receiver. The order of the remaining
+slices is preserved.
 
-/*
-setSymbol is a private method called by [Stack.Symbol].
+Negative and forward index support are honored for both from and to, in
+the same manner as [Stack.Index]. No action is taken if either index is
+out of range, if from (once resolved) exceeds to, or if the receiver is
+read-only.
 */
-func (r *stack) setSymbol(c ...any) {
-	var str string
-	for i := 0; i < len(c); i++ {
-		switch tv := c[i].(type) {
-		case string:
-			str += tv
-		case rune:
-			char := string(tv)
-			str += char
+func (r Stack) RemoveRange(from, to int) (removed []any, ok bool) {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			before := r.stack.called(`remove_range`, 2)
+			defer r.stack.ended()
+			var first int
+			if removed, first, ok = r.stack.removeRange(from, to); ok {
+				for i := 0; i < len(removed); i++ {
+					r.stack.changed(`remove`, first+i, removed[i])
+				}
+			}
+			r.stack.settled(`remove_range`, before)
 		}
 	}
 
-	if sc, _ := r.config(); sc.typ != list {
-		r.lock()
-		defer r.unlock()
-		sc.setSymbol(str)
-	}
+	return
 }
 
 /*
-getSymbol returns the symbol stored within the underlying *nodeConfig
-instance slice.
+removeRange is a private method called by [Stack.RemoveRange]. The user
+index of the first slice removed is returned alongside the slices.
 */
-func (r stack) getSymbol() (sym string) {
-	sc, _ := r.config()
-	sym = sc.sym
-	return sym
-}
-
-func (r *stack) setLogger(logger any) {
-	cfg, _ := r.config()
-
+func (r *stack) removeRange(from, to int) (removed []any, first int, ok bool) {
 	r.lock()
 	defer r.unlock()
+	defer r.touch()
 
-	cfg.log.setLogger(logger)
-}
-
-func (r Stack) getState(cf cfgFlag) (state bool) {
-	if r.IsInit() {
-		state = r.stack.positive(cf)
+	i, iok := r.rangeIndex(from)
+	j, jok := r.rangeIndex(to)
+	if ok = iok && jok && i <= j; !ok {
+		return
 	}
+
+	removed = append([]any{}, (*r)[i:j+1]...)
+	n := i + copy((*r)[i:], (*r)[j+1:])
+	clear((*r)[n:])
+	*r = (*r)[:n]
+	r.metaRemoveRange(i-1, j-1)
+	first = i - 1
+
 	return
 }
 
-func (r Stack) setState(cf cfgFlag, state ...bool) {
-	if r.IsInit() {
-		if !r.getState(ronly) || cf == ronly {
-			if len(state) > 0 {
-				if state[0] {
-					r.stack.setOpt(cf)
-				} else {
-					r.stack.unsetOpt(cf)
-				}
-			} else {
-				r.stack.toggleOpt(cf)
-			}
-		}
+/*
+rangeIndex returns the raw (config-offset) index for the user index (i)
+alongside a Boolean value indicative of whether the index is in range. In
+addition to the negative indices resolved by stack.swapIndex, indices
+beyond the final slice resolve to the final slice if forward index support
+is enabled.
+*/
+func (r stack) rangeIndex(i int) (idx int, ok bool) {
+	if L := r.ulen(); i >= L && L > 0 && r.positive(fwdidx) {
+		return L, true
 	}
+
+	return r.swapIndex(i)
 }
 
 /*
-toggleOpt returns the receiver value in fluent-style after a
-locked modification of the underlying *nodeConfig instance
-to TOGGLE the state of a particular option.
-*/
-func (r *stack) toggleOpt(x cfgFlag) *stack {
-	cfg, _ := r.config()
+RemoveIf removes and returns all slices for which the match function
+returns true. The order of the remaining slices is preserved, and no
+fragmentation results, consistent with [Stack.Remove]. Only the slices
+of the receiver are considered: nested [Stack] instances are removed
+whole when matched, and are otherwise untouched.
 
-	r.lock()
-	defer r.unlock()
-
-	cfg.toggleOpt(x)
+No action is taken if match is nil, or if the receiver is read-only.
+*/
+func (r Stack) RemoveIf(match func(any) bool) (removed []any) {
+	if r.IsInit() && match != nil {
+		if !r.getState(ronly) {
+			before := r.stack.called(`remove_if`, 1)
+			defer r.stack.ended()
+			var idxs []int
+			removed, idxs = r.stack.removeIf(match)
+			for i := 0; i < len(removed); i++ {
+				r.stack.changed(`remove`, idxs[i], removed[i])
+			}
+			r.stack.settled(`remove_if`, before)
+		}
+	}
 
-	return r
+	return
 }
 
 /*
-setOpt returns the receiver value in fluent-style after a
-locked modification of the underlying *nodeConfig instance
-to SET a particular option.
+removeIf is a private method called by [Stack.RemoveIf]. The original
+user indices of the slices removed are returned alongside the slices.
 */
-func (r *stack) setOpt(x cfgFlag) *stack {
-	cfg, _ := r.config()
-
+func (r *stack) removeIf(match func(any) bool) (removed []any, idxs []int) {
 	r.lock()
 	defer r.unlock()
+	defer r.touch()
 
-	cfg.setOpt(x)
+	pat := make([]int, r.ulen())
+	n := 1
+	for i := 1; i < r.len(); i++ {
+		if x := (*r)[i]; match(x) {
+			removed = append(removed, x)
+			idxs = append(idxs, i-1)
+		} else {
+			(*r)[n] = x
+			pat[i-1] = 1
+			n++
+		}
+	}
 
-	return r
+	if len(removed) > 0 {
+		clear((*r)[n:])
+		*r = (*r)[:n]
+		r.metaCompact(pat)
+	}
+
+	return
 }
 
 /*
-unsetOpt returns the receiver value in fluent-style after
-a locked modification of the underlying *nodeConfig instance
-to UNSET a particular option.
+remove is a private method called by [Stack.Remove].
 */
-func (r *stack) unsetOpt(x cfgFlag) *stack {
-	cfg, _ := r.config()
+func (r *stack) remove(idx int) (slice any, ok bool) {
+	defer r.touch()
+	var found bool
+	var index int
+	if slice, index, found = r.index(idx); found {
+		// note the len before we start
+		var u1 int = r.ulen()
 
-	r.lock()
-	defer r.unlock()
+		r.lock()
+		defer r.unlock()
 
-	cfg.unsetOpt(x)
+		// close the gap in place, and zero out
+		// the vacated final slot so its value
+		// may be garbage collected.
+		last := r.len() - 1
+		copy((*r)[index:], (*r)[index+1:])
+		(*r)[last] = nil
+		*r = (*r)[:last]
+		r.metaRemove(index - 1)
 
-	return r
+		// make sure we succeeded both in non-nilness
+		// and in the expected integer length change.
+		ok = slice != nil && u1-1 == r.ulen()
+	}
+
+	return
 }
 
 /*
-positive returns a Boolean value indicative of whether the specified
-cfgFlag input value is "on" within the receiver's configuration
-value.
-*/
-func (r stack) positive(x cfgFlag) bool {
-	cfg, _ := r.config()
+SetParen sets the string-encapsulation bit for parenthetical
+expression within the receiver. The receiver shall undergo
+parenthetical encapsulation ( (...) ) during the string
+representation process. Individual string values shall not
+be encapsulated in parenthesis, only the whole (current)
+stack.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the encapsulation bit (i.e.: true->false
+and false->true)
+*/
+func (r Stack) SetParen(state ...bool) Stack {
+	r.setState(parens, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetParen].
+*/
+func (r Stack) Paren(state ...bool) Stack {
+	return r.SetParen(state...)
+}
+
+/*
+IsNesting returns a Boolean value indicative of whether
+at least one (1) slice member is either a [Stack] or [Stack]
+type alias. If true, this indicates the relevant slice
+descends into another hierarchical (nested) context.
+*/
+func (r Stack) IsNesting() (is bool) {
+	if r.IsInit() {
+		is = r.stack.isNesting()
+	}
+	return
+
+}
+
+/*
+isNesting is a private method called by [Stack.IsNesting].
+
+When called, this method returns a Boolean value indicative
+of whether the receiver contains one (1) or more slice elements
+that match either of the following conditions:
+
+  - Slice type is a [Stack] native type instance, OR ...
+  - Slice type is a [Stack] type-aliased instance
+
+A return value of true is thrown at the first of either
+occurrence. Length of matched candidates is not significant
+during the matching process.
+*/
+func (r stack) isNesting() (is bool) {
+
+	// start iterating at index #1, thereby
+	// skipping the configuration slice.
+	for i := 1; i < r.len(); i++ {
+
+		// perform a type switch on the
+		// current index, thereby allowing
+		// evaluation of slice types.
+		switch tv := r[i].(type) {
+
+		// native Stack instance
+		case Stack:
+			is = true
+
+		// type alias stack instnaces, since
+		// we have no knowledge of them here,
+		// will be matched in default using
+		// the stackTypeAliasConverter func.
+		default:
+
+			// If convertible is true, we know the
+			// instance (tv) is a stack alias.
+			_, is = stackTypeAliasConverter(tv)
+		}
+
+		if is {
+			break
+		}
+	}
+
+	return
+}
+
+/*
+IsParen returns a Boolean value indicative of whether the
+receiver is parenthetical.
+*/
+func (r Stack) IsParen() bool {
+	return r.getState(parens)
+}
+
+/*
+SetFold will fold the case of logical Boolean operators which
+are not represented through symbols. For example, `AND` becomes
+`and`, or vice versa. This won't have any effect on List-based
+receivers, or if symbols are used in place of said Boolean words.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the case-folding bit (i.e.: true->false
+and false->true)
+*/
+func (r Stack) SetFold(state ...bool) Stack {
+	r.setState(cfold, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetFold].
+*/
+func (r Stack) Fold(state ...bool) Stack {
+	return r.SetFold(state...)
+}
+
+/*
+SetEqualityOrderInsensitive sets the order-insensitive equality bit within
+the receiver. When set, the [Stack.IsEqual] method compares the slices of
+an AND, OR or LIST receiver as a multiset: each slice of the receiver must
+be matched by exactly one (1) otherwise unmatched slice of the input value,
+regardless of order. NOT and BASIC receivers remain order-sensitive.
+
+Nested [Stack] instances are compared per their own configuration.
+
+A Boolean input value explicitly sets the bit as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+bit (i.e.: true->false and false->true)
+*/
+func (r Stack) SetEqualityOrderInsensitive(state ...bool) Stack {
+	r.setState(ueqty, state...)
+	return r
+}
+
+/*
+SliceMeta contains the metadata recorded for a single slice of a [Stack]
+when metadata tracking is enabled. See [Stack.SetTrackMetadata].
+*/
+type SliceMeta struct {
+	Time  time.Time // time at which the slice was added
+	Label string    // provenance label, if any; see [Stack.PushLabeled]
+}
+
+/*
+SetTrackMetadata enables or disables the tracking of per-slice metadata,
+which is comprised of the time at which each slice was added and an
+optional provenance label. See [Stack.PushLabeled] and [Stack.Metadata].
+
+Metadata remains aligned with its slice throughout removal, insertion,
+defragmentation, reversal, swapping and other reordering operations.
+
+Enabling tracking upon a populated receiver records zero metadata for
+the slices already present. Disabling tracking discards all metadata.
+No metadata overhead is incurred while tracking is disabled.
+
+Metadata recorded while a time-to-live is in effect is retained when
+tracking is enabled or disabled. See [Stack.SetTTL].
+
+A Boolean input value explicitly sets the tracking state as intended.
+Execution without a Boolean input value will *TOGGLE* the current state
+of tracking (i.e.: true->false and false->true)
+*/
+func (r Stack) SetTrackMetadata(state ...bool) Stack {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+		if sc, err := r.stack.config(); err == nil {
+			on := !sc.mtk
+			if len(state) > 0 {
+				on = state[0]
+			}
+
+			sc.mtk = on
+			if sc.ttl == 0 {
+				sc.met = nil
+				if on {
+					sc.met = make([]SliceMeta, r.stack.ulen())
+				}
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+SetTTL assigns the time-to-live (d) for the slices of the receiver. When
+non-zero, each slice expires once the duration has elapsed since it was
+added, whether by [Stack.Push], [Stack.Insert], [Stack.Replace] or any
+other means. Slices already present when the time-to-live is enabled
+are considered to have been added at that moment.
+
+Expired slices are removed only by [Stack.Expire], which reports the
+"expire" operation to the [ChangeHook] in effect, if any, for each slice
+removed. Until then, [Stack.Len], [Stack.Index], [Stack.String], [Stack.Pop],
+[Stack.Front], [Stack.Back] and [Stack.Unmarshal] treat such slices as
+absent, without removing them. Nested [Stack] instances are subject to
+their own time-to-live only. See also [Stack.SetClock].
+
+The time at which each slice was added is recorded in the manner of the
+metadata described by [Stack.SetTrackMetadata], thus no further overhead
+is incurred. A zero or negative duration disables expiry, discarding said
+times unless metadata tracking is enabled.
+
+Expiry is suspended while the receiver is read-only.
+*/
+func (r Stack) SetTTL(d time.Duration) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.lock()
+			defer r.stack.unlock()
+
+			sc, _ := r.stack.config()
+			if d <= 0 {
+				if sc.ttl = 0; !sc.mtk {
+					sc.met = nil
+				}
+			} else if sc.ttl == 0 {
+				if !sc.mtk {
+					sc.met = make([]SliceMeta, r.stack.ulen())
+				}
+				sc.ttl = d
+				sc.tte = sc.clock()
+			} else {
+				sc.ttl = d
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+TTL returns the time-to-live assigned to the slices of the receiver, or
+zero if unset. See [Stack.SetTTL].
+*/
+func (r Stack) TTL() (d time.Duration) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		d = sc.ttl
+	}
+	return
+}
+
+/*
+Expire removes all expired slices from the receiver under a single lock,
+returning the number of slices removed. The order of the remaining slices
+is preserved. See [Stack.SetTTL].
+
+No action is taken if no time-to-live is in effect, or if the receiver
+is read-only.
+*/
+func (r Stack) Expire() (removed int) {
+	if r.IsInit() {
+		before := r.stack.called(`expire`, 0)
+		defer r.stack.ended()
+		removed = r.expire()
+		r.stack.settled(`expire`, before)
+	}
+
+	return
+}
+
+/*
+SetClock assigns the function (fn) consulted for the current time when
+expiring slices and recording metadata. A nil fn restores use of the
+system clock. See [Stack.SetTTL] and [Stack.SetTrackMetadata].
+
+This is chiefly useful for the purpose of testing.
+*/
+func (r Stack) SetClock(fn func() time.Time) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.lock()
+			defer r.stack.unlock()
+
+			sc, _ := r.stack.config()
+			sc.clk = fn
+		}
+	}
+
+	return r
+}
+
+/*
+expire is a private method called by [Stack.Expire]. The number of slices
+removed is returned.
+*/
+func (r Stack) expire() int {
+	if sc := r.stack.lockConfig(); sc == nil || sc.ttl == 0 || sc.positive(ronly) {
+		return 0
+	}
+
+	removed, idxs := r.stack.expire()
+	for i := 0; i < len(removed); i++ {
+		r.stack.changed(`expire`, idxs[i], removed[i])
+	}
+
+	return len(removed)
+}
+
+/*
+expire is a private method called by [Stack.Expire] by way of stack.removeIf.
+The removed slices, and their original user indices, are returned.
+*/
+func (r *stack) expire() (removed []any, idxs []int) {
+	sc, _ := r.config()
+	now := sc.clock()
+
+	var i int
+	return r.removeIf(func(any) (expired bool) {
+		// slices are visited in order, under lock
+		expired = sc.expired(i, now)
+		i++
+		return
+	})
+}
+
+/*
+live returns the receiver or, should any of its slices have expired, a
+transient copy of the receiver bearing only those slices which have not.
+The user index within the receiver of each slice of the copy is returned
+alongside it; a nil slice is returned if the receiver itself is returned.
+
+Expired slices are not removed. See [Stack.SetTTL].
+*/
+func (r *stack) live() (view *stack, idxs []int) {
+	view = r
+	sc, err := r.config()
+	if err != nil || sc.ttl == 0 || sc.positive(ronly) {
+		return
+	}
+
+	now := sc.clock()
+	v := stack{sc}
+	for i := 0; i < r.ulen(); i++ {
+		if !sc.expired(i, now) {
+			v = append(v, (*r)[i+1])
+			idxs = append(idxs, i)
+		}
+	}
+
+	if len(idxs) == r.ulen() {
+		return r, nil
+	}
+
+	return &v, idxs
+}
+
+/*
+expired returns a Boolean value indicative of whether the slice found at
+user index i has expired as of the input time (now). See [Stack.SetTTL].
+*/
+func (r *nodeConfig) expired(i int, now time.Time) (is bool) {
+	if i < len(r.met) && r.ttl > 0 {
+		added := r.met[i].Time
+		if added.Before(r.tte) {
+			added = r.tte
+		}
+		is = now.Sub(added) >= r.ttl
+	}
+
+	return
+}
+
+/*
+Metadata returns the [SliceMeta] instance recorded for the slice found at
+the specified index, alongside a Boolean value indicative of success. A
+false value is returned if the index is out of bounds, or if metadata
+tracking is not enabled. See [Stack.SetTrackMetadata].
+
+Negative indices are honored if enabled. See [Stack.SetNegativeIndices].
+*/
+func (r Stack) Metadata(idx int) (meta SliceMeta, ok bool) {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+		if i, found := r.stack.swapIndex(idx); found {
+			if sc := r.stack.metaConfig(); sc != nil && sc.mtk && i-1 < len(sc.met) {
+				meta, ok = sc.met[i-1], true
+			}
+		}
+	}
+
+	return
+}
+
+/*
+metaConfig returns the *nodeConfig instance of the receiver if, and only
+if, metadata tracking or a time-to-live is enabled. Otherwise, nil is
+returned.
+*/
+func (r *stack) metaConfig() (sc *nodeConfig) {
+	if sc, _ = r.config(); sc != nil && !sc.mtk && sc.ttl == 0 {
+		sc = nil
+	}
+
+	return
+}
+
+/*
+metaInsert records new metadata bearing the input label at user index i.
+*/
+func (r *stack) metaInsert(i int, label string) {
+	if sc := r.metaConfig(); sc != nil && 0 <= i && i <= len(sc.met) {
+		sc.met = append(sc.met, SliceMeta{})
+		copy(sc.met[i+1:], sc.met[i:])
+		sc.met[i] = SliceMeta{Time: sc.clock(), Label: label}
+	}
+}
+
+/*
+metaRenew replaces the metadata at user index i, as is done when the
+associated slice is replaced.
+*/
+func (r *stack) metaRenew(i int, label string) {
+	if sc := r.metaConfig(); sc != nil && 0 <= i && i < len(sc.met) {
+		sc.met[i] = SliceMeta{Time: sc.clock(), Label: label}
+	}
+}
+
+/*
+metaRemove discards the metadata at user index i.
+*/
+func (r *stack) metaRemove(i int) {
+	r.dropUnique()
+	if sc := r.metaConfig(); sc != nil && 0 <= i && i < len(sc.met) {
+		sc.met = append(sc.met[:i], sc.met[i+1:]...)
+	}
+}
+
+/*
+metaRemoveRange discards the metadata at user indices i through j.
+*/
+func (r *stack) metaRemoveRange(i, j int) {
+	r.dropUnique()
+	if sc := r.metaConfig(); sc != nil && 0 <= i && i <= j && j < len(sc.met) {
+		sc.met = append(sc.met[:i], sc.met[j+1:]...)
+	}
+}
+
+/*
+metaSwap exchanges the metadata at user indices i and j.
+*/
+func (r *stack) metaSwap(i, j int) {
+	if sc := r.metaConfig(); sc != nil && 0 <= i && 0 <= j && i < len(sc.met) && j < len(sc.met) {
+		sc.met[i], sc.met[j] = sc.met[j], sc.met[i]
+	}
+}
+
+/*
+metaReverse reverses the order of all metadata.
+*/
+func (r *stack) metaReverse() {
+	if sc := r.metaConfig(); sc != nil {
+		for i, j := 0, len(sc.met)-1; i < j; i, j = i+1, j-1 {
+			sc.met[i], sc.met[j] = sc.met[j], sc.met[i]
+		}
+	}
+}
+
+/*
+metaReset discards all metadata, as is done when the receiver is reset.
+*/
+func (r *stack) metaReset() {
+	r.dropUnique()
+	if sc := r.metaConfig(); sc != nil {
+		sc.met = sc.met[:0]
+	}
+}
+
+/*
+metaCompact retains only the metadata of those user indices marked as
+occupied within the input pattern (pat), as is done following a
+defragmentation, before fitting the result to the receiver's length.
+*/
+func (r *stack) metaCompact(pat []int) {
+	r.dropUnique()
+	if sc := r.metaConfig(); sc != nil {
+		var met []SliceMeta
+		for i := 0; i < len(sc.met) && i < len(pat); i++ {
+			if pat[i] != 0 {
+				met = append(met, sc.met[i])
+			}
+		}
+
+		for len(met) < r.ulen() {
+			met = append(met, SliceMeta{})
+		}
+		sc.met = met[:r.ulen()]
+	}
+}
+
+/*
+SetNegativeIndices will enable negative index support when using
+the [Stack.Index] method extended by this type. See the method
+documentation for further details.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the negative indices bit (i.e.: true->false
+and false->true)
+*/
+func (r Stack) SetNegativeIndices(state ...bool) Stack {
+	r.setState(negidx, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetForwardIndices].
+*/
+func (r Stack) NegativeIndices(state ...bool) Stack {
+	return r.SetNegativeIndices(state...)
+}
+
+/*
+SetForwardIndices will enable forward index support when using
+the [Stack.Index] method extended by this type. See the method
+documentation for further details.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the forward indices bit (i.e.: true->false
+and false->true)
+*/
+func (r Stack) SetForwardIndices(state ...bool) Stack {
+	r.setState(fwdidx, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetForwardIndices].
+*/
+func (r Stack) ForwardIndices(state ...bool) Stack {
+	return r.SetForwardIndices(state...)
+}
+
+/*
+SetDelimiter accepts input characters (as string, or a single rune) for use
+in controlled [Stack] value joining when the underlying [Stack] type is a LIST.
+In such a case, the input value shall be used for delimitation of all slice
+values during the string representation process.
+
+A zero string, the NTBS (NULL) character -- ASCII #0 -- or nil, shall unset
+this value within the receiver.
+
+Multi-character values, such as " -> ", are supported. Note that unless the
+receiver is set via [Stack.SetNoPadding], each slice value is padded with a
+single space, and any resulting runs of whitespace are condensed.
+
+Any positional delimiters set via [Stack.SetDelimiters] are discarded.
+
+If this method is executed using any other stack type, the operation has no
+effect. If using Boolean AND, OR or NOT stacks and a character delimiter is
+preferred over a Boolean WORD, see the [Stack.Symbol] method.
+*/
+func (r Stack) SetDelimiter(x any) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setListDelimiter(x)
+		}
+	}
+
+	return r
+}
+
+/*
+assertListDelimiter is a private function called (indirectly)
+by the [Stack.SetDelimiter] method for the purpose of handing
+type assertion for values that may express a particular value
+intended to serve as delimiter character for a LIST [Stack] when
+string representation is requested.
+*/
+func assertListDelimiter(x any) (v string) {
+	if x != nil {
+		switch tv := x.(type) {
+		case string:
+			v = tv
+		case rune:
+			if tv != rune(0) {
+				v = string(tv)
+			}
+		}
+	}
+
+	return
+}
+
+/*
+Delimiter returns the delimiter string value currently set
+within the receiver instance. If positional delimiters were
+set via [Stack.SetDelimiters], the first is returned.
+*/
+func (r Stack) Delimiter() string {
+	return r.stack.getListDelimiter()
+}
+
+/*
+SetDelimiters assigns positional delimiters for use in [Stack] value joining
+when the underlying [Stack] type is a LIST. The last delimiter is used for the
+final join -- that is, before the final slice -- while all other joins use the
+remaining delimiters in order. When fewer delimiters than joins exist, the
+second-to-last delimiter repeats.
+
+For example, delimiters of ", " and " and " render a three (3) slice LIST as
+"a, b and c" when [Stack.SetNoPadding] is in effect.
+
+Joins are assigned according to slice position. A single delimiter behaves
+as though it were submitted via [Stack.SetDelimiter], and no delimiters
+unset any delimiter(s) within the receiver.
+
+If this method is executed using any other stack type, the operation has no
+effect.
+*/
+func (r Stack) SetDelimiters(delims ...string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.setListDelimiters(delims)
+		}
+	}
+
+	return r
+}
+
+/*
+Delimiters returns all delimiter string values currently set within the
+receiver instance. See also the [Stack.SetDelimiters] method.
+*/
+func (r Stack) Delimiters() (delims []string) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		if len(sc.ljs) > 0 {
+			delims = append(delims, sc.ljs...)
+		} else if len(sc.ljc) > 0 {
+			delims = []string{sc.ljc}
+		}
+	}
+
+	return
+}
+
+/*
+SetInheritPresentation sets the presentation inheritance setting within
+the receiver. When enabled, nested [Stack] slices are rendered during the
+string representation of the receiver as though they bore the delimiter(s),
+encapsulation and padding settings of the receiver, except for those which
+they set explicitly. A nested [Stack] which sets its own delimiter(s),
+encapsulation or padding always uses its own.
+
+The inheritance is transient: the configurations of nested [Stack] slices
+are not modified. Inheritance extends to all descendant [Stack] slices, but
+not to [Stack] expressions of [Condition] slices.
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetInheritPresentation(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			if len(state) > 0 {
+				sc.inh = state[0]
+			} else {
+				sc.inh = !sc.inh
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+IsInheritingPresentation returns a Boolean value indicative of whether
+nested [Stack] slices inherit the presentation settings of the receiver.
+See [Stack.SetInheritPresentation].
+*/
+func (r Stack) IsInheritingPresentation() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.inh
+	}
+	return
+}
+
+/*
+SetSkipInvalid sets the skip invalid setting within the receiver. When
+enabled, [Condition] (or [Condition] alias) slices which are invalid, as
+judged by [Condition.Valid], are omitted from the string representation
+of the receiver, rather than being rendered as their invalid marker (see
+[Condition.SetInvalidMarker]). This prevents a single broken [Condition]
+from corrupting the expression as a whole. The slices themselves are not
+affected.
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetSkipInvalid(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			if len(state) > 0 {
+				sc.ski = state[0]
+			} else {
+				sc.ski = !sc.ski
+			}
+		}
+	}
+
+	return r
+}
+
+/*
+IsSkippingInvalid returns a Boolean value indicative of whether invalid
+[Condition] slices are omitted from the string representation of the
+receiver. See [Stack.SetSkipInvalid].
+*/
+func (r Stack) IsSkippingInvalid() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.ski
+	}
+	return
+}
+
+/*
+presentationView returns a transient copy of the receiver whose
+configuration adopts those presentation settings of the parent
+configuration (pc) which the receiver does not set explicitly. The
+slices of the copy are shared with the receiver, whose configuration
+is not modified. See [Stack.SetInheritPresentation].
+*/
+func (r *stack) presentationView(pc *nodeConfig) *stack {
+	sc, _ := r.config()
+	tmp := *sc
+	tmp.inh = true
+
+	if len(tmp.ljc) == 0 && len(tmp.ljs) == 0 {
+		tmp.ljc, tmp.ljs = pc.ljc, pc.ljs
+	}
+	if len(tmp.enc) == 0 {
+		tmp.enc = pc.enc
+	}
+	if !tmp.shc && pc.shc {
+		tmp.shc, tmp.cmd = true, pc.cmd
+	}
+	tmp.ski = tmp.ski || pc.ski
+	if tmp.pst == nil && !tmp.positive(nspad) {
+		tmp.pst = pc.pst
+		tmp.opt |= pc.opt & nspad
+	}
+	if tmp.spd == nil {
+		tmp.spd = pc.spd
+	}
+
+	view := append(stack{&tmp}, (*r)[1:]...)
+	return &view
+}
+
+/*
+setListDelimiter is a private method called by [Stack.SetDelimiter]
+*/
+func (r *stack) setListDelimiter(x any) {
+	sc, _ := r.config()
+	sc.setListDelimiter(assertListDelimiter(x))
+	sc.ljs = nil
+}
+
+/*
+getListDelimiter is a private method called by [Stack.Delimiter].
+*/
+func (r *stack) getListDelimiter() string {
+	sc, _ := r.config()
+	delim := sc.getListDelimiter()
+	return delim
+}
+
+/*
+SetEncap accepts input characters for use in controlled [Stack] value
+encapsulation.
+
+A single string value will be used for both L and R encapsulation.
+
+An instance of []string with two (2) values will be used for L and R
+encapsulation using the first and second slice values respectively.
+
+An instance of []string with only one (1) value is identical to the
+act of providing a single string value, in that both L and R will use
+the one value.
+*/
+func (r Stack) SetEncap(x ...any) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setEncap(x...)
+		}
+	}
+
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetEncap].
+*/
+func (r Stack) Encap(x ...any) Stack {
+	return r.SetEncap(x...)
+}
+
+/*
+setEncap is a private method called by [Stack.Encap].
+*/
+func (r *stack) setEncap(x ...any) {
+	sc, _ := r.config()
+	sc.setEncap(x...)
+}
+
+/*
+IsEncap returns a Boolean value indicative of whether value encapsulation
+characters have been set within the receiver.
+*/
+func (r Stack) IsEncap() (is bool) {
+	if r.IsInit() {
+		is = len(r.stack.getEncap()) > 0
+	}
+	return
+}
+
+/*
+EncapChars returns the value encapsulation character pairs configured
+within the receiver, in order of application; the first pair is the
+outermost. Each pair contains the left and right characters, thus a
+single-character scheme (such as a double quote) returns that character
+for both sides.
+*/
+func (r Stack) EncapChars() (pairs [][2]string) {
+	if r.IsInit() {
+		sc, _ := r.config()
+		pairs = sc.encapChars()
+	}
+	return
+}
+
+/*
+CopyPresentation copies the presentation settings of the receiver onto
+dest, which must be an initialized [Stack] or [Stack]-alias instance. The
+settings copied are the value encapsulation scheme, the list delimiter,
+the symbol, the padding style, the symbol padding state (see the method
+[Stack.SetSymbolPadding]) and the parenthetical, case folding, no padding
+and lead-once bits. Settings not applicable to the kind of dest,
+such as a symbol for a [List], are ignored.
+
+An error wrapping [ErrReadOnly] is returned if dest is read-only.
+*/
+func (r Stack) CopyPresentation(dest any) (err error) {
+	D, ok := stackTypeAliasConverter(dest)
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "source stack instance is nil")
+	} else if !ok || !D.IsInit() {
+		err = wrapErr(ErrNotInitialized, "destination %T instance is nil or invalid", dest)
+	} else if D.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot copy presentation", dest)
+	} else {
+		src, _ := r.config()
+		dc, _ := D.config()
+
+		D.stack.lock()
+		defer D.stack.unlock()
+
+		for _, flag := range []cfgFlag{parens, cfold, nspad, lonce} {
+			if dc.unsetOpt(flag); src.positive(flag) {
+				dc.setOpt(flag)
+			}
+		}
+
+		dc.enc = nil
+		for i := 0; i < len(src.enc); i++ {
+			dc.enc = append(dc.enc, append([]string{}, src.enc[i]...))
+		}
+
+		dc.setListDelimiter(src.ljc)
+		if len(src.ljs) > 0 {
+			dc.setListDelimiters(src.ljs)
+		}
+		if dc.typ != list {
+			dc.setSymbol(src.sym)
+		}
+
+		dc.pst = nil
+		if src.pst != nil {
+			pst := *src.pst
+			dc.pst = &pst
+		}
+
+		dc.spd = nil
+		if src.spd != nil {
+			spd := *src.spd
+			dc.spd = &spd
+		}
+	}
+
+	return
+}
+
+/*
+getEncap returns the current value encapsulation character pattern
+set within the receiver instance.
+*/
+func (r *stack) getEncap() (encs [][]string) {
+	sc, _ := r.config()
+	encs = sc.enc
+
+	return
+}
+
+/*
+SetID assigns the provided string value (or lack thereof) to the receiver.
+This is optional, and is usually only needed in complex structures in which
+"labeling" certain components may be advantageous. It has no effect on an
+evaluation, nor should a name ever cause a validity check to fail.
+
+If the string `_random` is provided, a 24-character alphanumeric string is
+randomly generated using math/rand and assigned as the ID. If a prefix was
+set using [Stack.SetIDPrefix], the ID shall take the form "prefix-<random>".
+
+If the string `_addr` is provided, the ID shall always reflect the current
+pointer address of the receiver, as returned by [Stack.Addr].
+*/
+func (r Stack) SetID(id string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setID(id)
+		}
+	}
+
+	return r
+}
+
+/*
+setID is a private method called by [Stack.SetID].
+*/
+func (r *stack) setID(id string) {
+	sc, _ := r.config()
+
+	r.lock()
+	defer r.unlock()
+
+	sc.setID(id)
+}
+
+/*
+SetIDPrefix assigns the provided prefix to the receiver, which shall be
+applied to any random ID subsequently generated through the use of the
+`_random` keyword with [Stack.SetID]. Specifying a zero string removes
+the prefix. IDs already assigned are not modified.
+*/
+func (r Stack) SetIDPrefix(prefix string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.idp = prefix
+		}
+	}
+
+	return r
+}
+
+/*
+IDPrefix returns the prefix applied to random IDs generated for the
+receiver, if set. See [Stack.SetIDPrefix].
+*/
+func (r Stack) IDPrefix() (prefix string) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		prefix = sc.idp
+	}
+	return
+}
+
+/*
+Config returns a [StackConfig] snapshot of the receiver's configuration,
+gathered through a single configuration access and, if [Stack.CanMutex]
+is true, under a single lock.
+
+The return value is a copy; later changes to the receiver are not
+reflected within it, nor does modifying it alter the receiver. A zero
+instance is returned if the receiver is uninitialized.
+*/
+func (r Stack) Config() (snap StackConfig) {
+	if r.IsInit() {
+		snap = r.stack.snapshot()
+	}
+	return
+}
+
+/*
+snapshot is a private method called by [Stack.Config].
+*/
+func (r *stack) snapshot() StackConfig {
+	r.lock()
+	defer r.unlock()
+
+	sc, _ := r.config()
+	return sc.stackConfig(ptrString(r))
+}
+
+/*
+SetCategory assigns the provided string to the stack's internal category
+value. This allows for a means of identifying a particular kind of stack
+in the midst of many.
+*/
+func (r Stack) SetCategory(cat string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setCat(cat)
+		}
+	}
+	return r
+}
+
+/*
+setCat is a private method called by [Stack.SetCategory].
+*/
+func (r *stack) setCat(cat string) {
+	sc, _ := r.config()
+	sc.setCat(cat)
+}
+
+/*
+Category returns the categorical label string value assigned to the receiver,
+if set, else a zero string.
+*/
+func (r Stack) Category() (cat string) {
+	if r.IsInit() {
+		cat = r.stack.getCat()
+	}
+	return
+}
+
+/*
+getCat is a private method called by [Stack.Category].
+*/
+func (r *stack) getCat() (cat string) {
+	sc, _ := r.config()
+	cat = sc.cat
+	return
+}
+
+/*
+ID returns the assigned identifier string, if set, from within the underlying
+stack configuration.
+*/
+func (r Stack) ID() (id string) {
+	id = "unspecified"
+	if r.IsInit() {
+		id = r.stack.getID()
+	}
+	return
+}
+
+/*
+getID is a private method called by [Stack.ID].
+*/
+func (r *stack) getID() string {
+	sc, _ := r.config()
+	return sc.getID(ptrString(r))
+}
+
+/*
+SetLeadOnce sets the lead-once bit within the receiver. This
+causes two (2) things to happen:
+
+  - Only use the configured operator once in a stack, and ...
+  - Only use said operator at the very beginning of the stack string value
+
+Execution without a Boolean input value will *TOGGLE* the
+current state of the lead-once bit (i.e.: true->false and
+false->true)
+*/
+func (r Stack) SetLeadOnce(state ...bool) Stack {
+	r.setState(lonce, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetLeadOnce].
+*/
+func (r Stack) LeadOnce(state ...bool) Stack {
+	return r.SetLeadOnce(state...)
+}
+
+/*
+SetNoPadding sets the no-space-padding bit within the receiver.
+String values within the receiver shall not be padded using
+a single space character (ASCII #32).
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the padding bit (i.e.: true->false and
+false->true)
+*/
+func (r Stack) SetNoPadding(state ...bool) Stack {
+	r.setState(nspad, state...)
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setPaddingStyle(nil)
+		}
+	}
+	return r
+}
+
+/*
+SetPaddingStyle assigns the [PaddingStyle] bitmask to the receiver, which
+shall control the padding of the operator word or symbol used to join slices
+([PadAroundOperator]) and the padding inside parenthetical characters
+([PadInsideParens]) during string representation, in place of the legacy
+[Stack.SetNoPadding] method. Padding of the slice values themselves remains
+governed by [Stack.SetNoPadding].
+
+A subsequent execution of [Stack.SetNoPadding] discards the style, restoring
+the legacy padding behavior.
+*/
+func (r Stack) SetPaddingStyle(style PaddingStyle) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setPaddingStyle(&style)
+		}
+	}
+
+	return r
+}
+
+/*
+setPaddingStyle is a private method called by [Stack.SetPaddingStyle]. A
+nil style clears any previously set style.
+*/
+func (r *stack) setPaddingStyle(style *PaddingStyle) {
+	sc, _ := r.config()
+	if style == nil {
+		sc.pst = nil
+	} else {
+		sc.setPaddingStyle(*style)
+	}
+}
+
+/*
+SetSymbolPadding sets the padding of the symbol (see [Stack.SetSymbol]), or
+of the operator word in the absence of a symbol, used to join the slices of
+the receiver during string representation, independently of the padding of
+the slices themselves and of the parenthetical characters. For example, a
+three (3) member [And] bearing the symbol "&&" may produce any of:
+
+	( a && b && c )
+	( a&&b&&c )
+	(a && b && c)
+	(a&&b&&c)
+
+... depending upon this setting and that of [Stack.SetNoPadding]. While
+the symbol is unpadded, slice values are not padded either, as any such
+padding would surround the symbol.
+
+Until this method is first executed, the padding of the symbol follows the
+[Stack.SetNoPadding] and [Stack.SetPaddingStyle] methods, whose effects upon
+the symbol are superseded thereafter. See also [Stack.IsSymbolPadded].
+
+A Boolean input value explicitly sets the state as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state (i.e.: true->false and false->true)
+*/
+func (r Stack) SetSymbolPadding(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.lock()
+			defer r.stack.unlock()
+
+			sc, _ := r.stack.config()
+			pad := !sc.symbolPadded()
+			if len(state) > 0 {
+				pad = state[0]
+			}
+			sc.spd = &pad
+		}
+	}
+
+	return r
+}
+
+/*
+IsSymbolPadded returns a Boolean value indicative of whether the symbol,
+or operator word, used to join the slices of the receiver is padded during
+string representation. See [Stack.SetSymbolPadding].
+*/
+func (r Stack) IsSymbolPadded() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.symbolPadded()
+	}
+	return
+}
+
+/*
+Deprecated: Use [Stack.SetNoPadding].
+*/
+func (r Stack) NoPadding(state ...bool) Stack {
+	return r.SetNoPadding(state...)
+}
+
+/*
+SetNoNesting sets the no-nesting bit within the receiver. If
+set to true, the receiver shall ignore any [Stack] or [Stack]
+type alias instance when pushed using the [Stack.Push] method.
+In such a case, only primitives, [Condition] instances, etc.,
+shall be honored during the [Stack.Push] operation.
+
+Note this will only have an effect when not using a custom
+[PushPolicy]. When using a custom [PushPolicy], the user has
+total control -- and full responsibility -- in deciding
+what may or may not be pushed.
+
+Also note that setting or unsetting this bit shall not, in
+any way, have an impact on pre-existing [Stack] or [Stack] type
+alias instances within the receiver. This bit only has an
+influence on the [Stack.Push] method and only when set to true.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the nesting bit (i.e.: true->false and
+false->true)
+*/
+func (r Stack) SetNoNesting(state ...bool) Stack {
+	r.setState(nnest, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetNoNesting].
+*/
+func (r Stack) NoNesting(state ...bool) Stack {
+	return r.SetNoNesting(state...)
+}
+
+/*
+CanNest returns a Boolean value indicative of whether
+the no-nesting bit is unset, thereby allowing the push
+of [Stack] and/or [Stack] type alias instances.
+
+See also the [Stack.IsNesting] method.
+*/
+func (r Stack) CanNest() bool {
+	return r.getState(nnest)
+}
+
+/*
+IsPadded returns a Boolean value indicative of whether the
+receiver pads its contents with a SPACE char (ASCII #32).
+*/
+func (r Stack) IsPadded() bool {
+	return !r.getState(nspad)
+}
+
+/*
+SetReadOnly sets the receiver bit 'ronly' to a positive state.
+This will prevent any writes to the receiver or its underlying
+configuration.
+*/
+func (r Stack) SetReadOnly(state ...bool) Stack {
+	r.setState(ronly, state...)
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetReadOnly].
+*/
+func (r Stack) ReadOnly(state ...bool) Stack {
+	return r.SetReadOnly(state...)
+}
+
+/*
+IsReadOnly returns a Boolean value indicative of whether the
+receiver is set as read-only.
+*/
+func (r Stack) IsReadOnly() bool {
+	return r.getState(ronly)
+}
+
+/*
+SetReadOnlyDeep behaves as [Stack.SetReadOnly] does, except that the
+resulting state is also applied to every nested [Stack] -- including those
+found within [Condition] expressions -- as well as to the [Condition]
+instances themselves.
+
+When no Boolean input value is supplied, the receiver's own state is
+toggled and the outcome is propagated throughout, thus the entire tree
+ends up in a uniform state.
+
+Note that [Stack.IsReadOnly] remains shallow. Use [Stack.IsReadOnlyDeep]
+to verify the state of the entire tree.
+*/
+func (r Stack) SetReadOnlyDeep(state ...bool) Stack {
+	if r.IsInit() {
+		ro := !r.getState(ronly)
+		if len(state) > 0 {
+			ro = state[0]
+		}
+		r.stack.setReadOnlyDeep(ro, visitSet{r.stack: true})
+	}
+	return r
+}
+
+/*
+setReadOnlyDeep is a private method called by [Stack.SetReadOnlyDeep]
+and [Condition.SetReadOnlyDeep]. Instances present within the seen set
+are not descended again.
+*/
+func (r *stack) setReadOnlyDeep(state bool, seen visitSet) {
+	Stack{r}.setState(ronly, state)
+
+	for i := 1; i < r.len(); i++ {
+		var inner Stack
+		if S, ok := stackTypeAliasConverter((*r)[i]); ok {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter((*r)[i]); ok && C.IsInit() {
+			C.setState(ronly, state)
+			inner, _ = C.ExpressionAsStack()
+		}
+
+		if inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			inner.stack.setReadOnlyDeep(state, seen)
+		}
+	}
+}
+
+/*
+IsReadOnlyDeep returns a Boolean value indicative of whether the receiver,
+every nested [Stack] and every [Condition] found within are all set as
+read-only.
+
+See also [Stack.SetReadOnlyDeep].
+*/
+func (r Stack) IsReadOnlyDeep() bool {
+	return r.IsInit() && r.stack.isReadOnlyDeep(visitSet{r.stack: true})
+}
+
+/*
+isReadOnlyDeep is a private method called by [Stack.IsReadOnlyDeep] and
+[Condition.IsReadOnlyDeep].
+*/
+func (r *stack) isReadOnlyDeep(seen visitSet) bool {
+	if !r.positive(ronly) {
+		return false
+	}
+
+	for i := 1; i < r.len(); i++ {
+		var inner Stack
+		if S, ok := stackTypeAliasConverter((*r)[i]); ok {
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter((*r)[i]); ok && C.IsInit() {
+			if !C.getState(ronly) {
+				return false
+			}
+			inner, _ = C.ExpressionAsStack()
+		}
+
+		if inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			if !inner.stack.isReadOnlyDeep(seen) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+/*
+SetSymbol sets the provided symbol expression, which will be a sequence
+of any characters desired, to represent various Boolean operators without
+relying on words such as "AND". If a non-zero sequence of characters is
+set, they will be used to supplant the default word-based operators within
+the given stack in which the symbol is configured.
+
+Acceptable input types are string and rune.
+
+Execution of this method with no arguments empty the symbol store within
+the receiver, thereby returning to the default word-based behavior.
+
+This method has no effect on list-style [Stack] instances.
+*/
+func (r Stack) SetSymbol(c ...any) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setSymbol(c...)
+		}
+	}
+
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetSymbol].
+*/
+func (r Stack) Symbol(c ...any) Stack {
+	return r.SetSymbol(c...)
+}
+
+/*
+setSymbol is a private method called by [Stack.Symbol].
+*/
+func (r *stack) setSymbol(c ...any) {
+	var str string
+	for i := 0; i < len(c); i++ {
+		switch tv := c[i].(type) {
+		case string:
+			str += tv
+		case rune:
+			char := string(tv)
+			str += char
+		}
+	}
+
+	if sc, _ := r.config(); sc.typ != list {
+		r.lock()
+		defer r.unlock()
+		sc.setSymbol(str)
+	}
+}
+
+/*
+SetSymbols assigns a table of symbols, keyed by [StackKind], to the
+receiver. During the string representation of the receiver, any nested
+[Stack] which lacks a symbol of its own is rendered using the symbol
+assigned to its kind within the table, if any.
+
+A nested [Stack] rendered in this manner also adopts the parenthetical,
+lead-once and padding settings of the receiver -- where enabled -- such
+that the symbol is presented in the same fashion as within the receiver.
+This allows LDAP-style filters to be produced by configuring only the
+outermost [Stack], e.g.:
+
+	And().SetSymbol(`&`).SetLeadOnce(true).SetParen(true).SetNoPadding(true).
+		SetSymbols(map[StackKind]string{KindOr: `|`, KindNot: `!`})
+
+The table is consulted at all depths, unless a nested [Stack] bears a
+table of its own. The receiver's own symbol is set via [Stack.SetSymbol],
+and is unaffected by the table. Entries for [KindList] and [KindBasic],
+as well as those bearing zero strings, are ignored.
+
+Execution of this method with a nil or empty table removes any table
+previously assigned.
+*/
+func (r Stack) SetSymbols(table map[StackKind]string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setSymbols(table)
+		}
+	}
+
+	return r
+}
+
+/*
+setSymbols is a private method called by [Stack.SetSymbols].
+*/
+func (r *stack) setSymbols(table map[StackKind]string) {
+	var sst map[stackType]string
+	for kind, sym := range table {
+		switch typ := kind.stackType(); typ {
+		case and, or, not:
+			if len(sym) > 0 {
+				if sst == nil {
+					sst = make(map[stackType]string)
+				}
+				sst[typ] = sym
+			}
+		}
+	}
+
+	sc, _ := r.config()
+	r.lock()
+	defer r.unlock()
+	sc.sst = sst
+}
+
+/*
+Symbols returns a copy of the table of symbols assigned to the receiver
+via [Stack.SetSymbols], or nil if unset.
+*/
+func (r Stack) Symbols() (table map[StackKind]string) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		for typ, sym := range sc.sst {
+			if table == nil {
+				table = make(map[StackKind]string)
+			}
+			table[typ.stackKind()] = sym
+		}
+	}
+
+	return
+}
+
+/*
+tableSymbol returns the symbol assigned to the input stackType within
+the receiver's table of symbols, if any.
+*/
+func (r stack) tableSymbol(typ stackType) string {
+	sc, _ := r.config()
+	return sc.sst[typ]
+}
+
+/*
+symbolView returns a transient copy of the receiver bearing the table of
+symbols of its parent, whose configuration is pc, alongside the symbol
+and presentation settings described by [Stack.SetSymbols] if applicable.
+The receiver is returned as-is if it bears a table of its own, or if the
+parent bears none.
+*/
+func (r *stack) symbolView(pc *nodeConfig) *stack {
+	sc, _ := r.config()
+	if len(pc.sst) == 0 || len(sc.sst) > 0 {
+		return r
+	}
+
+	tmp := *sc
+	tmp.sst = pc.sst
+	if sym := pc.sst[sc.typ]; len(sc.sym) == 0 && len(sym) > 0 {
+		tmp.sym = sym
+		tmp.opt |= pc.opt & (parens | lonce | nspad)
+	}
+
+	view := append(stack{&tmp}, (*r)[1:]...)
+	return &view
+}
+
+/*
+getSymbol returns the symbol stored within the underlying *nodeConfig
+instance slice.
+*/
+func (r stack) getSymbol() (sym string) {
+	sc, _ := r.config()
+	sym = sc.sym
+	return sym
+}
+
+func (r *stack) setLogger(logger any) {
+	cfg, _ := r.config()
+
+	r.lock()
+	defer r.unlock()
+
+	cfg.log.setLogger(logger)
+}
+
+func (r Stack) getState(cf cfgFlag) (state bool) {
+	if r.IsInit() {
+		state = r.stack.positive(cf)
+	}
+	return
+}
+
+func (r Stack) setState(cf cfgFlag, state ...bool) {
+	if r.IsInit() {
+		if !r.getState(ronly) || cf == ronly {
+			if len(state) > 0 {
+				if state[0] {
+					r.stack.setOpt(cf)
+				} else {
+					r.stack.unsetOpt(cf)
+				}
+			} else {
+				r.stack.toggleOpt(cf)
+			}
+		}
+	}
+}
+
+/*
+toggleOpt returns the receiver value in fluent-style after a
+locked modification of the underlying *nodeConfig instance
+to TOGGLE the state of a particular option.
+*/
+func (r *stack) toggleOpt(x cfgFlag) *stack {
+	cfg, _ := r.config()
+
+	r.lock()
+	defer r.unlock()
+
+	cfg.toggleOpt(x)
+
+	return r
+}
+
+/*
+setOpt returns the receiver value in fluent-style after a
+locked modification of the underlying *nodeConfig instance
+to SET a particular option.
+*/
+func (r *stack) setOpt(x cfgFlag) *stack {
+	cfg, _ := r.config()
+
+	r.lock()
+	defer r.unlock()
+
+	cfg.setOpt(x)
+
+	return r
+}
+
+/*
+unsetOpt returns the receiver value in fluent-style after
+a locked modification of the underlying *nodeConfig instance
+to UNSET a particular option.
+*/
+func (r *stack) unsetOpt(x cfgFlag) *stack {
+	cfg, _ := r.config()
+
+	r.lock()
+	defer r.unlock()
+
+	cfg.unsetOpt(x)
+
+	return r
+}
+
+/*
+positive returns a Boolean value indicative of whether the specified
+cfgFlag input value is "on" within the receiver's configuration
+value.
+*/
+func (r stack) positive(x cfgFlag) bool {
+	cfg, _ := r.config()
 	result := cfg.positive(x)
 	return result
 }
 
 /*
-SetMutex enables the receiver's mutual exclusion locking capabilities.
+SetMutex enables the receiver's mutual exclusion locking capabilities.
+
+Subsequent calls of write-related methods, such as [Stack.Push],
+[Stack.Pop], [Stack.Remove] and others, shall invoke MuTeX locking at
+the latest possible state of processing, thereby minimizing the duration
+of a lock.
+*/
+func (r Stack) SetMutex() Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.setMutex()
+		}
+	}
+	return r
+}
+
+/*
+Deprecated: Use [Stack.SetMutex].
+*/
+func (r Stack) Mutex() Stack {
+	return r.SetMutex()
+}
+
+/*
+LockStats returns a [LockStats] snapshot of the lock diagnostics of
+the receiver. A zero instance is returned if the receiver was not
+equipped with mutual exclusion locking features via [Stack.SetMutex].
+*/
+func (r Stack) LockStats() (ls LockStats) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		ls = sc.lst.stats()
+	}
+	return
+}
+
+/*
+SetLockWarnThreshold assigns the duration (d) which, when exceeded by
+a single hold of the receiver's lock, shall cause a [LogLevel3] (state)
+event named "lock_hold" to be transcribed through the log subsystem. A
+zero duration disables this behavior.
+
+This method has no effect unless the receiver was equipped with mutual
+exclusion locking features via [Stack.SetMutex].
+*/
+func (r Stack) SetLockWarnThreshold(d time.Duration) Stack {
+	if r.IsInit() {
+		if sc, _ := r.stack.config(); sc.lst != nil {
+			sc.lst.warn.Store(int64(d))
+		}
+	}
+	return r
+}
+
+/*
+setMutex is a private method called by [Stack.Mutex].
+*/
+func (r *stack) setMutex() {
+	if sc, _ := r.config(); sc.valid() && sc.mtx == nil {
+		sc.setMutex()
+		r.register(sc)
+	}
+}
+
+/*
+CanMutex returns a Boolean value indicating whether the receiver
+instance has been equipped with mutual exclusion locking features.
+
+This does NOT indicate whether the receiver is actually locked.
+*/
+func (r Stack) CanMutex() (can bool) {
+	if r.IsInit() {
+		can = r.stack.canMutex()
+	}
+	return
+}
+
+/*
+canMutex is a private method called by [Stack.CanMutex].
+*/
+func (r stack) canMutex() bool {
+	sc, _ := r.config()
+	return sc.mtx != nil
+}
+
+/*
+lockers associates the address of each locking-enabled *stack with its
+configuration, thereby allowing stack.lock to reach the mutex without
+reading the slice header of the receiver, which another goroutine may
+be rewriting while holding that very mutex. Entries are added by way of
+stack.register, and removed when the *stack is freed or collected.
+*/
+var lockers sync.Map // uintptr -> *nodeConfig
+
+/*
+register records the input configuration (sc) of the receiver within the
+lockers table. See stack.setMutex.
+*/
+func (r *stack) register(sc *nodeConfig) {
+	lockers.Store(r.addr(), sc)
+	runtime.SetFinalizer(r, (*stack).unregister)
+}
+
+/*
+unregister removes the receiver from the lockers table, if present.
+*/
+func (r *stack) unregister() {
+	lockers.Delete(r.addr())
+}
+
+/*
+addr returns the address of the receiver for use with the lockers table.
+*/
+func (r *stack) addr() uintptr {
+	return reflect.ValueOf(r).Pointer()
+}
+
+/*
+lockConfig returns the configuration of the receiver, or nil if the receiver
+is nil or invalid. Unlike stack.config, this method does not read the slice
+header of a locking-enabled receiver, and may therefore be used while another
+goroutine holds the lock.
+*/
+func (r *stack) lockConfig() (sc *nodeConfig) {
+	if v, found := lockers.Load(r.addr()); found {
+		sc = v.(*nodeConfig)
+	} else {
+		sc, _ = r.config()
+	}
+
+	return
+}
+
+/*
+lock will attempt to lock the receiver using sync.Mutex. If already
+locked, the operation will block. If sync.Mutex was not enabled for
+the receiver, nothing happens.
+*/
+func (r *stack) lock() {
+	if sc := r.lockConfig(); sc != nil && sc.mtx != nil {
+		start := now()
+		sc.mtx.Lock()
+		sc.lst.locked(start)
+	}
+}
+
+/*
+unlock will attempt to unlock the receiver using sync.Mutex. If not
+already locked, the operation will block. If sync.Mutex was not enabled for
+the receiver, nothing happens.
+*/
+func (r *stack) unlock() {
+	if r.canMutex() {
+		if mutex, found := r.mutex(); found {
+			sc, _ := r.config()
+			hold, warn := sc.lst.unlocked()
+			if sc.cnd != nil {
+				// wake any PushWait/PopWait callers
+				sc.cnd.Broadcast()
+			}
+			mutex.Unlock()
+			if warn {
+				thr := time.Duration(sc.lst.warn.Load())
+				sc.logEvent(LogLevel3, `lock_hold`, -1,
+					errorf("lock held for %s; exceeds threshold %s", hold, thr))
+			}
+		}
+	}
+}
+
+/*
+config returns the *nodeConfig instance found within the receiver
+alongside an instance of error. If either the *stackInstance (sc)
+is nil OR if the error (err) is non-nil, the receiver is deemed
+totally invalid and unusable.
+
+Being a slice type, the receiver bears no header in which the
+*nodeConfig pointer might be cached for its lifetime. The result
+is instead memoized per operation: stack.render fetches it once
+per pass and hands it to the per-slice calls (stack.renderSlice
+and stack.defaultAssertionHandler), rather than repeating the
+assertion for each slice rendered.
+*/
+func (r *stack) config() (sc *nodeConfig, err error) {
+	if r != nil {
+		var ok bool
+		// verify slice #0 is a *nodeConfig
+		// instance, or bail out.
+		err = unexpectedReceiverState
+		if len(*r) > 0 {
+			if sc, ok = (*r)[0].(*nodeConfig); ok {
+				err = nil
+			}
+		}
+	}
+
+	return
+}
+
+/*
+len returns the true integer length of the receiver instance.
+
+See also stack.ulen().
+*/
+func (r stack) len() int {
+	return len(r)
+}
+
+/*
+StackStats contains a structural summary of a [Stack] instance, as
+returned by the [Stack.Stats] method.
+*/
+type StackStats struct {
+	// Total is the number of slices examined.
+	Total int
+
+	// Conditions is the number of [Condition] (and [Condition]
+	// alias) slices examined.
+	Conditions int
+
+	// NestedStacks is the number of nested [Stack] (and [Stack]
+	// alias) instances encountered, including those found within
+	// [Condition] expressions during recursion.
+	NestedStacks int
+
+	// Nils is the number of nil slices examined.
+	Nils int
+
+	// MaxDepth is the deepest level at which a slice was found,
+	// where the receiver's own slices reside at level one (1).
+	MaxDepth int
+
+	// ByType contains the number of slices examined per type,
+	// keyed using the %T representation of each slice. Alias
+	// types appear under their own names.
+	ByType map[string]int
+}
+
+/*
+Stats returns an instance of [StackStats] summarizing the structure of
+the receiver. The receiver is not modified, and read-only instances are
+supported.
+
+A Boolean input value of true results in the inclusion of all nested
+[Stack] instances -- including those found within [Condition] expressions
+-- within the summary. By default, only the receiver's own slices are
+examined.
+*/
+func (r Stack) Stats(recurse ...bool) (stats StackStats) {
+	stats.ByType = make(map[string]int)
+	if r.IsInit() {
+		r.lock()
+		defer r.unlock()
+
+		r.stack.stats(len(recurse) > 0 && recurse[0], 1, &stats, visitSet{r.stack: true})
+	}
+
+	return
+}
+
+/*
+stats is a private method called by [Stack.Stats]. Instances present
+within the seen set are not descended again.
+*/
+func (r *stack) stats(recurse bool, depth int, stats *StackStats, seen visitSet) {
+	for i := 1; i < r.len(); i++ {
+		slice := (*r)[i]
+		stats.Total++
+		stats.ByType[sprintf("%T", slice)]++
+		if depth > stats.MaxDepth {
+			stats.MaxDepth = depth
+		}
+
+		var inner Stack
+		if slice == nil {
+			stats.Nils++
+		} else if S, ok := stackTypeAliasConverter(slice); ok {
+			stats.NestedStacks++
+			inner = S
+		} else if C, ok := conditionTypeAliasConverter(slice); ok {
+			stats.Conditions++
+			if S, sok := C.ExpressionAsStack(); sok && recurse {
+				stats.NestedStacks++
+				inner = S
+			}
+		}
+
+		if recurse && inner.IsInit() && !seen[inner.stack] {
+			seen[inner.stack] = true
+			inner.stack.stats(recurse, depth+1, stats, seen)
+			delete(seen, inner.stack)
+		}
+	}
+}
+
+/*
+Len returns the integer length or "size" of the receiver.
+*/
+func (r Stack) Len() (i int) {
+	if r.IsInit() {
+		view, _ := r.stack.live()
+		i = view.ulen()
+	}
+	return
+}
+
+/*
+cap is a private method called during a variety of processes,
+returning the integer representation of the capacity limit
+imposed upon the receiver instance.
+
+Note that this method returns the "true" capacity, which includes
+the cfg slice offset value (+1) summed with the user-input capacity
+limit figure. The exported Cap method, however, does NOT report this
+figure, and only recognizes the user-input value. Don't confuse the
+user :)
+*/
+func (r stack) cap() int {
+	sc, _ := r.config()
+	return sc.cap
+}
+
+/*
+Cap returns the integer representation of a capacity limit imposed upon
+the receiver. The return values shall be interpreted as follows:
+
+  - A zero (0) value indicates that the receiver has NO capacity, as the instance is not properly initialized
+  - A positive non-zero value (e.g.: >=1) reflects the capacity limit imposed upon the receiver instance
+  - A minus one (-1) value indicates infinite capacity is available; no limit is imposed
+*/
+func (r Stack) Cap() (c int) {
+	if r.IsInit() {
+		offset := -1
+		c = offset
+		if _c := r.cap(); _c > 0 {
+			c = _c + offset // cfg.cap minus 1
+		}
+	}
+
+	return
+}
+
+/*
+Avail returns the available number of slices as an integer value by
+subtracting the current length from a non-zero capacity.
+
+If no capacity is set, this method returns minus one (-1), meaning
+infinite capacity is available.
+
+If the receiver (r) is uninitialized, zero (0) is returned.
+*/
+func (r Stack) Avail() (avail int) {
+	if r.IsInit() {
+		if avail = -1; r.cap() > 0 {
+			avail = r.cap() - r.len()
+		}
+	}
+
+	return
+}
+
+/*
+ulen returns the practical integer length of the receiver. This does
+*NOT* include slice zero (0), which is reserved for the configuration
+instance, therefore this method always returns len()-1 for stacks with
+a true length that is greater than or equal to two (<= 2), and returns
+zero (0) for a true length of zero (0) OR one (1).
+*/
+func (r stack) ulen() (l int) {
+	switch u := r.len(); u {
+	case 0, 1:
+		return 0
+	default:
+		l = u - 1
+	}
+
+	return
+}
+
+/*
+KindOf returns the [StackKind] of the receiver. An uninitialized receiver
+reports [KindInvalid]. Unlike [Stack.Kind], the return value is not
+influenced by symbol or case folding settings.
+*/
+func (r Stack) KindOf() (k StackKind) {
+	if r.IsInit() {
+		k = r.stack.stackType().stackKind()
+	}
+
+	return
+}
+
+/*
+Kind returns the string name of the type of receiver configuration.
+*/
+func (r Stack) Kind() (k string) {
+	k = badStack
+	if r.IsInit() {
+		switch t, c := r.stack.typ(); c {
+		case and, or, not, list, basic:
+			k = t
+		}
+	}
+
+	return
+}
+
+/*
+String is a stringer method that returns the string representation of
+the receiver.
+
+Note that invalid [Stack] instances, as well as basic [Stack] instances,
+are not eligible for string representation.
+*/
+func (r Stack) String() (s string) {
+	if r.IsInit() {
+		view, _ := r.stack.live()
+		if sc, _ := r.stack.config(); sc.msl > 0 {
+			s = view.truncatedString(sc.msl, sc.truncationMarker())
+		} else {
+			s = view.string(nil)
+		}
+	}
+	return
+}
+
+/*
+truncatedString is a private method called by [Stack.String] when a
+maximum string length has been set. The receiver is rendered by way of
+a truncator, which stops the operation once the maximum (n) has been
+reached. In that case, marker is appended, followed by the closing
+characters of any parenthetical [Stack] instances left open.
+*/
+func (r *stack) truncatedString(n int, marker string) string {
+	builder := newStringBuilder()
+	if te, ok := r.render(&truncator{w: &builder, rem: n}, nil).(*truncatedError); ok {
+		builder.WriteString(marker + te.tail)
+	}
+	return builder.String()
+}
+
+/*
+SetMaxStringLength assigns the maximum length, in bytes, of the string
+representation produced by [Stack.String]. Once the maximum is reached,
+rendering stops and the output is terminated using the truncation marker
+(see [Stack.SetTruncationMarker]), followed by the closing characters of
+any parenthetical [Stack] instances left open, thus the output remains
+balanced. Slice values are never cut partway; a value which would exceed
+the maximum is omitted altogether.
+
+The maximum applies to the receiver's own [Stack.String] call, and governs
+all nested instances rendered during that call. The settings of nested
+instances are neither consulted nor modified. Note that [Stack.WriteTo] is
+not subject to this setting.
+
+A zero or negative value, which is the default, disables truncation.
+*/
+func (r Stack) SetMaxStringLength(n int) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.msl = n
+		}
+	}
+	return r
+}
+
+/*
+MaxStringLength returns the maximum string length set within the receiver,
+or zero (0) if unlimited. See [Stack.SetMaxStringLength].
+*/
+func (r Stack) MaxStringLength() (n int) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		n = max(sc.msl, 0)
+	}
+	return
+}
+
+/*
+SetTruncationMarker assigns the string value used to terminate truncated
+string representations of the receiver. See [Stack.SetMaxStringLength].
+
+A zero string restores the default marker, which is an ellipsis (…).
+*/
+func (r Stack) SetTruncationMarker(marker string) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.tmk = marker
+		}
+	}
+	return r
+}
+
+/*
+TruncationMarker returns the string value used to terminate truncated
+string representations of the receiver. See [Stack.SetTruncationMarker].
+*/
+func (r Stack) TruncationMarker() (marker string) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		marker = sc.truncationMarker()
+	}
+	return
+}
+
+/*
+WriteTo implements the [io.WriterTo] interface, streaming the string
+representation of the receiver into w. The output written is identical
+to that of the [Stack.String] method, however each slice is written as
+it is rendered rather than being assembled into one (1) large string
+value beforehand. This may be useful when dealing with very large LIST
+stacks destined for files, HTTP responses and the like.
+
+Nested [Stack] and [Stack] type alias instances are streamed through the
+same writer recursively.
+
+The number of bytes written is returned alongside an error. Any error
+returned by w shall abort the operation and is returned as-is.
+
+Note that invalid [Stack] instances, as well as basic [Stack] instances,
+are not eligible for string representation. In such cases nothing is
+written, and zero (0) and a nil error are returned.
+*/
+func (r Stack) WriteTo(w io.Writer) (n int64, err error) {
+	if w == nil {
+		err = errorf("nil io.Writer; cannot write")
+	} else if r.IsInit() {
+		cw := &countWriter{w: w}
+		err = r.stack.render(cw, nil)
+		n = cw.n
+	}
+
+	return
+}
+
+func (r *stack) canString() (can bool, ot string, oc stackType) {
+	if r != nil {
+		if r.valid() {
+			ot, oc = r.typ()
+			can = oc != 0x0 && oc != basic
+		}
+	}
+	return
+}
+
+/*
+string is a private method called by [Stack.String]. It renders
+the receiver by way of stack.render into a string builder. See
+stack.render regarding seen.
+*/
+func (r *stack) string(seen visitSet) string {
+	builder := newStringBuilder()
+	_ = r.render(&builder, seen)
+	return builder.String()
+}
+
+/*
+recursiveSentinel is rendered in place of a [Stack] which is already
+being rendered further up the same path, as is the case when a [Stack]
+contains itself.
+*/
+const recursiveSentinel = `<recursive>`
+
+/*
+visitSet contains the *stack instances present along the current path
+of a recursive operation, and is used to detect cycles.
+*/
+type visitSet map[*stack]bool
+
+/*
+render is a private method called by stack.string and [Stack.WriteTo].
+It writes the string representation of the receiver to w, slice by
+slice, by way of a condensing writer that mimics the effect of the
+condenseWHSP function upon the whole of the output.
+
+The seen set contains those instances being rendered further up the
+current path, which are rendered using recursiveSentinel when found
+again. A nil seen set is allocated as needed.
+*/
+func (r *stack) render(w io.Writer, seen visitSet) (err error) {
+	can, ot, oc := r.canString()
+	if !can {
+		return
+	}
+
+	if seen == nil {
+		seen = make(visitSet)
+	} else if seen[r] {
+		_, err = io.WriteString(w, recursiveSentinel)
+		return
+	}
+	seen[r] = true
+	defer delete(seen, r)
+
+	// execute the user-authored presentation
+	// policy, if defined, instead of going any
+	// further.
+	if ppol := r.getPresentationPolicy(); ppol != nil {
+		_, err = io.WriteString(w, ppol(r))
+		return
+	}
+
+	// the configuration is fetched once for the
+	// whole of the pass, and threaded through to
+	// each per-slice call (see stack.config).
+	sc, _ := r.config()
+
+	doPad := !sc.positive(nspad) && r.getSymbol() == ``
+	if sc.spd != nil || sc.pst != nil {
+		doPad = sc.symbolPadded()
+	}
+	ot = padValue(doPad, ot)
+
+	cw := newCondenser(w)
+	open, clos := r.parenChars()
+	cw.writeString(open)
+	opened := cw.err == nil
+
+	var sep string
+	if r.positive(lonce) {
+		if oc != list {
+			cw.writeString(ot)
+		}
+	} else {
+		sep = r.joinString(ot, oc)
+	}
+
+	// Positional delimiters vary per join
+	positional := oc == list && !r.positive(lonce) && len(sc.ljs) > 0
+
+	// Scan each slice and attempt stringification
+	var emitted bool
+	for i := 1; i < r.len() && cw.err == nil; i++ {
+		if positional {
+			sep = sc.listDelimiter(i-1, r.ulen())
+		}
+		emitted = r.renderSlice(cw, sc, (*r)[i], sep, emitted, seen)
+	}
+
+	cw.writeString(clos)
+
+	// see truncatedError
+	if te, ok := cw.err.(*truncatedError); ok {
+		if opened {
+			te.tail += clos
+		}
+		return te
+	}
+
+	return cw.close()
+}
+
+/*
+renderSlice is a private method called by stack.render. It writes the
+string representation of slice x to the condensing writer, preceded by
+the join value (sep) if a previous slice was written. A Boolean value
+is returned indicative of whether anything has been written so far.
+
+The sc input value is the receiver's configuration, as fetched once by
+stack.render for the whole of the pass.
+*/
+func (r *stack) renderSlice(cw *condenser, sc *nodeConfig, x any, sep string, emitted bool, seen visitSet) bool {
+	var prefix string
+	if emitted {
+		prefix = sep
+	}
+
+	// Comments are rendered in place, without
+	// the join value.
+	if c, ok := x.(Comment); ok {
+		if val := r.commentString(c); len(val) > 0 {
+			cw.writePadded(r.commentPadded(), val)
+		}
+		return emitted
+	}
+
+	if Xs, _ := stackTypeAliasConverter(x); Xs.IsInit() {
+		if _, ic := Xs.stack.typ(); ic == not && len(Xs.getSymbol()) == 0 &&
+			len(sc.sst[not]) == 0 {
+			// Handle NOTs a little differently
+			// when nested and when not using
+			// symbol operators. The word is
+			// padded on both sides, as the join
+			// value (if any) may not be.
+			if emitted {
+				prefix += ` `
+			}
+			cw.writeString(prefix + foldValue(Xs.positive(cfold), Xs.kind()) + ` `)
+			cw.err = r.renderChild(cw, Xs.stack, seen)
+			return true
+		}
+
+		// Only write the join value if the nested
+		// stack actually produces something.
+		lw := &lazyWriter{w: cw, prefix: prefix}
+		cw.err = r.renderChild(lw, Xs.stack, seen)
+		return emitted || lw.done
+	}
+
+	// Handle slice value types through assertion
+	if val, pad := r.defaultAssertionHandler(sc, x, seen); len(val) > 0 {
+		if sc.spd != nil && !*sc.spd {
+			// padded values would otherwise
+			// pad the unpadded symbol.
+			pad = false
+		}
+		cw.writeString(prefix)
+		cw.writePadded(pad, val)
+		return true
+	}
+
+	return emitted
+}
+
+/*
+renderChild is a private method called by stack.renderSlice. It renders
+the nested stack (child) to w, by way of stack.symbolView if the receiver
+bears a table of symbols, and stack.presentationView if the receiver bears
+the presentation inheritance setting.
+*/
+func (r *stack) renderChild(w io.Writer, child *stack, seen visitSet) error {
+	sc, _ := r.config()
+	if (!sc.inh && len(sc.sst) == 0) || seen[child] {
+		return child.render(w, seen)
+	}
+
+	view := child.symbolView(sc)
+	if sc.inh {
+		view = view.presentationView(sc)
+	}
+	if view == child {
+		return child.render(w, seen)
+	}
+
+	// the view stands in for the child, which
+	// must remain detectable as already seen.
+	seen[child] = true
+	defer delete(seen, child)
+
+	return view.render(w, seen)
+}
+
+/*
+joinString returns the string value used to join the slices of the
+receiver during string representation based on the stack type (oc)
+and the effective operator string value (ot).
+*/
+func (r stack) joinString(ot string, oc stackType) (j string) {
+	if oc == list {
+		j = r.getListDelimiter()
+	} else if sc, _ := r.config(); sc.pst != nil || sc.spd != nil {
+		// ot was already padded (or not)
+		// per the PaddingStyle or the
+		// symbol padding state.
+		j = ot
+	} else if len(r.getSymbol()) > 0 {
+		j = ot
+		if !r.positive(nspad) {
+			j = `   ` + ot + `   `
+		}
+	} else {
+		j = `   ` + ot + `   `
+	}
+
+	return
+}
+
+/*
+defaultAssertionHandler is a private method called by stack.renderSlice.
+A Boolean value indicative of whether str is to be padded is returned;
+padding is not applied to str itself, thereby avoiding a needless copy
+of each value. The sc input value is the receiver's configuration.
+*/
+func (r stack) defaultAssertionHandler(sc *nodeConfig, x any, seen visitSet) (str string, pad bool) {
+
+	// str is assigned with
+	str = `UNKNOWN`
+
+	// Whatever it is, it had better be one (1) of the following
+	// possibilities in the following evaluated order:
+	// • An initialized Stack, or type alias of Stack, or ...
+	// • An initialized Condition, or type alias of Condition, or ...
+	// • Something that has its own "stringer" (String()) method, or ...
+	// • A Go primitive
+	//
+	// BUG FIX: shadow the "ok" variable for conversion checks,
+	// and use (Stack|Condition).IsInit to decide whether the
+	// asserted value is usable. This resolves a panic bug that
+	// was reported within go-aci, an application that imports
+	// stackage.
+	if Xs, _ := stackTypeAliasConverter(x); Xs.IsInit() {
+		ik, ic := Xs.stack.typ() // make note of inner stack type
+		if ic == not && len(Xs.getSymbol()) == 0 {
+			// Handle NOTs a little differently
+			// when nested and when not using
+			// symbol operators ...
+			ik = foldValue(Xs.positive(cfold), ik)
+			str = ik + ` ` + Xs.stack.string(seen)
+		} else {
+			str = Xs.stack.string(seen)
+		}
+
+	} else if Xc, _ := conditionTypeAliasConverter(x); Xc.IsInit() {
+		if sc.ski && !Xc.renderable() {
+			str = `` // omitted entirely
+		} else {
+			str = Xc.string(seen)
+		}
+
+	} else if c, ok := x.(Comment); ok {
+		str = r.commentString(c)
+
+	} else if tstr, ok := typeStringer(sc.tsf, x); ok {
+		// the user registered a stringer handler
+		// for this type, either package-wide or
+		// within the receiver.
+		str, pad = sc.encapv(tstr), !sc.positive(nspad)
+	} else if meth := getStringer(x); meth != nil {
+		// whatever it is, it seems to have
+		// a stringer method, at least. If the
+		// user is submitting a non-primitive
+		// like a struct or a map, and NOT a
+		// type alias of Stack/Condition, this
+		// will be the condition that matches.
+		str, pad = sc.encapv(meth()), !sc.positive(nspad)
+	} else if isKnownPrimitive(x) {
+		// If its a Go primitive, string it (see misc.go).
+		str, pad = sc.encapv(primitiveStringer(x)), !sc.positive(nspad)
+	}
+
+	return
+}
+
+/*
+SetTypeStringer registers the provided function (fn) as the string handler
+for all values of the type of typ when encountered as slices within the
+receiver instance. Handlers registered in this manner take precedence over
+those registered package-wide via the [RegisterStringer] function, and are
+subject to the same rules.
 
-Subsequent calls of write-related methods, such as [Stack.Push],
-[Stack.Pop], [Stack.Remove] and others, shall invoke MuTeX locking at
-the latest possible state of processing, thereby minimizing the duration
-of a lock.
+Specifying a nil fn shall remove any handler registered within the receiver
+for the type.
 */
-func (r Stack) SetMutex() Stack {
+func (r Stack) SetTypeStringer(typ any, fn func(any) string) Stack {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.stack.setMutex()
+			r.stack.setTypeStringer(typ, fn)
 		}
 	}
+
 	return r
 }
 
 /*
-Deprecated: Use [Stack.SetMutex].
+setTypeStringer is a private method called by [Stack.SetTypeStringer].
 */
-func (r Stack) Mutex() Stack {
-	return r.SetMutex()
-}
+func (r *stack) setTypeStringer(typ any, fn func(any) string) {
+	t := stringerType(typ)
+	if t == nil {
+		return
+	}
 
-/*
-setMutex is a private method called by [Stack.Mutex].
-*/
-func (r *stack) setMutex() {
 	sc, _ := r.config()
-	sc.setMutex()
+	r.lock()
+	defer r.unlock()
+
+	// copy-on-write, so any in-flight
+	// string operations are unaffected.
+	tsf := make(map[reflect.Type]func(any) string, len(sc.tsf)+1)
+	for k, v := range sc.tsf {
+		tsf[k] = v
+	}
+
+	if fn == nil {
+		delete(tsf, t)
+	} else {
+		tsf[t] = fn
+	}
+	sc.tsf = tsf
 }
 
 /*
-CanMutex returns a Boolean value indicating whether the receiver
-instance has been equipped with mutual exclusion locking features.
+Traverse will "walk" a structure of stack elements using the path indices
+provided. It returns the slice found at the final index, or nil, along with
+a success-indicative Boolean value.
 
-This does NOT indicate whether the receiver is actually locked.
+The semantics of "traversability" are as follows:
+
+  - Any "nesting" instance must be a [Stack] or [Stack] type alias
+  - [Condition] instances must either be the final requested element, OR must contain a [Stack] or [Stack] type alias instance through which the traversal process may continue
+  - All other value types are returned as-is
+
+If the traversal ended at any given value, it will be returned along with a
+positive ok value letting the user know they arrived at the coordinates they
+defined and that "something" was found.
+
+If, however, any path elements remained and further traversal was NOT possible,
+the last slice is returned as nil.
+
+As the return type is any, the slice value must be manually type asserted.
 */
-func (r Stack) CanMutex() (can bool) {
+func (r Stack) Traverse(indices ...int) (slice any, ok bool) {
 	if r.IsInit() {
-		can = r.stack.canMutex()
+		var err error
+		slice, err = r.stack.traverse(1, indices...)
+		ok = err == nil
 	}
 	return
 }
 
 /*
-canMutex is a private method called by [Stack.CanMutex].
+TraverseErr performs the same operation as [Stack.Traverse], returning an
+error describing the reason for a failed traversal in place of a Boolean
+value. The error identifies the (one-based) depth at which the failure
+occurred alongside the requested index, the length of the [Stack] at that
+depth, or the type encountered that prevented further traversal.
 */
-func (r stack) canMutex() bool {
-	sc, _ := r.config()
-	return sc.mtx != nil
+func (r Stack) TraverseErr(indices ...int) (slice any, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "depth 1: stack instance is nil")
+		return
+	}
+
+	return r.stack.traverse(1, indices...)
 }
 
 /*
-lock will attempt to lock the receiver using sync.Mutex. If already
-locked, the operation will block. If sync.Mutex was not enabled for
-the receiver, nothing happens.
+TraverseAt returns the slice found by way of [Stack.Traverse] using the
+path indices provided, or the optional default (def) value if traversal
+failed. If no default value is specified, nil is returned in such cases.
+
+This is a convenience method only; traversal semantics are identical to
+[Stack.Traverse].
 */
-func (r *stack) lock() {
-	if r.canMutex() {
-		if mutex, found := r.mutex(); found {
-			sc, _ := r.config()
-			_now := now()
-			sc.ldr = &_now
-			mutex.Lock()
+func (r Stack) TraverseAt(indices []int, def ...any) (slice any) {
+	var ok bool
+	if slice, ok = r.Traverse(indices...); !ok {
+		slice = nil
+		if len(def) > 0 {
+			slice = def[0]
 		}
 	}
+	return
 }
 
 /*
-unlock will attempt to unlock the receiver using sync.Mutex. If not
-already locked, the operation will block. If sync.Mutex was not enabled for
-the receiver, nothing happens.
+MustTraverse returns the slice found by way of [Stack.Traverse] using the
+path indices provided. A panic occurs if traversal failed. The panic
+message identifies the ID of the receiver alongside the reason for the
+failure, as described by [Stack.TraverseErr].
+
+This is a convenience method only, intended mainly for test code.
 */
-func (r *stack) unlock() {
-	if r.canMutex() {
-		if mutex, found := r.mutex(); found {
-			mutex.Unlock()
-			sc, _ := r.config()
-			sc.ldr = nil
-		}
+func (r Stack) MustTraverse(indices ...int) any {
+	slice, err := r.TraverseErr(indices...)
+	if err != nil {
+		panic(sprintf("stackage: traversal of stack '%s' failed: %v", r.ID(), err))
 	}
+	return slice
 }
 
 /*
-config returns the *nodeConfig instance found within the receiver
-alongside an instance of error. If either the *stackInstance (sc)
-is nil OR if the error (err) is non-nil, the receiver is deemed
-totally invalid and unusable.
+traverse is a private method called by [Stack.Traverse] and [Stack.TraverseErr].
 */
-func (r *stack) config() (sc *nodeConfig, err error) {
-	if r != nil {
-		var ok bool
-		// verify slice #0 is a *nodeConfig
-		// instance, or bail out.
-		err = unexpectedReceiverState
-		if sc, ok = (*r)[0].(*nodeConfig); ok {
-			err = nil
+func (r stack) traverse(depth int, indices ...int) (slice any, err error) {
+	if len(indices) == 0 {
+		err = errorf("depth %d: empty traversal path", depth)
+		return
+	} else if !r.valid() {
+		err = errorf("depth %d: invalid stack", depth)
+		return
+	}
+
+	current := indices[0] // user-facing index number w/ offset
+	var found bool
+	if slice, _, found = r.index(current); !found {
+		if L := r.ulen(); current < 0 || current >= L {
+			err = errorf("depth %d: index %d out of range (len %d)", depth, current, L)
+		} else {
+			err = errorf("depth %d: index %d is nil", depth, current)
+		}
+		return
+	} else if len(indices) == 1 {
+		// End of the line :)
+		return
+	}
+
+	// Begin assertion of possible traversable and non-traversable
+	// values. We'll go as deep as possible, provided each nesting
+	// instance is a Stack/Stack alias, or Condition/Condition alias
+	// containing a Stack/Stack alias value.
+	if s, ok := stackTypeAliasConverter(slice); ok && s.IsInit() {
+		return s.stack.traverse(depth+1, indices[1:]...)
+	} else if c, ok := conditionTypeAliasConverter(slice); ok {
+		if s, ok := c.ExpressionAsStack(); ok && s.IsInit() {
+			return s.stack.traverse(depth+1, indices[1:]...)
 		}
+		err = errorf("depth %d: Condition expression is %T, not traversable",
+			depth, c.Expression())
+	} else {
+		err = errorf("depth %d: %T is not traversable", depth, slice)
 	}
 
+	// If we arrived here with more path elements left,
+	// it would appear the path was invalid, or ill-suited
+	// for this particular structure in the traversable
+	// sense. Don't return the last slice.
+	slice = nil
+
 	return
 }
 
 /*
-len returns the true integer length of the receiver instance.
+Conditions returns slices of [Condition] instances -- or [Condition] type
+alias instances converted back to the native [Condition] type -- found
+within the receiver instance in encounter order.
 
-See also stack.ulen().
-*/
-func (r stack) len() int {
-	return len(r)
-}
+If recurse is true, nested [Stack] (and [Stack] alias) slices are descended,
+as are the expression values of any [Condition] instances containing a [Stack]
+(or [Stack] alias) instance.
 
-/*
-Len returns the integer length or "size" of the receiver.
+Neither the receiver nor any of its slices are modified by this method. A
+nil instance of []Condition is returned if the receiver is uninitialized.
 */
-func (r Stack) Len() (i int) {
+func (r Stack) Conditions(recurse ...bool) (conds []Condition) {
 	if r.IsInit() {
-		i = r.ulen()
+		var deep bool
+		if len(recurse) > 0 {
+			deep = recurse[0]
+		}
+		conds = r.stack.conditions(deep, conds)
 	}
+
 	return
 }
 
 /*
-cap is a private method called during a variety of processes,
-returning the integer representation of the capacity limit
-imposed upon the receiver instance.
-
-Note that this method returns the "true" capacity, which includes
-the cfg slice offset value (+1) summed with the user-input capacity
-limit figure. The exported Cap method, however, does NOT report this
-figure, and only recognizes the user-input value. Don't confuse the
-user :)
+conditions is a private method called by [Stack.Conditions] and [Stack.Keywords].
 */
-func (r stack) cap() int {
-	sc, _ := r.config()
-	return sc.cap
+func (r stack) conditions(deep bool, conds []Condition) []Condition {
+	for i := 1; i < r.len(); i++ {
+		if c, ok := conditionTypeAliasConverter(r[i]); ok && c.IsInit() {
+			conds = append(conds, c)
+			if deep {
+				if S, sok := c.ExpressionAsStack(); sok && S.IsInit() {
+					conds = S.stack.conditions(deep, conds)
+				}
+			}
+		} else if S, sok := stackTypeAliasConverter(r[i]); sok && deep && S.IsInit() {
+			conds = S.stack.conditions(deep, conds)
+		}
+	}
+
+	return conds
 }
 
 /*
-Cap returns the integer representation of a capacity limit imposed upon
-the receiver. The return values shall be interpreted as follows:
+Keywords returns the de-duplicated keyword string values of all [Condition]
+instances found within the receiver in encounter order. See [Stack.Conditions]
+for details on the recurse input argument.
 
-  - A zero (0) value indicates that the receiver has NO capacity, as the instance is not properly initialized
-  - A positive non-zero value (e.g.: >=1) reflects the capacity limit imposed upon the receiver instance
-  - A minus one (-1) value indicates infinite capacity is available; no limit is imposed
+Neither the receiver nor any of its slices are modified by this method. A
+nil instance of []string is returned if the receiver is uninitialized.
 */
-func (r Stack) Cap() (c int) {
-	if r.IsInit() {
-		offset := -1
-		c = offset
-		if _c := r.cap(); _c > 0 {
-			c = _c + offset // cfg.cap minus 1
+func (r Stack) Keywords(recurse ...bool) (kws []string) {
+	for _, c := range r.Conditions(recurse...) {
+		if kw := c.Keyword(); !strInSlice(kw, kws) {
+			kws = append(kws, kw)
 		}
 	}
 
@@ -1734,303 +5439,391 @@ func (r Stack) Cap() (c int) {
 }
 
 /*
-Avail returns the available number of slices as an integer value by
-subtracting the current length from a non-zero capacity.
+Negate returns the logical negation of the receiver by way of De Morgan's
+laws, applied recursively:
 
-If no capacity is set, this method returns minus one (-1), meaning
-infinite capacity is available.
+  - An [And] receiver becomes an [Or] (and vice versa), with each of its slices negated
+  - A [Not] receiver is eliminated (double negation); if it contains a single [Stack], that [Stack] is returned, else its slices are returned within a new [And] [Stack]
+  - A [List] or [Basic] receiver, which has no logical meaning, is enveloped within a new [Not] [Stack]
 
-If the receiver (r) is uninitialized, zero (0) is returned.
+Slices are negated as follows:
+
+  - [Condition] slices are negated in place via [Condition.Negate] if their [Operator] has a known negation (see [NegateOperator]), else they are enveloped within a new [Not] [Stack]
+  - [Stack] slices are replaced with the outcome of their own negation
+  - Read-only slices, and slices of any other type, are enveloped within a new [Not] [Stack]
+
+Note that the receiver is modified in place wherever possible, and that the
+return value should be used in place of the receiver going forward. Symbols
+assigned via [Stack.SetSymbol] are not altered.
+
+Read-only receivers are returned unmodified, as are receivers which contain
+themselves, in which case an error wrapping [ErrCycle] is set within the
+receiver, accessible via [Stack.Err].
 */
-func (r Stack) Avail() (avail int) {
+func (r Stack) Negate() Stack {
 	if r.IsInit() {
-		if avail = -1; r.cap() > 0 {
-			avail = r.cap() - r.len()
+		if !r.getState(ronly) {
+			if err := r.stack.cycleErr(); err != nil {
+				r.stack.setErr(err)
+				return r
+			}
+			return r.stack.negate()
 		}
 	}
-
-	return
+	return r
 }
 
 /*
-ulen returns the practical integer length of the receiver. This does
-*NOT* include slice zero (0), which is reserved for the configuration
-instance, therefore this method always returns len()-1 for stacks with
-a true length that is greater than or equal to two (<= 2), and returns
-zero (0) for a true length of zero (0) OR one (1).
+negate is a private method called by [Stack.Negate].
 */
-func (r stack) ulen() (l int) {
-	switch u := r.len(); u {
-	case 0, 1:
-		return 0
+func (r *stack) negate() (S Stack) {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
+
+	sc, _ := r.config()
+	switch sc.typ {
+	case and, or:
+		if sc.typ == and {
+			sc.typ = or
+		} else {
+			sc.typ = and
+		}
+		for i := 1; i < r.len(); i++ {
+			if (*r)[i] != nil {
+				(*r)[i] = negateSlice((*r)[i])
+			}
+		}
+		S = Stack{r}
+	case not:
+		if r.ulen() == 1 {
+			if inner, ok := stackTypeAliasConverter((*r)[1]); ok && inner.IsInit() {
+				S = inner
+				return
+			}
+		}
+		S = Stack{r.sibling()}
+		nc, _ := S.stack.config()
+		nc.typ = and
+		*S.stack = append(*S.stack, (*r)[1:]...)
 	default:
-		l = u - 1
+		S = Not().Push(Stack{r})
 	}
 
 	return
 }
 
 /*
-Kind returns the string name of the type of receiver configuration.
+negateSlice returns the negation of slice x for use by stack.negate.
 */
-func (r Stack) Kind() (k string) {
-	k = badStack
-	if r.IsInit() {
-		switch t, c := r.stack.typ(); c {
-		case and, or, not, list, basic:
-			k = t
+func negateSlice(x any) any {
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		if !S.getState(ronly) {
+			return S.stack.negate()
+		}
+	} else if C, ok := conditionTypeAliasConverter(x); ok && C.IsInit() {
+		if !C.getState(ronly) {
+			if neg, nok := NegateOperator(C.Operator()); nok {
+				C.condition.setOperator(neg)
+				return x
+			}
 		}
 	}
 
-	return
+	return Not().Push(x)
 }
 
 /*
-String is a stringer method that returns the string representation of
-the receiver.
-
-Note that invalid [Stack] instances, as well as basic [Stack] instances,
-are not eligible for string representation.
-*/
-func (r Stack) String() (s string) {
-	if r.IsInit() {
-		s = r.stack.string()
-	}
-	return
-}
+Reveal processes the receiver instance and disenvelops needlessly
+enveloped [Stack] slices.
 
-func (r *stack) canString() (can bool, ot string, oc stackType) {
-	if r != nil {
-		if r.valid() {
-			ot, oc = r.typ()
-			can = oc != 0x0 && oc != basic
-		}
-	}
-	return
-}
+Note that this is done in place: the receiver -- and any nested [Stack]
+and [Condition] instances -- are modified, which is visible to all other
+references to the same instances. See [Stack.RevealCopy] for a
+non-destructive alternative.
 
-/*
-string is a private method called by [Stack.String].
+Processing stops upon encountering a read-only nested instance, as it
+cannot be modified or disenveloped, in which case an error wrapping
+[ErrReadOnly] is returned, and is also set within the receiver. No action
+is taken if the receiver itself is read-only, in which case an error
+wrapping [ErrReadOnly] is returned.
 */
-func (r *stack) string() (assembled string) {
-	if can, ot, oc := r.canString(); can {
-
-		var str []string
-		// execute the user-authoried presentation
-		// policy, if defined, instead of going any
-		// further.
-		if ppol := r.getPresentationPolicy(); ppol != nil {
-			assembled = ppol(r)
-			return
+func (r Stack) Reveal() (err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+	} else if r.getState(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot reveal", r)
+	} else {
+		before := r.stack.called(`reveal`, 0)
+		defer r.stack.ended()
+		if err = r.stack.cycleErr(); err == nil {
+			err = r.stack.reveal()
 		}
 
-		// Scan each slice and attempt stringification
-		for i := 1; i < r.len(); i++ {
-			// Handle slice value types through assertion
-			if val := r.defaultAssertionHandler((*r)[i]); len(val) > 0 {
-				//if val := r.stringAssertion((*r)[i]); len(val) > 0 {
-				// Append the apparently valid
-				// string value ...
-				str = append(str, val)
-			}
+		if err != nil {
+			r.stack.setErr(err)
 		}
-
-		// hand off our string slices, along with the outermost
-		// type/code values, to the assembleStringStack worker.
-		doPad := !r.positive(nspad) && r.getSymbol() == ``
-		assembled = r.assembleStringStack(str, padValue(doPad, ot), oc)
+		r.stack.settled(`reveal`, before)
 	}
 
 	return
 }
 
 /*
-defaultAssertionHandler is a private method called by stack.string
-and stack.isEqual.
-*/
-func (r stack) defaultAssertionHandler(x any) (str string) {
-
-	// str is assigned with
-	str = `UNKNOWN`
+RevealCopy returns a deep copy of the receiver, processed as described by
+[Stack.Reveal], alongside an error, if any. The receiver, and all other
+instances it references, remain untouched. The copy is produced in the
+manner described by [Stack.Interpolate].
 
-	// Whatever it is, it had better be one (1) of the following
-	// possibilities in the following evaluated order:
-	// • An initialized Stack, or type alias of Stack, or ...
-	// • An initialized Condition, or type alias of Condition, or ...
-	// • Something that has its own "stringer" (String()) method, or ...
-	// • A Go primitive
-	//
-	// BUG FIX: shadow the "ok" variable for conversion checks,
-	// and use (Stack|Condition).IsInit to decide whether the
-	// asserted value is usable. This resolves a panic bug that
-	// was reported within go-aci, an application that imports
-	// stackage.
-	if Xs, _ := stackTypeAliasConverter(x); Xs.IsInit() {
-		ik, ic := Xs.stack.typ() // make note of inner stack type
-		if ic == not && len(Xs.getSymbol()) == 0 {
-			// Handle NOTs a little differently
-			// when nested and when not using
-			// symbol operators ...
-			ik = foldValue(Xs.positive(cfold), ik)
-			str = ik + ` ` + Xs.String()
-		} else {
-			str = Xs.String()
-		}
+The read-only state of the receiver does not prevent processing of the
+copy, though that of any nested instance does, in which case an error
+wrapping [ErrReadOnly] is returned. An uninitialized [Stack] is returned
+alongside any error.
+*/
+func (r Stack) RevealCopy() (S Stack, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+		return
+	} else if err = r.stack.cycleErr(); err != nil {
+		return
+	}
 
-	} else if Xc, _ := conditionTypeAliasConverter(x); Xc.IsInit() {
-		str = Xc.String()
+	r.stack.lock()
+	C := r.stack.clone(make(map[*stack]*stack))
+	r.stack.unlock()
 
-	} else if meth := getStringer(x); meth != nil {
-		// whatever it is, it seems to have
-		// a stringer method, at least. If the
-		// user is submitting a non-primitive
-		// like a struct or a map, and NOT a
-		// type alias of Stack/Condition, this
-		// will be the condition that matches.
-		str = padValue(!r.positive(nspad), r.encapv(meth()))
-	} else if isKnownPrimitive(x) {
-		// If its a Go primitive, string it (see misc.go).
-		str = padValue(!r.positive(nspad), r.encapv(primitiveStringer(x)))
+	sc, _ := C.config()
+	ro := sc.positive(ronly)
+	sc.unsetOpt(ronly)
+	if err = C.reveal(); err == nil {
+		if ro {
+			sc.setOpt(ronly)
+		}
+		S = Stack{C}
 	}
 
 	return
 }
 
 /*
-assembleStringStack is a private method called by stack.string. This method
-reduces the cyclomatic complexity of stack.string() by handling the end-stage
-processing of a request for string representation of the receiver.
+ContainsCycle returns a Boolean value indicative of whether the receiver
+contains itself, or whether any nested [Stack] contains itself, whether
+directly or by way of a [Condition] expression.
+
+Operations which descend through the structure of the receiver, such as
+[Stack.IsEqual], [Stack.Reveal], [Stack.Defrag] and [Stack.Unmarshal],
+refuse such instances, returning (or setting) an error which wraps
+[ErrCycle]. [Stack.String] renders the recurring instance as "<recursive>".
 */
-func (r stack) assembleStringStack(str []string, ot string, oc stackType) string {
-	// Padding char (or lack thereof)
-	pad := padValue(!r.positive(nspad), "")
+func (r Stack) ContainsCycle() (is bool) {
+	if r.IsInit() {
+		is = r.stack.cycleErr() != nil
+	}
+	return
+}
 
-	builder := newStringBuilder()
+/*
+cycleErr returns an error wrapping [ErrCycle] if the receiver contains
+a cycle, per [Stack.ContainsCycle].
+*/
+func (r *stack) cycleErr() error {
+	return r.findCycle(make(visitSet), nil)
+}
 
-	if r.positive(lonce) {
-		if oc != list {
-			builder.WriteString(ot)
-		}
-		for _, val := range str {
-			builder.WriteString(val)
+/*
+findCycle is a private method called by stack.cycleErr. The seen set
+contains those instances present along the current path, which is
+indicated.
+*/
+func (r *stack) findCycle(seen visitSet, path []int) (err error) {
+	if seen[r] {
+		loc := `receiver`
+		if len(path) > 0 {
+			loc = `slice ` + pathString(path)
 		}
-	} else {
-		if oc == list {
-			var joinChar string
-			if ljc := r.getListDelimiter(); len(ljc) > 0 {
-				joinChar = ljc
-			} else {
-				joinChar = pad
-			}
-			builder.WriteString(join(str, joinChar))
-		} else {
-			var tjn string
-			var char string
-			if len(r.getSymbol()) > 0 {
-				if !r.positive(nspad) {
-					char = " "
-				}
-				sympad := padValue(!r.positive(nspad), char)
-				j := sympad + ot + sympad
-				tjn = join(str, j)
-			} else {
-				char = " "
-				sympad := padValue(true, char)
-				j := sympad + ot + sympad
-				tjn = join(str, j)
+		return wrapErr(ErrCycle, "%s: stack contains itself", loc)
+	}
+	seen[r] = true
+	defer delete(seen, r)
+
+	for i := 1; i < r.len() && err == nil; i++ {
+		S, ok := stackTypeAliasConverter((*r)[i])
+		if !ok {
+			if C, cok := conditionTypeAliasConverter((*r)[i]); cok && C.IsInit() {
+				S, ok = C.ExpressionAsStack()
 			}
-			builder.WriteString(tjn)
 		}
-	}
 
-	fpad := pad + builder.String() + pad
-	result := condenseWHSP(r.paren(fpad))
+		if ok && S.IsInit() {
+			err = S.stack.findCycle(seen, append(append([]int{}, path...), i-1))
+		}
+	}
 
-	return result
+	return
 }
 
 /*
-Traverse will "walk" a structure of stack elements using the path indices
-provided. It returns the slice found at the final index, or nil, along with
-a success-indicative Boolean value.
+Checksum returns a 64-bit FNV-1a fingerprint of the logical structure of
+the receiver, alongside an error if the fingerprint could not be computed.
+This is far less costly than [Stack.IsEqual], and is suitable for use in
+cache keys and change detection.
 
-The semantics of "traversability" are as follows:
+The fingerprint is computed over the kind of the receiver and, in order,
+each of its slices. Nested [Stack] instances contribute their own
+fingerprints, as do [Condition] instances, which are fingerprinted by
+keyword, operator and expression value(s). All other values are rendered
+by way of their String method, if present, else using the %v verb.
 
-  - Any "nesting" instance must be a [Stack] or [Stack] type alias
-  - [Condition] instances must either be the final requested element, OR must contain a [Stack] or [Stack] type alias instance through which the traversal process may continue
-  - All other value types are returned as-is
+Presentation-only settings, such as parenthetical encapsulation, padding,
+symbols and encapsulation, have no bearing upon the fingerprint, nor do
+[Comment] slices, such that instances deemed equal by [Stack.IsEqual]
+produce equal fingerprints. Slice
+order is significant, except within AND, OR and LIST instances for which
+order-insensitive equality is enabled. See [Stack.SetEqualityOrderInsensitive].
 
-If the traversal ended at any given value, it will be returned along with a
-positive ok value letting the user know they arrived at the coordinates they
-defined and that "something" was found.
+An error is returned if a channel, function or unsafe pointer value is
+encountered, as these cannot be fingerprinted meaningfully, or if the
+receiver contains itself. See [Stack.ContainsCycle].
+*/
+func (r Stack) Checksum() (sum uint64, err error) {
+	if !r.IsInit() {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+		return
+	}
 
-If, however, any path elements remained and further traversal was NOT possible,
-the last slice is returned as nil.
+	if err = r.stack.cycleErr(); err == nil {
+		sum, err = r.stack.checksum(nil)
+	}
 
-As the return type is any, the slice value must be manually type asserted.
+	return
+}
+
+/*
+checksum is a private method called by [Stack.Checksum]. The path of the
+receiver, relative to the outermost [Stack], is indicated.
 */
-func (r Stack) Traverse(indices ...int) (slice any, ok bool) {
-	if r.IsInit() {
-		slice, ok, _ = r.stack.traverse(indices...)
+func (r *stack) checksum(path []int) (sum uint64, err error) {
+	h := fnv.New64a()
+	var unordered uint64
+	typ := r.stackType()
+	uo := r.positive(ueqty) && (typ == and || typ == or || typ == list)
+	checksumWrite(h, uint64(typ))
+	for i := 1; i < r.len() && err == nil; i++ {
+		if isComment((*r)[i]) {
+			continue
+		}
+		var sub uint64
+		if sub, err = checksumValue((*r)[i],
+			append(append([]int{}, path...), i-1)); err == nil {
+			if uo {
+				unordered += sub
+			} else {
+				checksumWrite(h, sub)
+			}
+		}
+	}
+
+	if err == nil {
+		if uo {
+			checksumWrite(h, unordered)
+		}
+		sum = h.Sum64()
 	}
+
 	return
 }
 
 /*
-traverse is a private method called by [Stack.Traverse].
+checksum is a private method called by checksumValue.
 */
-func (r stack) traverse(indices ...int) (slice any, ok, done bool) {
-	if r.valid() {
-		if len(indices) == 0 {
-			return
+func (r *condition) checksum(path []int) (sum uint64, err error) {
+	h := fnv.New64a()
+	checksumWrite(h, uint64(cond), r.kw)
+	if r.op != nil {
+		checksumWrite(h, r.op.String(), r.op.Context())
+	}
+
+	vals := r.exv
+	if len(vals) == 0 {
+		vals = []any{r.ex}
+	}
+
+	for i := 0; i < len(vals) && err == nil; i++ {
+		var sub uint64
+		if sub, err = checksumValue(vals[i], path); err == nil {
+			checksumWrite(h, sub)
 		}
+	}
 
-		// begin "walking" path of int breadcrumbs ...
-		for i := 0; i < len(indices); i++ {
+	if err == nil {
+		sum = h.Sum64()
+	}
 
-			current := indices[i] // user-facing index number w/ offset
+	return
+}
 
-			if instance, _, found := r.index(current); found {
+/*
+checksumValue returns the fingerprint of x, which resides at the indicated
+path. See [Stack.Checksum].
+*/
+func checksumValue(x any, path []int) (sum uint64, err error) {
+	if S, ok := stackTypeAliasConverter(x); ok && S.IsInit() {
+		return S.stack.checksum(path)
+	} else if C, cok := conditionTypeAliasConverter(x); cok && C.IsInit() {
+		return C.condition.checksum(path)
+	}
 
-				// Begin assertion of possible traversable and non-traversable
-				// values. We'll go as deep as possible, provided each nesting
-				// instance is a Stack/Stack alias, or Condition/Condition alias
-				// containing a Stack/Stack alias value.
-				if slice, ok, done = r.traverseAssertionHandler(instance, i, indices...); !done {
-					continue
-				}
-			}
-			break
+	h := fnv.New64a()
+	if x == nil {
+		checksumWrite(h, `nil`)
+	} else if meth := getStringer(x); meth != nil {
+		checksumWrite(h, sprintf("%T", x), meth())
+	} else {
+		switch reflect.TypeOf(x).Kind() {
+		case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+			err = errorf("slice %s: unhashable type %T", pathString(path), x)
+			return
 		}
+		checksumWrite(h, sprintf("%T", x), sprintf("%v", x))
 	}
+	sum = h.Sum64()
 
 	return
 }
 
 /*
-Reveal processes the receiver instance and disenvelops needlessly
-enveloped [Stack] slices.
+checksumWrite writes each of the uint64 or string values (parts) to h. A
+separator follows each string value, such that adjacent values cannot run
+together.
 */
-func (r Stack) Reveal() Stack {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			_ = r.stack.reveal()
+func checksumWrite(h hash.Hash64, parts ...any) {
+	for _, part := range parts {
+		switch tv := part.(type) {
+		case uint64:
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], tv)
+			h.Write(b[:])
+		case string:
+			h.Write(append([]byte(tv), 0))
 		}
 	}
-	return r
 }
 
 /*
 reveal is a private method called by [Stack.Reveal].
 */
 func (r *stack) reveal() (err error) {
+	if r.positive(ronly) {
+		return revealReadOnly(Stack{r})
+	}
+
 	r.lock()
 	defer r.unlock()
 
 	// scan each slice (except the config
 	// slice) and analyze its structure.
 	for i := 0; i < r.len() && err == nil; i++ {
-		if sl, _, _ := r.index(i); sl != nil { // cfg offset handled by index method, be honest
+		if sl := r.indexRaw(i); sl != nil { // cfg offset handled by indexRaw method, be honest
 			// If the element is a stack, begin descent
 			// through recursion.
 			if outer, ook := stackTypeAliasConverter(sl); ook && outer.Len() > 0 {
@@ -2058,12 +5851,20 @@ zero (0) of the provided inner stack.
 - Any other length (>1) results in a new top-level recursive call of the top-level
 stack.reveal private method, beginning the entire process anew at index zero(0) of
 the provided inner stack.
+
+[Not] stacks are handed off to stack.revealNot, as their negation must survive.
 */
 func (r *stack) revealDescend(inner Stack, idx int) (err error) {
 	var updated any
+	var negated bool
 
-	// TODO: Still mulling the best way to handle NOTs.
-	if inner.stackType() != not {
+	if inner.getState(ronly) {
+		return revealReadOnly(inner)
+	}
+
+	if negated = inner.stackType() == not; negated {
+		updated, err = inner.stack.revealNot()
+	} else {
 		switch inner.Len() {
 		case 1:
 			// descend into inner slice #0
@@ -2092,8 +5893,66 @@ func (r *stack) revealDescend(inner Stack, idx int) (err error) {
 		}
 
 		// Begin second pass-over before
-		// return.
-		err = inner.reveal()
+		// return. A collapsed double
+		// negation leaves nothing of
+		// inner behind to scan.
+		if negated && updated != nil {
+			err = r.revealSingle(idx)
+		} else {
+			err = inner.reveal()
+		}
+	}
+
+	return
+}
+
+/*
+revealReadOnly returns the error describing the read-only nested instance
+(x) encountered during reveal processing.
+*/
+func revealReadOnly(x any) error {
+	return wrapErr(ErrReadOnly, "nested %T is read-only; cannot reveal", x)
+}
+
+/*
+revealNot is a private method called by stack.revealDescend for receivers
+of the [Not] kind. A non-nil replacement value is returned if the receiver
+itself is to be replaced within its enclosing [Stack].
+
+- A single unparenthesized, symbol-free [Not] stack, itself containing only
+one (1) slice, shall be collapsed along with the receiver, as the double
+negation cancels out. The remaining slice is returned as the replacement.
+Note that, unlike all other reveal operations, this alters the string value
+(but not the meaning) of the receiver's enclosing [Stack].
+
+- A single unparenthesized [Stack] of any other kind, itself containing only
+one (1) slice, is replaced by said slice, leaving the negation intact.
+
+- Any other length (>1) results in a new top-level stack.reveal of the receiver,
+scanning its members like those of any other kind.
+*/
+func (r *stack) revealNot() (updated any, err error) {
+	if r.ulen() != 1 {
+		err = r.reveal()
+		return
+	}
+
+	child, _, _ := r.index(0)
+	if cs, ok := stackTypeAliasConverter(child); ok && cs.IsInit() &&
+		cs.Len() == 1 && !cs.IsParen() {
+		grand, _, _ := cs.index(0)
+		if cs.stackType() == not {
+			if !r.positive(parens) && len(r.getSymbol()) == 0 &&
+				len(cs.getSymbol()) == 0 {
+				updated = grand
+			}
+		} else {
+			r.replace(grand, 0)
+		}
+	}
+
+	if updated == nil {
+		err = r.revealSingle(0)
 	}
 
 	return
@@ -2120,7 +5979,9 @@ func (r *stack) revealSingle(idx int) (err error) {
 		// If a condition ...
 		if c, okc := conditionTypeAliasConverter(slice); okc {
 			// ... If condition expression is a stack ...
-			if inner, iok := stackTypeAliasConverter(c.Expression()); iok {
+			if inner, iok := c.ExpressionAsStack(); iok && c.getState(ronly) {
+				err = revealReadOnly(c)
+			} else if iok {
 				// ... recurse into said stack expression
 				if err = inner.reveal(); err == nil {
 					// update the condition w/ new value
@@ -2137,77 +5998,149 @@ func (r *stack) revealSingle(idx int) (err error) {
 }
 
 /*
-traverseAssertionHandler handles the type assertion processes during traversal of one (1) or more
-nested [Stack]/[Stack] alias instances that may or may not reside in [Condition]/[Condition] alias instances.
+NormalizeOption describes a single transformation to be applied by the
+[Stack.Normalize] method. Values may be specified individually, or OR'd
+together.
 */
-func (r stack) traverseAssertionHandler(x any, idx int, indices ...int) (slice any, ok, done bool) {
+type NormalizeOption uint8
 
-	if slice, ok, done = r.traverseStack(x, idx, indices...); ok {
-		// The value was a Stack or Stack type alias.
-	} else if slice, ok, done = r.traverseStackInCondition(x, idx, indices...); ok {
-		// The value was a Condition, and there MAY be a Stack
-		// or Stack type alias nested within said Condition ...
-	} else if len(indices) <= 1 {
-		// If we're at the end of the line, just return
-		// whatever is there.
-		slice = x
-		ok = true
-		done = true
-	} else {
-		// If we arrived here with more path elements left,
-		// it would appear the path was invalid, or ill-suited
-		// for this particular structure in the traversable
-		// sense. Don't return the last slice, don't declare
-		// done or ok since it (probably) isn't what they
-		// wanted ...
-		slice = nil
+const (
+	// MergeSameKind flattens AND-in-AND and OR-in-OR nesting
+	// into a single level.
+	MergeSameKind NormalizeOption = 1 << iota
+
+	// CollapseSingletons replaces a nested AND or OR instance
+	// containing only one (1) slice with that slice.
+	CollapseSingletons
+)
+
+/*
+Normalize canonicalizes the receiver instance -- as well as any nested [Stack]
+instances, including those found within [Condition] expressions -- according
+to the [NormalizeOption] values provided. If no options are provided, all of
+them are applied.
+
+Much like [Stack.Reveal], only transformations which would not alter the
+string representation of the receiver are performed. Nested instances which
+are parenthetical, read-only, governed by a [PresentationPolicy] or whose
+presentation settings (e.g.: symbol, encapsulation, etc.) differ from those
+of the enclosing instance are left as-is.
+
+This is a destructive method, and will not run upon read-only receivers.
+Nor will it run upon receivers which contain themselves, in which case an
+error wrapping [ErrCycle] is set within the receiver, accessible via
+[Stack.Err].
+*/
+func (r Stack) Normalize(opts ...NormalizeOption) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if err := r.stack.cycleErr(); err != nil {
+				r.stack.setErr(err)
+				return r
+			}
+
+			var opt NormalizeOption
+			for i := 0; i < len(opts); i++ {
+				opt |= opts[i]
+			}
+			if opt == 0 {
+				opt = MergeSameKind | CollapseSingletons
+			}
+			r.stack.normalize(opt)
+		}
 	}
 
-	return
+	return r
 }
 
 /*
-traverseStackInCondition is the private recursive helper method for the stack.traverse method. This
-method will traverse either a [Stack] *OR* [Stack] alias type fashioned by the user that is the [Condition]
-instance's own value (i.e.: recurse stacks that reside in conditions, etc ...).
+normalize is a private method called by [Stack.Normalize].
 */
-func (r stack) traverseStackInCondition(u any, idx int, indices ...int) (slice any, ok, done bool) {
+func (r *stack) normalize(opt NormalizeOption) {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
 
-	if c, cOK := conditionTypeAliasConverter(u); cOK {
-		// End of the line :)
-		if len(indices) <= 1 {
-			slice = c
-			ok = true
-			done = true
-		} else {
-			// We have leftovers. If the Condition's value is a
-			// Stack *OR* a Stack alias, traverse it ...
-			expr := c.Expression()
-			return r.traverseStack(expr, idx, indices...)
+	out := make([]any, 1, r.len())
+	out[0] = (*r)[0] // preserve config slice
+
+	// slices hoisted from nested instances
+	// inherit the metadata of the instance.
+	sc := r.metaConfig()
+	var met []SliceMeta
+	for i := 1; i < r.len(); i++ {
+		before := len(out)
+		out = r.normalizeSlice((*r)[i], opt, out, r.len()-i-1)
+		for j := before; j < len(out) && sc != nil && i-1 < len(sc.met); j++ {
+			met = append(met, sc.met[i-1])
 		}
 	}
 
-	return
+	*r = out
+	if sc != nil {
+		sc.met = met
+	}
 }
 
 /*
-traverseStack is the private recursive helper method for the stack.traverse method. This
-method will traverse either a [Stack] *OR* [Stack] alias type fashioned by the user.
+normalizeSlice is a private method called by stack.normalize. It appends
+the normalized form of slice to out, which is then returned. The rem input
+value describes the number of slices yet to be processed by the caller, so
+that any capacity constraints are honored.
 */
-func (r stack) traverseStack(u any, idx int, indices ...int) (slice any, ok, done bool) {
-	if s, sOK := stackTypeAliasConverter(u); sOK {
-		// End of the line :)
-		if len(indices) <= 1 {
-			slice = u
-			ok = sOK
-			done = true
-		} else {
-			// begin new Stack (tv/x) recursion beginning at the NEXT index ...
-			return s.stack.traverse(indices[1:]...)
+func (r stack) normalizeSlice(slice any, opt NormalizeOption, out []any, rem int) []any {
+	if c, ok := conditionTypeAliasConverter(slice); ok {
+		if sub, sok := c.ExpressionAsStack(); sok {
+			sub.Normalize(opt)
 		}
+		return append(out, slice)
 	}
 
-	return
+	sub, ok := stackTypeAliasConverter(slice)
+	if !ok || !sub.IsInit() || sub.getState(ronly) {
+		return append(out, slice)
+	}
+
+	sub.stack.normalize(opt)
+	if r.normalizable(sub.stack) {
+		kind, styp := r.stackType(), sub.stackType()
+		if styp == and || styp == or {
+			if opt&MergeSameKind != 0 && styp == kind &&
+				(r.cap() == 0 || len(out)+sub.ulen()+rem <= r.cap()) {
+				return append(out, (*sub.stack)[1:]...)
+			} else if opt&CollapseSingletons != 0 && sub.ulen() == 1 {
+				// the sole slice may itself be eligible
+				return r.normalizeSlice((*sub.stack)[1], opt, out, rem)
+			}
+		}
+	}
+
+	return append(out, slice)
+}
+
+/*
+normalizable returns a Boolean value indicative of whether the nested
+instance (sub) may be dissolved into the receiver without altering the
+string representation of the receiver.
+*/
+func (r stack) normalizable(sub *stack) bool {
+	rc, _ := r.config()
+	sc, _ := sub.config()
+
+	if sub.positive(parens) || sub.positive(lonce) || r.positive(lonce) ||
+		sc.rpf != nil || len(sc.enc) != len(rc.enc) {
+		return false
+	}
+
+	for i := 0; i < len(sc.enc); i++ {
+		if join(sc.enc[i], ``) != join(rc.enc[i], ``) {
+			return false
+		}
+	}
+
+	return sc.sym == rc.sym &&
+		sub.positive(cfold) == r.positive(cfold) &&
+		sub.positive(nspad) == r.positive(nspad)
 }
 
 /*
@@ -2217,24 +6150,27 @@ not removed from the receiver instance.
 
 In LIFO mode (the default), this returns the right-most slice. In FIFO mode,
 this returns the left-most slice, and is analogous to the concept of "top"
-in other queue implementations.
+in other queue implementations. In priority mode, this returns the least
+slice (see [Stack.SetPriority]). In any mode, this is the slice which would
+be removed by a subsequent call of [Stack.Pop] (see [Stack.Peek]).
+
+As with [Stack.Pop], nil slices are not skipped: if the front slice is nil,
+nil is returned alongside a Boolean value of false. Use [Stack.Defrag] to
+remove nil slices, or [Stack.FindFirst] to locate the first non-nil slice.
 */
 func (r Stack) Front() (slice any, ok bool) {
 	if r.IsInit() {
-
-		if r.IsFIFO() {
-			for i := 0; i < r.Len(); i++ {
-				if slice, ok = r.Index(i); ok {
-					break
-				}
-			}
-			return
-		}
-
-		for i := r.Len(); i > 0; i-- {
-			if slice, ok = r.Index(i - 1); ok {
-				break
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		view, _ := r.stack.live()
+		if sc, _ := r.config(); sc.pri {
+			if view.ulen() > 0 {
+				slice, _ = view.peekNext()
+				ok = slice != nil
 			}
+		} else {
+			slice, ok = view.end(r.stack.isFIFO())
 		}
 	}
 
@@ -2248,22 +6184,103 @@ not removed from the receiver instance.
 
 In LIFO mode (the default), this returns the left-most slice. In FIFO mode,
 this returns the right-most slice.
+
+As with [Stack.Front], nil slices are not skipped.
 */
 func (r Stack) Back() (slice any, ok bool) {
 	if r.IsInit() {
-		if !r.IsFIFO() {
-			for i := 0; i < r.Len(); i++ {
-				if slice, ok = r.Index(i); ok {
-					break
-				}
-			}
-			return
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		view, _ := r.stack.live()
+		slice, ok = view.end(!r.stack.isFIFO())
+	}
+
+	return
+}
+
+/*
+Peek returns the slice which would be removed by a subsequent call of
+[Stack.Pop], alongside a Boolean value indicative of success. The slice
+is not removed from the receiver instance.
+
+Peek is equivalent to [Stack.Front], and shares its nil slice semantics.
+*/
+func (r Stack) Peek() (slice any, ok bool) {
+	return r.Front()
+}
+
+/*
+end is a private method called by [Stack.Front] and [Stack.Back]. It
+returns the left-most user slice if left is true, else the right-most
+user slice, alongside a Boolean value indicative of a non-nil slice.
+*/
+func (r stack) end(left bool) (slice any, ok bool) {
+	if L := r.ulen(); L > 0 {
+		idx := L
+		if left {
+			idx = 1
 		}
+		slice = r[idx]
+		ok = slice != nil
+	}
 
-		for i := r.Len(); i > 0; i-- {
-			if slice, ok = r.Index(i - 1); ok {
-				break
-			}
+	return
+}
+
+/*
+FindFirst returns the first slice, in logical order, which satisfies the
+input match closure, alongside its index and a Boolean value indicative
+of success. Logical order begins at the "front" of the receiver, as with
+[Stack.Front]. A nil match closure matches any non-nil slice, thus making
+FindFirst(nil) equivalent to [Stack.Front] with the index included, except
+that nil slices are skipped rather than returned.
+
+The receiver is not modified. If no slice matches, or if the receiver is
+uninitialized, nil, -1 and false are returned.
+*/
+func (r Stack) FindFirst(match func(any) bool) (slice any, idx int, ok bool) {
+	return r.find(match, true)
+}
+
+/*
+FindLast returns the last slice, in logical order, which satisfies the
+input match closure, alongside its index and a Boolean value indicative
+of success. This is the first matching slice found when scanning from
+the "rear" of the receiver, as with [Stack.Back]. A nil match closure
+matches any non-nil slice.
+
+The receiver is not modified. If no slice matches, or if the receiver is
+uninitialized, nil, -1 and false are returned.
+*/
+func (r Stack) FindLast(match func(any) bool) (slice any, idx int, ok bool) {
+	return r.find(match, false)
+}
+
+/*
+find is a private method called by [Stack.FindFirst] and [Stack.FindLast].
+*/
+func (r Stack) find(match func(any) bool, front bool) (slice any, idx int, ok bool) {
+	idx = -1
+	if !r.IsInit() {
+		return
+	}
+
+	r.stack.lock()
+	defer r.stack.unlock()
+
+	// LIFO fronts are on the right, while
+	// FIFO fronts are on the left.
+	L := r.stack.ulen()
+	ltr := front == r.stack.isFIFO()
+	for i := 0; i < L && !ok; i++ {
+		j := i
+		if !ltr {
+			j = L - i - 1
+		}
+
+		if x := (*r.stack)[j+1]; x != nil && (match == nil || match(x)) {
+			slice, idx, ok = x, j, true
 		}
 	}
 
@@ -2298,11 +6315,49 @@ length returns the intended slice along with Boolean value of true.
 */
 func (r Stack) Index(idx int) (slice any, ok bool) {
 	if r.IsInit() {
-		slice, _, ok = r.stack.index(idx)
+		view, _ := r.stack.live()
+		slice, _, ok = view.index(idx)
+	}
+	return
+}
+
+/*
+At returns the Nth slice within the receiver, or the optional default
+(def) value if no non-nil slice could be found at the requested index.
+If no default value is specified, nil is returned in such cases.
+
+This is a convenience method only; index handling -- including support
+for negative and forward indices -- is identical to [Stack.Index].
+*/
+func (r Stack) At(idx int, def ...any) (slice any) {
+	var ok bool
+	if slice, ok = r.Index(idx); !ok {
+		slice = nil
+		if len(def) > 0 {
+			slice = def[0]
+		}
 	}
 	return
 }
 
+/*
+MustIndex returns the Nth slice within the receiver. A panic occurs if
+no non-nil slice could be found at the requested index. The panic message
+identifies the index requested, as well as the ID and length of the
+receiver.
+
+This is a convenience method only, intended mainly for test code. Index
+handling is identical to [Stack.Index].
+*/
+func (r Stack) MustIndex(idx int) any {
+	slice, ok := r.Index(idx)
+	if !ok {
+		panic(sprintf("stackage: no slice at index %d in stack '%s' (len %d)",
+			idx, r.ID(), r.Len()))
+	}
+	return slice
+}
+
 /*
 index is a private method called by [Stack.Index].
 */
@@ -2334,6 +6389,20 @@ func (r stack) index(i int) (slice any, idx int, ok bool) {
 	return
 }
 
+/*
+indexRaw returns the Nth user slice of the receiver, or nil if i is out
+of bounds. Unlike stack.index, negative and forward index support are not
+consulted, thus no configuration lookups occur. This is intended for the
+internal iteration of plain, in-range indices.
+*/
+func (r stack) indexRaw(i int) (slice any) {
+	if i >= 0 && i+1 < len(r) {
+		slice = r[i+1]
+	}
+
+	return
+}
+
 /*
 factorNegIndex is run when negative index support
 is enabled and a negative index is encountered.
@@ -2359,25 +6428,37 @@ receiver configuration. This will only encapsulate the
 outside of the stack, not its value(s).
 */
 func (r stack) paren(v string) string {
-	var pad string = string(rune(32))
-	if sc, _ := r.config(); sc.positive(nspad) {
-		pad = ``
-	}
+	open, clos := r.parenChars()
+	return open + v + clos
+}
 
+/*
+parenChars returns the L and R parenthetical values, including any
+padding, to be used for the receiver during string representation.
+Zero strings are returned if the receiver is not parenthetical.
+*/
+func (r stack) parenChars() (open, clos string) {
 	if r.positive(parens) && r.stackType() != basic {
-		return `(` + pad + v + pad + `)`
+		pad := r.parenPad()
+		open = `(` + pad
+		clos = pad + `)`
 	}
-	return v
+
+	return
 }
 
 /*
-encapv may (or may not) apply character encapsulation to the
-input/return value, depending on the underlying receiver cfg.
+parenPad returns the padding to be applied inside the parentheses of
+the receiver, whether or not it is parenthetical.
 */
-func (r stack) encapv(v string) (e string) {
-	if r.stackType() != basic {
-		e = encapValue(r.getEncap(), v)
+func (r stack) parenPad() (pad string) {
+	pad = string(rune(32))
+	if sc, _ := r.config(); sc.pst != nil {
+		pad = padIf(*sc.pst&PadInsideParens != 0)
+	} else if sc.positive(nspad) {
+		pad = ``
 	}
+
 	return
 }
 
@@ -2410,6 +6491,7 @@ ordering mode in effect:
 
   - In the default mode -- LIFO -- this shall be the final slice (index [Stack.Len] - 1", or the "far right" element)
   - In the alternative mode -- FIFO -- this shall be the first slice (index 0, or the "far left" element)
+  - In priority mode, this shall be the least slice (see [Stack.SetPriority])
 
 Note that if the receiver is in an invalid state, or has a zero length,
 nothing will be removed.
@@ -2417,35 +6499,268 @@ nothing will be removed.
 func (r Stack) Pop() (popped any, ok bool) {
 	if !r.IsEmpty() {
 		if !r.getState(ronly) {
-			popped, ok = r.stack.pop()
+			before := r.stack.called(`pop`, 0)
+			defer r.stack.ended()
+			var idx int
+			if popped, idx, ok = r.stack.pop(); idx >= 0 {
+				r.stack.changed(`pop`, idx, popped)
+			}
+			r.stack.settled(`pop`, before)
+		}
+	}
+	return
+}
+
+/*
+PushWait behaves similarly to [Stack.Push], except that it blocks while
+the receiver is full, resuming as capacity becomes available (e.g.: due
+to [Stack.Pop] or [Stack.PopWait] calls by other goroutines). Values are
+appended in order, one at a time.
+
+An error is returned if the context (ctx) is done before all values were
+appended, or if a value is refused by the [PushPolicy] in effect. The
+receiver must be locking-enabled (see [Stack.SetMutex]) and capacity
+limited; otherwise, an error is returned immediately.
+*/
+func (r Stack) PushWait(ctx context.Context, y ...any) (err error) {
+	var sc *nodeConfig
+	if sc, err = r.waitable(true); err != nil {
+		return
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		sc.mtx.Lock()
+		sc.cnd.Broadcast()
+		sc.mtx.Unlock()
+	})
+	defer stop()
+
+	for i := 0; i < len(y) && err == nil; i++ {
+		r.stack.lock()
+		for r.stack.isFull() && ctx.Err() == nil {
+			sc.cnd.Wait()
+		}
+
+		before := r.stack.ulen()
+		var pushed []any
+		if err = ctx.Err(); err == nil {
+			if r.stack.appendPolicy(``, y[i]); r.stack.ulen() == before {
+				err = errorf("value %d refused: %v", i, sc.getErr())
+			}
+			pushed = append(pushed, (*r.stack)[before+1:]...)
+		}
+		r.stack.unlock()
+
+		for j := 0; j < len(pushed); j++ {
+			sc.changed(`push`, before+j, pushed[j])
+		}
+	}
+
+	return
+}
+
+/*
+PopWait behaves similarly to [Stack.Pop], except that it blocks while the
+receiver is empty, resuming once a slice becomes available (e.g.: due to
+[Stack.Push] or [Stack.PushWait] calls by other goroutines).
+
+An error is returned if the context (ctx) is done before a slice could
+be removed. The receiver must be locking-enabled (see [Stack.SetMutex]);
+otherwise, an error is returned immediately.
+*/
+func (r Stack) PopWait(ctx context.Context) (popped any, err error) {
+	var sc *nodeConfig
+	if sc, err = r.waitable(false); err != nil {
+		return
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		sc.mtx.Lock()
+		sc.cnd.Broadcast()
+		sc.mtx.Unlock()
+	})
+	defer stop()
+
+	r.stack.lock()
+	for r.stack.ulen() == 0 && ctx.Err() == nil {
+		sc.cnd.Wait()
+	}
+
+	var idx int = -1
+	if err = ctx.Err(); err == nil {
+		popped, idx = r.stack.peekNext()
+		r.stack.popNext(idx)
+	}
+	r.stack.unlock()
+
+	if idx != -1 {
+		sc.changed(`pop`, idx, popped)
+	}
+
+	return
+}
+
+/*
+waitable returns the configuration of the receiver, or an error if the
+receiver is unsuitable for use with [Stack.PushWait] (push) or [Stack.PopWait].
+Aside from initialization, the receiver is examined under lock, as other
+goroutines may be appending to it.
+*/
+func (r Stack) waitable(push bool) (sc *nodeConfig, err error) {
+	if sc = r.stack.lockConfig(); sc == nil {
+		err = wrapErr(ErrNotInitialized, "stack instance is nil")
+		return
+	}
+
+	r.stack.lock()
+	defer r.stack.unlock()
+
+	if sc.positive(ronly) {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot wait", r)
+	} else if sc.cnd == nil {
+		err = errorf("%T is not locking-enabled; see Stack.SetMutex", r)
+	} else if push && r.stack.cap() == 0 {
+		err = errorf("%T is not capacity-limited; use Stack.Push", r)
+	}
+
+	return
+}
+
+/*
+PopIf removes and returns the slice that would be returned by [Stack.Pop]
+if, and only if, it satisfies the input match closure. The inspection and
+removal are conducted under a single lock, provided that locking is enabled
+for the receiver. See [Stack.SetMutex]. A nil match closure matches any
+slice.
+
+A Boolean value is returned alongside, indicative of whether a slice was
+removed. If the receiver is read-only, nothing is removed and an error is
+set within the receiver.
+*/
+func (r Stack) PopIf(match func(any) bool) (popped any, ok bool) {
+	if p := r.PopWhile(match, 1); len(p) > 0 {
+		popped, ok = p[0], true
+	}
+	return
+}
+
+/*
+PopWhile repeatedly removes and returns the slice that would be returned
+by [Stack.Pop] for as long as it satisfies the input match closure, or
+until the optional maximum number of slices (max) have been removed.
+The removed slices are returned in the order in which they were removed.
+
+All slices are removed under a single lock, provided that locking is
+enabled for the receiver. See [Stack.SetMutex]. A nil match closure
+matches any slice. If the receiver is read-only, nothing is removed and
+an error is set within the receiver.
+*/
+func (r Stack) PopWhile(match func(any) bool, max ...int) (popped []any) {
+	sc := r.stack.lockConfig()
+	if sc == nil {
+		return
+	}
+
+	limit := -1
+	if len(max) > 0 && max[0] > 0 {
+		limit = max[0]
+	}
+
+	var idxs []int
+	popped, idxs = r.stack.popWhile(match, limit)
+	for i := 0; i < len(popped); i++ {
+		sc.changed(`pop`, idxs[i], popped[i])
+	}
+
+	return
+}
+
+/*
+popWhile is a private method called by [Stack.PopWhile]. A negative
+limit indicates no limit. The user index from which each slice was
+removed is returned alongside the slices.
+
+The read-only state of the receiver is checked under the same lock as
+the removals, thus the receiver need not be read outside of it.
+*/
+func (r *stack) popWhile(match func(any) bool, limit int) (popped []any, idxs []int) {
+	r.lock()
+	defer r.unlock()
+
+	if r.positive(ronly) {
+		r.setErr(wrapErr(ErrReadOnly, "%T is read-only; cannot pop", Stack{r}))
+		return
+	}
+
+	for limit != 0 && r.ulen() > 0 {
+		slice, idx := r.peekNext()
+		if match != nil && !match(slice) {
+			break
 		}
+
+		r.popNext(idx)
+		popped = append(popped, slice)
+		idxs = append(idxs, idx)
+		limit--
 	}
+
 	return
 }
 
 /*
-pop is a private method called by [Stack.Pop].
+pop is a private method called by [Stack.Pop]. The user index from
+which the slice was removed is returned alongside it, or -1 if nothing
+was removed. A nil slice is removed, but is not deemed ok.
 */
-func (r *stack) pop() (slice any, ok bool) {
+func (r *stack) pop() (slice any, idx int, ok bool) {
 
 	r.lock()
 	defer r.unlock()
 
-	var idx int
+	// expired slices are passed over, but not removed
+	idx = -1
+	if view, idxs := r.live(); view.ulen() > 0 {
+		slice, idx = view.peekNext()
+		if idxs != nil {
+			idx = idxs[idx]
+		}
+		r.popNext(idx)
+		ok = slice != nil
+	}
 
-	if r.isFIFO() {
-		idx = 1
-		slice = (*r)[idx]
-		pres := (*r)[idx+1:]
-		(*r) = (*r)[:idx]
-		*r = append(*r, pres...)
-	} else {
-		idx = len(*r) - 1
-		slice = (*r)[idx]
-		*r = (*r)[:idx]
+	return
+}
+
+/*
+peekNext returns the slice, and its user index, that would be removed by
+a subsequent call of popNext per the ordering scheme in effect. The receiver
+must not be empty.
+*/
+func (r stack) peekNext() (slice any, idx int) {
+	if sc, _ := r.config(); sc.pri {
+		S := Stack{&r}
+		for i := 1; i < r.ulen(); i++ {
+			if S.Less(i, idx) {
+				idx = i
+			}
+		}
+	} else if !r.isFIFO() {
+		idx = r.ulen() - 1
 	}
+	slice = r[idx+1]
+
+	return
+}
 
-	ok = slice != nil
+/*
+popNext removes and returns the slice at user index idx, as returned by
+stack.peekNext. The caller must hold the lock.
+*/
+func (r *stack) popNext(idx int) (slice any) {
+	defer r.touch()
+	slice = (*r)[idx+1]
+	*r = append((*r)[:idx+1], (*r)[idx+2:]...)
+	r.metaRemove(idx)
 
 	return
 }
@@ -2459,9 +6774,119 @@ has been set and reached, each of the values intended for append shall
 be ignored.
 */
 func (r Stack) Push(y ...any) Stack {
+	if sc := r.stack.lockConfig(); sc != nil {
+		if !sc.positive(ronly) {
+			sc.logCall(`push`, len(y))
+			defer sc.endCall()
+			before, pushed := r.stack.pushLabeled(``, y...)
+			for i := 0; i < len(pushed); i++ {
+				sc.changed(`push`, before+i, pushed[i])
+			}
+			sc.logLen(`push`, before, before+len(pushed))
+		}
+	}
+	return r
+}
+
+/*
+PushCond constructs a [Condition] using the provided keyword (kw), [Operator]
+(op) and expression (ex) values, as with [Cond], and appends it to the
+receiver in the same manner as [Stack.Push]. The receiver is returned in
+fluent form, allowing calls to be chained:
+
+	And().PushCond(`objectClass`, Eq, `person`).PushCond(`uid`, Eq, `jc`)
+
+If the resultant [Condition] is invalid, it is not appended, and its error
+is set within the receiver (see [Stack.Err]). Any [PushPolicy], capacity
+constraint or other push rule in effect within the receiver applies.
+*/
+func (r Stack) PushCond(kw any, op Operator, ex any) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if c := Cond(kw, op, ex); c.Err() != nil {
+				r.stack.setErr(errorf("invalid condition: %v", c.Err()))
+			} else {
+				r.Push(c)
+			}
+		}
+	}
+	return r
+}
+
+/*
+PushStack constructs a [Stack] of the specified [StackKind] containing the
+provided members, as with [NewStack] and [Stack.Push], and appends it to the
+receiver in the same manner as [Stack.Push]. The receiver is returned in
+fluent form.
+
+If kind is invalid, nothing is appended, and an error is set within the
+receiver. Any [PushPolicy], capacity constraint or nesting restriction in
+effect within the receiver applies.
+*/
+func (r Stack) PushStack(kind StackKind, members ...any) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			if S := NewStack(kind); !S.IsInit() {
+				r.stack.setErr(errorf("invalid stack kind '%s'", kind))
+			} else {
+				r.Push(S.Push(members...))
+			}
+		}
+	}
+	return r
+}
+
+/*
+PushFlatten appends the provided value(s) to the receiver in the same
+manner as [Stack.Push], except that each [Stack] or [Stack]-alias value
+is not itself appended; rather, its slices are appended individually, in
+order. Each such slice is subject to the same [PushPolicy], capacity and
+nesting checks as any other pushed value.
+
+Only the input values are flattened. Nested [Stack] instances found within
+them, including the [Stack] expressions of [Condition] instances, are not.
+
+If capacity is exhausted partway through, the remaining values are ignored
+and an error identifying the number of elements appended is set within the
+receiver, which wraps [ErrCapacityViolation].
+*/
+func (r Stack) PushFlatten(y ...any) Stack {
 	if r.IsInit() {
 		if !r.getState(ronly) {
-			r.stack.push(y...)
+			var flat []any
+			for i := 0; i < len(y); i++ {
+				if S, ok := stackTypeAliasConverter(y[i]); ok && S.IsInit() {
+					flat = append(flat, (*S.stack)[1:]...)
+				} else {
+					flat = append(flat, y[i])
+				}
+			}
+
+			before := r.ulen()
+			r.stack.push(flat...)
+			if added := r.ulen() - before; added < len(flat) && r.stack.isFull() {
+				r.stack.setErr(wrapErr(ErrCapacityViolation,
+					"failed: capacity violation; %d of %d elements appended",
+					added, len(flat)))
+			}
+			r.stack.changedFrom(`push`, before)
+		}
+	}
+	return r
+}
+
+/*
+PushLabeled behaves identically to [Stack.Push], except that the provided
+label is recorded as the provenance of each value appended, provided that
+metadata tracking is enabled. See [Stack.SetTrackMetadata].
+*/
+func (r Stack) PushLabeled(label string, y ...any) Stack {
+	if sc := r.stack.lockConfig(); sc != nil {
+		if !sc.positive(ronly) {
+			before, pushed := r.stack.pushLabeled(label, y...)
+			for i := 0; i < len(pushed); i++ {
+				sc.changed(`push`, before+i, pushed[i])
+			}
 		}
 	}
 	return r
@@ -2471,21 +6896,51 @@ func (r Stack) Push(y ...any) Stack {
 push is a private method called by [Stack.Push].
 */
 func (r *stack) push(x ...any) {
+	r.pushLabeled(``, x...)
+}
+
+/*
+pushLabeled is a private method called by stack.push and [Stack.PushLabeled].
+The user length of the receiver prior to the append (before), and the slices
+actually appended, are read under the lock and returned.
+*/
+func (r *stack) pushLabeled(label string, x ...any) (before int, pushed []any) {
 
 	r.lock()
 	defer r.unlock()
 
+	before = r.ulen()
+	r.appendPolicy(label, x...)
+	pushed = append(pushed, (*r)[before+1:]...)
+
+	return
+}
+
+/*
+appendPolicy appends x to the receiver per the push policy in effect, if
+any. The caller must hold the lock.
+*/
+func (r *stack) appendPolicy(label string, x ...any) {
+	x = r.screenPush(x)
+
 	// try to see if the user provided a
 	// push verification function
 	if meth := r.getPushPolicy(); meth != nil {
 		// use the user-provided function to scan
 		// each pushed item for verification.
-		r.methodAppend(meth, x...)
+		r.methodAppend(meth, label, x...)
+		return
+	}
+
+	// try to see if the user provided a
+	// context-aware push verification function
+	if sc, _ := r.config(); sc.ppc != nil {
+		r.contextAppend(sc.ppc, label, x...)
 		return
 	}
 
 	// no push policy was found, just do it.
-	r.genericAppend(x...)
+	r.genericAppend(label, x...)
 
 	return
 }
@@ -2496,7 +6951,7 @@ of the original.
 */
 func (r Stack) Reverse() Stack {
 	if !r.IsEmpty() {
-		if !r.getState(ronly) {
+		if !r.getState(ronly) && !r.orderLocked(`reverse`) {
 			r.stack.reverse()
 		}
 	}
@@ -2510,10 +6965,94 @@ func (r *stack) reverse() {
 
 	r.lock()
 	defer r.unlock()
+	defer r.touch()
 
 	for i, j := 1, r.len()-1; i < j; i, j = i+1, j-1 {
 		(*r)[i], (*r)[j] = (*r)[j], (*r)[i]
 	}
+	r.metaReverse()
+}
+
+/*
+Shuffle re-orders the receiver's current slices randomly, using the
+Fisher-Yates algorithm. The optional [rand.Source] is used to produce
+the random sequence, thereby allowing deterministic results. If unset,
+the default source of the math/rand package is used.
+
+Read-only and order-locked receivers are not modified, in which case an
+error is set within the receiver.
+
+This method is not suitable for cryptographic purposes.
+*/
+func (r Stack) Shuffle(src ...rand.Source) Stack {
+	if r.IsInit() {
+		if r.getState(ronly) {
+			r.setErr(wrapErr(ErrReadOnly, "%T is read-only; cannot shuffle", r))
+		} else if !r.orderLocked(`shuffle`) {
+			r.stack.shuffle(randIntn(src))
+		}
+	}
+	return r
+}
+
+/*
+shuffle is a private method called by [Stack.Shuffle]. The intn closure
+supplies the random sequence.
+*/
+func (r *stack) shuffle(intn func(int) int) {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
+
+	for i := r.ulen() - 1; i > 0; i-- {
+		j := intn(i + 1)
+		(*r)[i+1], (*r)[j+1] = (*r)[j+1], (*r)[i+1]
+		r.metaSwap(i, j)
+	}
+}
+
+/*
+Sample returns a new [Stack] containing n distinct slices of the receiver,
+chosen at random and presented in random order. If n exceeds the number of
+slices present, all slices are returned. The optional [rand.Source] is used
+as described by [Stack.Shuffle].
+
+The new instance inherits the kind, ordering and presentation configuration
+of the receiver, but not its capacity. The receiver is not modified, and
+slices are referenced rather than copied.
+
+An uninitialized receiver results in an uninitialized [Stack].
+*/
+func (r Stack) Sample(n int, src ...rand.Source) (S Stack) {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		S = Stack{r.stack.sample(n, randIntn(src))}
+	}
+	return
+}
+
+/*
+sample is a private method called by [Stack.Sample]. A partial Fisher-Yates
+shuffle of the user slice indices is conducted, stopping once n indices have
+been drawn.
+*/
+func (r *stack) sample(n int, intn func(int) int) *stack {
+	sib := r.sibling()
+
+	idx := make([]int, r.ulen())
+	for i := 0; i < len(idx); i++ {
+		idx[i] = i + 1
+	}
+
+	for i := 0; i < n && i < len(idx); i++ {
+		j := i + intn(len(idx)-i)
+		idx[i], idx[j] = idx[j], idx[i]
+		*sib = append(*sib, (*r)[idx[i]])
+	}
+
+	return sib
 }
 
 /*
@@ -2533,52 +7072,167 @@ nil instances specified as slices. This may also happen if the values were point
 have since been annihilated through some external means. This method exists for these reasons, as well
 as other corner-cases currently inconceivable.
 
-The max integer, which defaults to fifty (50) when unset, shall result in the scan being terminated
-when the number of nil slices encountered consecutively reaches the maximum. This is to prevent the
-process from looping into eternity.
+The max integer, which defaults to fifty (50) when unset, shall result in the scan being terminated
+when the number of nil slices encountered consecutively reaches the maximum, in which case the remaining
+slices are retained as-is. The order of all non-nil slices is always preserved.
+
+If run on a [Stack] or [Stack] type-alias that is currently in possession of one (1) or more nested [Stack]
+or [Stack] type-alias instances, Defrag shall hierarchically traverse the structure and process it no
+differently than the top-level instance. This applies to such Stack values nested with an instance of
+[Condition] or [Condition] type-alias as well, in which case the processed instance is written back to
+the [Condition] expression as a native [Stack], thereby substituting any [Stack] type-alias originally
+assigned. A read-only [Condition] retains its original expression value.
+
+This is potentially a destructive method and is still very much considered EXPERIMENTAL. While all
+tests yield expected results, those who use this method are advised to exercise extreme caution. The
+most obvious note of caution pertains to the volatility of index numbers, which shall shift according
+to the defragmentation's influence on the instance in question.  By necessity, [Stack.Len] return
+values shall also change accordingly.
+
+See also the [Stack.DefragReport] method, which returns statistics describing
+the changes made alongside any error encountered.
+*/
+func (r Stack) Defrag(max ...int) Stack {
+	_, _ = r.DefragReport(max...)
+	return r
+}
+
+/*
+DefragStats contains the results of a defragmentation pass executed by
+way of the [Stack.DefragReport] method.
+*/
+type DefragStats struct {
+	// RemovedCount is the total number of nil slices removed
+	// from the receiver and all nested instances processed.
+	RemovedCount int
+
+	// NestedProcessed is the number of nested [Stack] (and
+	// [Stack] alias) instances processed, including those
+	// found within [Condition] expressions.
+	NestedProcessed int
+
+	// Truncated is true if any processed instance still
+	// contains nil slices after the pass, such as when the
+	// consecutive nil maximum was reached. The operation
+	// may be re-run using a larger maximum.
+	Truncated bool
+
+	// Details contains per-instance statistics for each
+	// nested instance processed.
+	Details []DefragDetail
+}
+
+/*
+DefragDetail contains the defragmentation results for a single nested
+[Stack] instance processed by [Stack.DefragReport].
+*/
+type DefragDetail struct {
+	// Path is the index path of the nested instance relative
+	// to the receiver, suitable for use with [Stack.Traverse].
+	Path []int
+
+	// Removed is the number of nil slices removed from the
+	// nested instance.
+	Removed int
+
+	// Truncated is true if the nested instance still contains
+	// nil slices after the pass.
+	Truncated bool
+}
+
+/*
+DefragReport performs the same operation as [Stack.Defrag], returning an
+instance of [DefragStats] describing what changed alongside the first error
+encountered during the process, if any.
+*/
+func (r Stack) DefragReport(max ...int) (stats DefragStats, err error) {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			before := r.stack.called(`defrag`, len(max))
+			defer r.stack.ended()
+			if err = r.stack.cycleErr(); err != nil {
+				r.stack.setErr(err)
+				return
+			}
+
+			// to break defrag loop.
+			m := calculateDefragMax(max...)
+			nils := r.nils()
+			err = r.stack.defragReport(m, nil, &stats)
+			if before != r.ulen() || nils != r.nils() {
+				r.stack.changed(`defrag`, -1, nil)
+			}
+			r.stack.settled(`defrag`, before)
+		}
+	}
+
+	return
+}
+
+/*
+defragReport is a private method called by [Stack.DefragReport]. It
+defragments the receiver and, recursively, any nested [Stack] instances
+(or [Stack] instances found within [Condition] expressions), recording
+the results within stats.
+*/
+func (r *stack) defragReport(max int, path []int, stats *DefragStats) (err error) {
+	before := r.nils()
+	err = r.defrag(max) // defrag the stack itself
+
+	after := r.nils()
+	removed := before - after
+	truncated := after > 0
+	stats.RemovedCount += removed
+	stats.Truncated = stats.Truncated || truncated
+	if len(path) > 0 {
+		stats.NestedProcessed++
+		stats.Details = append(stats.Details, DefragDetail{
+			Path:      path,
+			Removed:   removed,
+			Truncated: truncated,
+		})
+	}
+
+	// Recurse through the stack, and defrag any other suitable
+	// candidates for the operation. Targets are any Stack or
+	// Condition instances, OR their aliased equivalents.
+	for i := 0; i < r.ulen(); i++ {
+		slice := r.indexRaw(i)
+		sub, ok := stackTypeAliasConverter(slice)
+		var cub Condition
+		if !ok {
+			if cub, ok = conditionTypeAliasConverter(slice); ok {
+				// Condition expression contains a Stack/Stack alias
+				sub, ok = cub.ExpressionAsStack()
+			}
+		}
+
+		if ok && sub.IsInit() && !sub.getState(ronly) {
+			subPath := append(append([]int{}, path...), i)
+			if serr := sub.stack.defragReport(max, subPath, stats); err == nil {
+				err = serr
+			}
 
-If run on a [Stack] or [Stack] type-alias that is currently in possession of one (1) or more nested [Stack]
-or [Stack] type-alias instances, Defrag shall hierarchically traverse the structure and process it no
-differently than the top-level instance. This applies to such Stack values nested with an instance of
-[Condition] or [Condition] type-alias as well.
+			if cub.IsInit() {
+				cub.condition.defragged(sub)
+			}
+		}
+	}
 
-This is potentially a destructive method and is still very much considered EXPERIMENTAL. While all
-tests yield expected results, those who use this method are advised to exercise extreme caution. The
-most obvious note of caution pertains to the volatility of index numbers, which shall shift according
-to the defragmentation's influence on the instance in question.  By necessity, [Stack.Len] return
-values shall also change accordingly.
-*/
-func (r Stack) Defrag(max ...int) Stack {
-	if r.IsInit() {
-		if !r.getState(ronly) {
-			// to break defrag loop.
-			m := calculateDefragMax(max...)
+	return
+}
 
-			r.stack.defrag(m) // defrag the stack itself
-
-			// If the receiver instance is judged as nesting, we'll
-			// recurse through stack, and defrag any other suitable
-			// candidates for the operation. Targets are any Stack
-			// or Condition instances, OR their aliased equivalents.
-			if r.IsNesting() {
-				for i := 0; i < r.Len(); i++ {
-					slice, _ := r.Index(i)
-					if sub, ok := stackTypeAliasConverter(slice); ok {
-						// Instance is Stack/Stack alias
-						sub.Defrag(m)
-					} else if cub, ok := conditionTypeAliasConverter(slice); ok {
-						// Instance is Condition/Condition alias
-						if sub, ok := stackTypeAliasConverter(cub.Expression()); ok {
-							// Condition expression contains a Stack/Stack alias
-							sub.Defrag(m)
-						}
-					}
-				}
-			}
+/*
+nils returns the number of nil user slices present within the receiver.
+*/
+func (r stack) nils() (ct int) {
+	for i := 1; i < r.len(); i++ {
+		if r[i] == nil {
+			ct++
 		}
 	}
 
-	return r
+	return
 }
 
 /*
@@ -2605,7 +7259,7 @@ Please use sparingly.
 */
 func (r Stack) IsEqual(o any) error {
 	if !r.IsInit() {
-		return errorf("Not initialized")
+		return wrapErr(ErrNotInitialized, "Not initialized")
 	}
 
 	// handle stack/stack-alias assertion and
@@ -2619,11 +7273,16 @@ func (r Stack) IsEqual(o any) error {
 		}
 
 		// use default assertion with the converted
-		// instance.
-		return r.stack.isEqual(s.stack)
+		// instance, refusing cyclical structures.
+		if err := r.stack.cycleErr(); err != nil {
+			return err
+		} else if err = s.stack.cycleErr(); err != nil {
+			return err
+		}
+		return r.stack.isEqual(s.stack, false)
 	}
 
-	return errorf("Cannot perform equality assertion; bad input")
+	return wrapErr(ErrEqualityMismatch, "Cannot perform equality assertion; bad input")
 }
 
 /*
@@ -2632,7 +7291,9 @@ It calls the top-level valuesEqual function, meant to compare two values,
 which in turn calls any number of type-specific equality functions based
 on the content encountered.
 */
-func (r *stack) isEqual(o *stack) (err error) {
+func (r *stack) isEqual(o *stack, fold bool) (err error) {
+	fold = fold || r.positive(kfold)
+
 	// Before we bother to run functions,
 	// lets see if the two instances are
 	// actually the same pointer.
@@ -2640,24 +7301,104 @@ func (r *stack) isEqual(o *stack) (err error) {
 		return nil
 	}
 
+	// Comments are disregarded
+	r, o = r.uncommented(), o.uncommented()
+
 	// compare len/cap of stacks
 	if !capLenEqual(r.cap(), o.cap(), r.len(), o.len()) {
-		err = errorf("Capacity or length mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Capacity or length mismatch")
 		return
 	}
 
 	// Compare the kinds of stacks
 	if r.kind() != o.kind() {
-		err = errorf("Stack kind mismatch")
+		err = wrapErr(ErrEqualityMismatch, "Stack kind mismatch")
 		return
 	}
 
+	switch r.stackType() {
+	case and, or, list:
+		if r.positive(ueqty) {
+			err = r.isEqualUnordered(o, fold)
+			return
+		}
+	}
+
 	// iterate each slice and compare using
 	// the generic valuesEqual function ...
 	for i := 0; i < r.ulen() && err == nil; i++ {
-		isl, _, _ := r.index(i)
-		jsl, _, _ := o.index(i)
-		err = valuesEqual(isl, jsl)
+		err = foldEqual(r.indexRaw(i), o.indexRaw(i), fold)
+	}
+
+	return
+}
+
+/*
+SetFoldKeywordComparison sets the keyword folding bit within the receiver.
+When set, the keywords of all [Condition] instances compared by way of
+[Stack.IsEqual] -- including those within nested [Stack] instances and
+[Condition] expressions -- are compared case-insensitively, as though each
+had been set via [Condition.SetFoldKeywordComparison]. Instances governed
+by an [EqualityPolicy] are unaffected.
+
+By default, keywords are compared case-sensitively.
+
+A Boolean input value explicitly sets the bit as intended.
+Execution without a Boolean input value will *TOGGLE* the
+current state of the folding bit (i.e.: true->false and
+false->true)
+*/
+func (r Stack) SetFoldKeywordComparison(state ...bool) Stack {
+	r.setState(kfold, state...)
+	return r
+}
+
+/*
+foldEqual compares x and y in the manner of the valuesEqual function,
+except that when fold is true, any [Stack] or [Condition] instances (or
+aliases of either) not governed by an [EqualityPolicy] are compared with
+keyword folding in effect. See [Stack.SetFoldKeywordComparison].
+*/
+func foldEqual(x, y any, fold bool) error {
+	if fold {
+		if ic, ok := conditionTypeAliasConverter(x); ok && ic.IsInit() && ic.condition.cfg.eqf == nil {
+			if jc, jok := conditionTypeAliasConverter(y); jok && jc.IsInit() {
+				return ic.condition.isEqual(jc.condition, fold)
+			}
+		} else if is, sok := stackTypeAliasConverter(x); sok && is.IsInit() {
+			if sc, _ := is.stack.config(); sc.eqf == nil {
+				if js, jok := stackTypeAliasConverter(y); jok && js.IsInit() {
+					return is.stack.isEqual(js.stack, fold)
+				}
+			}
+		}
+	}
+
+	return valuesEqual(x, y)
+}
+
+/*
+isEqualUnordered is a private method called by stack.isEqual when the
+order-insensitive equality bit is set. Each slice of the receiver must
+match exactly one (1) unmatched slice of o, per valuesEqual.
+*/
+func (r *stack) isEqualUnordered(o *stack, fold bool) (err error) {
+	matched := make([]bool, o.ulen())
+	for i := 0; i < r.ulen(); i++ {
+		isl := r.indexRaw(i)
+
+		var found bool
+		for j := 0; j < o.ulen() && !found; j++ {
+			if !matched[j] {
+				found = foldEqual(isl, o.indexRaw(j), fold) == nil
+				matched[j] = found
+			}
+		}
+
+		if !found {
+			err = wrapErr(ErrEqualityMismatch, "No unmatched counterpart for slice %d", i)
+			break
+		}
 	}
 
 	return
@@ -2766,7 +7507,10 @@ func (r Stack) Unmarshal() (slice []any, err error) {
 			slice, err = sc.umf()
 		} else {
 			// use default unmarshaler
-			slice, err = r.stack.unmarshalDefault()
+			if err = r.stack.cycleErr(); err == nil {
+				view, _ := r.stack.live()
+				slice, err = view.unmarshalDefault(r.getState(umcfg))
+			}
 		}
 	}
 
@@ -2774,23 +7518,57 @@ func (r Stack) Unmarshal() (slice []any, err error) {
 }
 
 /*
-unmarshalDefault is a private method called by Stack.Unmarshal.
+SetUnmarshalWithConfig sets the unmarshal configuration bit within the
+receiver. When set, the default [Stack.Unmarshal] output includes an
+instance of [MarshalSettings] as the second element of the receiver,
+as well as of each nested [Stack] and [Condition], such that the default
+[Stack.Marshal] method produces instances which render identically.
+
+A Boolean input value explicitly sets the bit as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+bit (i.e.: true->false and false->true)
+*/
+func (r Stack) SetUnmarshalWithConfig(state ...bool) Stack {
+	r.setState(umcfg, state...)
+	return r
+}
+
+/*
+unmarshalDefault is a private method called by Stack.Unmarshal. If cfg
+is true, or if the receiver bears the unmarshal configuration bit, the
+presentation settings of the receiver and its descendants are included.
 */
-func (r stack) unmarshalDefault() (slices []any, err error) {
+func (r stack) unmarshalDefault(cfg bool) (slices []any, err error) {
+	sc, _ := r.config()
 	slices = append(slices, r.kind())
+	if cfg = cfg || sc.positive(umcfg); cfg {
+		slices = append(slices, sc.marshalSettings())
+	}
+
 	for i := 0; i < r.ulen() && err == nil; i++ {
-		slice, _, _ := r.index(i) // auto-skip config
+		slice := r.indexRaw(i) // auto-skip config
 		var subSlices []any
-		if sub, ok := stackTypeAliasConverter(slice); ok {
+		if isComment(slice) {
+			if sc.ucm {
+				slices = append(slices, slice)
+			}
+		} else if sub, ok := stackTypeAliasConverter(slice); ok {
 			// Instance is Stack/Stack alias;
 			// use native unmarshalDefault.
-			if subSlices, err = sub.unmarshalDefault(); err == nil {
+			if subSlices, err = sub.unmarshalDefault(cfg); err == nil {
 				slices = append(slices, subSlices)
 			}
 		} else if cub, ok := conditionTypeAliasConverter(slice); ok {
 			// Instance is Condition/Condition alias;
-			// use the Condition.Unmarshal method.
-			if subSlices, err = cub.Unmarshal(); err == nil {
+			// use the Condition.Unmarshal method,
+			// unless settings are requested.
+			if !cfg || cub.condition.cfg.umf != nil {
+				subSlices, err = cub.Unmarshal()
+			} else {
+				subSlices, err = cub.condition.unmarshalDefault(cfg)
+			}
+
+			if err == nil {
 				slices = append(slices, subSlices)
 			}
 		} else {
@@ -2809,29 +7587,49 @@ Marshal returns an error following an attempt to read the variadic 'in'
 value(s) into the receiver instance. The appropriate input for this method
 is the output produced by [Stack.Unmarshal].
 
+Any [MarshalSettings] instances found within the input are applied to
+the corresponding instances. Unrecognized kind labels result in a
+[Basic] [Stack]; see [Stack.MarshalStrict] for an alternative.
+
 This method is intended for generalized use, and may be overridden using
 the [Stack.SetMarshaler] method.
 */
 func (r *Stack) Marshal(in ...any) (err error) {
+	return r.marshal(false, in...)
+}
+
+/*
+MarshalStrict behaves identically to [Stack.Marshal], except that an
+unrecognized kind label, at any depth, results in an error wrapping
+[ErrMarshalInput] rather than a [Basic] [Stack].
+*/
+func (r *Stack) MarshalStrict(in ...any) (err error) {
+	return r.marshal(true, in...)
+}
+
+/*
+marshal is a private method called by [Stack.Marshal] and [Stack.MarshalStrict].
+*/
+func (r *Stack) marshal(strict bool, in ...any) (err error) {
 	if len(in) == 0 {
-		err = errorf("Empty marshaler input")
+		err = wrapErr(ErrMarshalInput, "Empty marshaler input")
 	} else {
 		var xs Stack
 		var xc Condition
 
 		if !r.IsInit() {
 			// use default marshaler
-			if xs, xc, err = marshalDefault(in); xs.IsInit() {
+			if xs, xc, err = marshalDefault(in, strict); xs.IsInit() {
 				r.stack = xs.stack
 			} else if xc.IsInit() {
-				err = errorf("Cannot Unmarshal Condition only; must envelope in Stack")
+				err = wrapErr(ErrMarshalInput, "Cannot Unmarshal Condition only; must envelope in Stack")
 			}
 		} else if sc, _ := r.config(); sc.maf != nil {
 			// use the user-authored closure marshaler
 			err = sc.maf(in...)
 		} else {
 			// use default marshaler
-			if xs, xc, err = marshalDefault(in); xs.IsInit() {
+			if xs, xc, err = marshalDefault(in, strict); xs.IsInit() {
 				r.Push(xs)
 			} else if xc.IsInit() {
 				r.Push(xc)
@@ -2843,256 +7641,492 @@ func (r *Stack) Marshal(in ...any) (err error) {
 }
 
 func stackByWord(label string) Stack {
-	switch uc(label) {
-	case `LIST`:
-		return List()
-	case `AND`:
-		return And()
-	case `NOT`:
-		return Not()
-	case `OR`:
-		return Or()
+	for kind := KindAnd; kind < KindBasic; kind++ {
+		if uc(label) == kind.String() {
+			return NewStack(kind)
+		}
 	}
 
 	return Basic()
 }
 
-func extractConditionValues(in []any) (c Condition, ok bool) {
-	if len(in) != 4 {
+func extractConditionValues(in []any, strict bool) (c Condition, err error) {
+	var x Condition
+	x.Init()
+	if err = x.condition.marshalDefault(in, strict); err == nil {
+		if verr := x.Valid(); verr != nil {
+			x.SetErr(verr)
+		}
+		c = x
+	}
+
+	return
+}
+
+func marshalDefault(in []any, strict bool) (x Stack, c Condition, err error) {
+	if len(in) == 0 {
+		err = wrapErr(ErrMarshalInput, "Empty input")
+		return
+	}
+
+	// De-envelope needlessly enveloped value
+	in = deenvelopeSingleStack(in)
+
+	// The first string value in a stack indicates the
+	// appropriate type of stack or condition
+	lab, ok := in[0].(string)
+	if !ok {
+		err = wrapErr(ErrMarshalInput, "Cannot unmarshal without stack label")
+		return
+	}
+
+	switch uc(lab) {
+	case `CONDITION`:
+		// A condition is an instance of []any
+		// with four values. The 1st is the
+		// 'CONDITION' label. The 2nd is the
+		// Keyword of a condition. The 3rd is
+		// the Operator and the last is the
+		// expression (value).  Convert this
+		// to a proper instance of Condition.
+		c, err = extractConditionValues(in, strict)
 		return
+	case `LIST`, `AND`, `OR`, `NOT`, `BASIC`:
+		x = stackByWord(lab)
+		in = in[1:]
+		if len(in) > 0 {
+			// apply any settings, which
+			// follow the label.
+			if ms, ok := in[0].(MarshalSettings); ok {
+				sc, _ := x.config()
+				sc.applyMarshalSettings(ms)
+				in = in[1:]
+			}
+		}
+		x.Push(in...)
+	default:
+		if strict {
+			err = wrapErr(ErrMarshalInput, "Unrecognized stack label '%s'", lab)
+			return
+		}
+
+		// No idea what the value is, just
+		// use a Basic
+		x = Basic().Push(in...)
+	}
+
+	// Iterate through the new stack (x) and
+	// Make sure there are no nested []any
+	// instance. If found, try to convert
+	// them to Stacks or Conditions by self
+	// executing this same function and if
+	// converted, replace the index with the
+	// new value.
+	for i := 0; i < x.Len() && !(strict && err != nil); i++ {
+		slice, _ := x.Index(i)
+		if tv, aok := slice.([]any); aok {
+			var xz Stack
+			var xc Condition
+			if xz, xc, err = marshalDefault(tv, strict); xz.IsInit() {
+				// Was a stack; replace old slice
+				x.Replace(xz, i)
+			} else if xc.IsInit() {
+				// Was a condition; replace old slice
+				x.Replace(xc, i)
+			}
+		}
+	}
+
+	return
+}
+
+func deenvelopeSingleStack(in []any) []any {
+	if len(in) == 1 {
+		for {
+			if inner, ok := in[0].([]any); ok {
+				in = inner
+			} else {
+				break
+			}
+		}
+	}
+
+	return in
+}
+
+/*
+calculateDefragMax is a private function executed exclusively by [Stack.Defrag], and
+exists simply to keep cyclomatics factors <=9 in the caller.
+*/
+func calculateDefragMax(max ...int) (m int) {
+	var _m int = 50
+	m = _m
+	if len(max) > 0 {
+		if max[0] > 0 {
+			m = max[0]
+		}
+	}
+
+	return
+}
+
+/*
+defrag is a private method called by stack.defragReport. It performs a
+single stable compaction of the receiver's user slices: each non-nil slice
+is copied forward, preserving order, and the remainder is truncated.
+
+Scanning stops once max consecutive nil slices have been encountered, in
+which case the unscanned remainder -- beginning with said nil slices -- is
+retained as-is, and in order.
+*/
+func (r *stack) defrag(max int) (err error) {
+	r.lock()
+	defer r.unlock()
+	defer r.touch()
+
+	before := r.ulen() - r.nils()
+	pat := make([]int, r.ulen())
+
+	w, run := 1, 0
+	for i := 1; i < r.len(); i++ {
+		if (*r)[i] == nil {
+			if run++; run < max {
+				continue
+			}
+
+			// consecutive nil maximum reached;
+			// retain the remainder as-is.
+			for j := i - run + 1; j < r.len(); j++ {
+				pat[j-1] = 1
+				(*r)[w] = (*r)[j]
+				w++
+			}
+			break
+		}
+
+		run = 0
+		pat[i-1] = 1
+		(*r)[w] = (*r)[i]
+		w++
+	}
+
+	for i := w; i < r.len(); i++ {
+		(*r)[i] = nil // release references
+	}
+	*r = (*r)[:w]
+	r.metaCompact(pat)
+
+	if after := r.ulen() - r.nils(); after != before {
+		err = errorf("defragmentation failed; %d non-nil slices before, %d after",
+			before, after)
+		r.setErr(err)
+	}
+
+	return
+}
+
+func (r *stack) canPushNester(x any) (can bool) {
+	_, can = stackTypeAliasConverter(x)
+	if !r.positive(nnest) {
+		can = true
+	}
+	return
+}
+
+/*
+methodAppend is a private method called by stack.push.
+*/
+func (r *stack) methodAppend(meth PushPolicy, label string, x ...any) *stack {
+	defer r.touch()
+	// use the user-provided function to scan
+	// each pushed item for verification.
+	var pct int
+	for i := 0; i < len(x); i++ {
+		var err error
+		if r.isFull() {
+			r.setErr(wrapErr(ErrCapacityViolation, "failed: capacity violation"))
+			break
+		}
+
+		if err = meth(x[i]); err != nil {
+			r.setErr(err)
+			break
+		} else if r.refuseDuplicate(x[i], -1) != nil {
+			continue
+		}
+
+		*r = append(*r, x[i])
+		r.metaInsert(r.ulen()-1, label)
+		r.addUnique(x[i])
+		pct++
 	}
-	var word string
-	var op Operator
 
-	if W, ok := in[1].(string); ok {
-		word = W
+	return r
+}
+
+/*
+contextAppend is a private method called by stack.push.
+*/
+func (r *stack) contextAppend(meth PushPolicyContext, label string, x ...any) {
+	defer r.touch()
+	// the context is constructed once per call; only
+	// the per-element fields are updated thereafter.
+	var ctx PushContext
+	for i := 0; i < len(x); i++ {
+		if r.isFull() {
+			r.setErr(wrapErr(ErrCapacityViolation, "failed: capacity violation"))
+			break
+		}
+
+		if i == 0 {
+			ctx = r.pushContext(x[i], r.ulen())
+		} else {
+			ctx.Index, ctx.Len = r.ulen(), r.ulen()
+			ctx.IsStack, ctx.IsCondition = pushKinds(x[i])
+		}
+
+		if err := meth(ctx, x[i]); err != nil {
+			r.setErr(err)
+			break
+		} else if r.refuseDuplicate(x[i], -1) != nil {
+			continue
+		}
+
+		*r = append(*r, x[i])
+		r.metaInsert(r.ulen()-1, label)
+		r.addUnique(x[i])
 	}
-	if O, ok := in[2].(Operator); ok {
-		op = O
+}
+
+/*
+pushContext returns the [PushContext] describing the prospective placement
+of x at user index idx within the receiver.
+*/
+func (r *stack) pushContext(x any, idx int) (ctx PushContext) {
+	ctx = PushContext{
+		Receiver: Stack{r},
+		Index:    idx,
+		Len:      r.ulen(),
+		Cap:      -1,
 	}
-	if E, ok := in[3].([]any); ok {
-		var xm Stack
-		var xn Condition
-		xm, xn, _ = marshalDefault(E)
-		if xm.IsInit() {
-			c = Cond(word, op, xm)
-		} else if xn.IsInit() {
-			c = Cond(word, op, xn)
-		}
-	} else {
-		c = Cond(word, op, in[3])
+	if c := r.cap(); c > 0 {
+		ctx.Cap = c - 1
 	}
+	ctx.IsStack, ctx.IsCondition = pushKinds(x)
 
 	return
 }
 
-func marshalDefault(in []any) (x Stack, c Condition, err error) {
-	if len(in) == 0 {
-		err = errorf("Empty input")
-		return
-	}
-
-	// De-envelope needlessly enveloped value
-	in = deenvelopeSingleStack(in)
+/*
+pushKinds returns Boolean values indicative of whether x is a [Stack] or a
+[Condition] (or an alias of either), for use within a [PushContext].
+*/
+func pushKinds(x any) (isStack, isCondition bool) {
+	_, isStack = stackTypeAliasConverter(x)
+	_, isCondition = conditionTypeAliasConverter(x)
+	return
+}
 
-	// The first string value in a stack indicates the
-	// appropriate type of stack or condition
-	lab, ok := in[0].(string)
-	if !ok {
-		err = errorf("Cannot unmarshal without stack label")
+/*
+admit is a private method called by [Stack.Insert] and [Stack.Exchange].
+It subjects x, bound for user index idx, to the [PushPolicy] -- or else the
+[PushPolicyContext] -- in effect, if any, unless the receiver is exempt (see
+[Stack.SetPolicyExemptReplace]). An error returned by the policy is set
+within the receiver, and returned.
+*/
+func (r *stack) admit(x any, idx int) (err error) {
+	sc, _ := r.config()
+	if sc.pex {
 		return
 	}
 
-	switch uc(lab) {
-	case `CONDITION`:
-		// A condition is an instance of []any
-		// with four values. The 1st is the
-		// 'CONDITION' label. The 2nd is the
-		// Keyword of a condition. The 3rd is
-		// the Operator and the last is the
-		// expression (value).  Convert this
-		// to a proper instance of Condition.
-		c, _ = extractConditionValues(in)
-		return
-	case `LIST`, `AND`, `OR`, `NOT`, `BASIC`:
-		x = stackByWord(lab).Push(in[1:]...)
-	default:
-		// No idea what the value is, just
-		// use a Basic
-		x = Basic().Push(in...)
+	if meth := r.getPushPolicy(); meth != nil {
+		err = meth(x)
+	} else if sc.ppc != nil {
+		err = sc.ppc(r.pushContext(x, idx), x)
 	}
 
-	// Iterate through the new stack (x) and
-	// Make sure there are no nested []any
-	// instance. If found, try to convert
-	// them to Stacks or Conditions by self
-	// executing this same function and if
-	// converted, replace the index with the
-	// new value.
-	for i := 0; i < x.Len(); i++ {
-		slice, _ := x.Index(i)
-		if tv, aok := slice.([]any); aok {
-			var xz Stack
-			var xc Condition
-			if xz, xc, err = marshalDefault(tv); xz.IsInit() {
-				// Was a stack; replace old slice
-				x.Replace(xz, i)
-			} else if xc.IsInit() {
-				// Was a condition; replace old slice
-				x.Replace(xc, i)
-			}
-		}
+	if err != nil {
+		r.setErr(err)
 	}
 
 	return
 }
 
-func deenvelopeSingleStack(in []any) []any {
-	if len(in) == 1 {
-		for {
-			if inner, ok := in[0].([]any); ok {
-				in = inner
+/*
+SetPolicyExemptReplace exempts (true) the [Stack.Insert], [Stack.InsertAfter],
+[Stack.Replace] and [Stack.Exchange] methods from the [PushPolicy] or the
+[PushPolicyContext] in effect, or subjects them to it (false), which is the
+default. This exemption may be useful to adopters which rely upon the former
+behavior, in which only values appended by way of [Stack.Push] (and similar)
+were subject to the policy.
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetPolicyExemptReplace(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			if len(state) > 0 {
+				sc.pex = state[0]
 			} else {
-				break
+				sc.pex = !sc.pex
 			}
 		}
 	}
 
-	return in
+	return r
 }
 
 /*
-calculateDefragMax is a private function executed exclusively by [Stack.Defrag], and
-exists simply to keep cyclomatics factors <=9 in the caller.
+IsPolicyExemptReplace returns a Boolean value indicative of whether the
+receiver exempts replacements and insertions from its push policy. See
+[Stack.SetPolicyExemptReplace].
 */
-func calculateDefragMax(max ...int) (m int) {
-	var _m int = 50
-	m = _m
-	if len(max) > 0 {
-		if max[0] > 0 {
-			m = max[0]
-		}
+func (r Stack) IsPolicyExemptReplace() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.pex
 	}
-
 	return
 }
 
-func (r *stack) defrag(max int) {
+/*
+SetUnique sets the unique setting within the receiver. When enabled, a
+value that is equal to any slice already present -- per the rules
+described by [Stack.IsEqual] -- is refused by [Stack.Push] (and similar),
+[Stack.Insert], [Stack.InsertAfter], [Stack.Replace] and [Stack.Exchange].
+Thus, [Condition] and [Stack] values bearing equal content are considered
+duplicates even if they are distinct instances.
+
+A refused value is not written, and an error wrapping [ErrDuplicate] is
+set within the receiver. Pushes of multiple values continue past such a
+refusal, such that only the duplicates are omitted. Any duplicates already
+present when this setting is enabled are retained.
+
+While the receiver contains only string, number and bool primitives, an
+internal index of its slices is maintained such that each refusal check
+need not scan all of the receiver's slices.
+
+A Boolean input value explicitly sets the setting as intended. Execution
+without a Boolean input value will *TOGGLE* the current state of the
+setting (i.e.: true->false and false->true)
+*/
+func (r Stack) SetUnique(state ...bool) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			r.stack.lock()
+			defer r.stack.unlock()
 
-	var start int = -1
-	var spat []int = make([]int, r.len(), r.len())
-	for i := 0; i < r.len(); i++ {
-		if _, _, ok := r.index(i); !ok {
-			if start == -1 {
-				start = i // only set once
+			sc, _ := r.stack.config()
+			if len(state) > 0 {
+				sc.unq = state[0]
+			} else {
+				sc.unq = !sc.unq
 			}
-			continue
+			sc.uqc = nil
 		}
-		spat[i] = 1
 	}
 
-	if !(start == -1 || max <= start) {
-		tpat := r.implode(start, max, spat)
-		last, err := r.verifyImplode(spat, tpat)
-		r.setErr(err)
-		if err == nil && last >= 0 {
-			// chop off the remaining consecutive nil slices
-			(*r) = (*r)[:last+1]
-		}
-	}
+	return r
+}
 
+/*
+IsUnique returns a Boolean value indicative of whether the receiver
+refuses duplicate slices. See [Stack.SetUnique].
+*/
+func (r Stack) IsUnique() (is bool) {
+	if r.IsInit() {
+		sc, _ := r.stack.config()
+		is = sc.unq
+	}
 	return
 }
 
-func (r stack) verifyImplode(spat, tpat []int) (last int, err error) {
-	last = -1
+/*
+refuseDuplicate returns an error if the receiver refuses duplicates and
+x is equal to any user slice other than that at user index skip, which
+may be -1. Such an error is also set within the receiver. The caller
+must hold the lock.
+*/
+func (r *stack) refuseDuplicate(x any, skip int) (err error) {
+	sc, _ := r.config()
+	if !sc.unq {
+		return
+	}
 
-	err = errorf("defragmentation failed; inconsistent slice results")
-	data := make(map[string]string, len(tpat))
-	var fail bool
-	for i := 1; i < len(spat); i++ {
-		key := `S[` + itoa(i-1) + `]`
-		result := spat[i] == tpat[i]
-		fail = true
-		if result {
-			fail = false
-		}
-		if tpat[i] != 0 {
-			last = (len(data) + i) - len(tpat)
+	var dup bool
+	if r.uniqueIndex(sc) && isKnownPrimitive(x) {
+		// the skipped slice, if equal, is the sole match
+		_, dup = sc.uqc[x]
+		dup = dup && (skip < 0 || valuesEqual((*r)[skip+1], x) != nil)
+	} else {
+		for i := 1; i < r.len() && !dup; i++ {
+			dup = i-1 != skip && valuesEqual((*r)[i], x) == nil
 		}
-
-		data[key] = `match:` + bool2str(result)
 	}
 
-	if !fail {
-		err = nil
+	if dup {
+		err = wrapErr(ErrDuplicate, "%T value is already present; duplicates are not permitted", x)
+		r.setErr(err)
 	}
 
-	last--
-
 	return
 }
 
-func (r *stack) implode(start, max int, spat []int) (tpat []int) {
-	var ct int
-	tpat = make([]int, len(spat), len(spat))
-	tpat[0] = 1 // cfg slice is exempt
-
-	r.lock()
-	defer r.unlock()
-
-	for {
-		if ct >= max || start+ct >= r.ulen() {
-			break
-		}
+/*
+uniqueIndex returns a Boolean value indicative of whether the index of
+primitive slices within sc is usable, rebuilding it first if stale. The
+index is unusable if any user slice is not a string, number or bool
+primitive.
+*/
+func (r *stack) uniqueIndex(sc *nodeConfig) bool {
+	if sc.uqc != nil && sc.uqn == r.ulen() {
+		return true
+	}
 
-		if (*r)[start+ct+1] == nil {
-			ct++
-			continue
+	sc.uqc = nil
+	for i := 1; i < r.len(); i++ {
+		if !isKnownPrimitive((*r)[i]) {
+			return false
 		}
+	}
 
-		(*r)[start+1] = (*r)[start+ct+1]
-
-		tpat[start+ct] = 1
-
-		(*r)[start+ct+1] = nil
-		start = start + 1
-		ct = 0
+	sc.uqc = make(map[any]struct{}, r.ulen())
+	for i := 1; i < r.len(); i++ {
+		sc.uqc[(*r)[i]] = struct{}{}
 	}
+	sc.uqn = r.ulen()
 
-	return
+	return true
 }
 
-func (r *stack) canPushNester(x any) (can bool) {
-	_, can = stackTypeAliasConverter(x)
-	if !r.positive(nnest) {
-		can = true
+/*
+addUnique records x, newly appended to the receiver, within the index
+of primitive slices if the index is current. Otherwise, the index is
+left to be rebuilt upon demand.
+*/
+func (r *stack) addUnique(x any) {
+	if sc, _ := r.config(); sc.uqc != nil && sc.uqn == r.ulen()-1 {
+		if isKnownPrimitive(x) {
+			sc.uqc[x] = struct{}{}
+			sc.uqn++
+		} else {
+			sc.uqc = nil
+		}
 	}
-	return
 }
 
 /*
-methodAppend is a private method called by stack.push.
+dropUnique discards the index of primitive slices, as is done when a
+slice is removed or replaced.
 */
-func (r *stack) methodAppend(meth PushPolicy, x ...any) *stack {
-
-	// use the user-provided function to scan
-	// each pushed item for verification.
-	var pct int
-	for i := 0; i < len(x); i++ {
-		var err error
-		if !r.isFull() {
-			if err = meth(x[i]); err != nil {
-				r.setErr(err)
-				break
-			}
-
-			*r = append(*r, x[i])
-			pct++
-		}
+func (r *stack) dropUnique() {
+	if sc, _ := r.config(); sc != nil {
+		sc.uqc = nil
 	}
-
-	return r
 }
 
 /*
@@ -3130,17 +8164,139 @@ genericAppend performs a normal append operation without the
 involvement of a custom push policy. Each iteration shall verify
 that maximum capacity --if one was specified-- is not exceeded.
 */
-func (r *stack) genericAppend(x ...any) {
+func (r *stack) genericAppend(label string, x ...any) {
+	defer r.touch()
 	var pct int
 
 	for i := 0; i < len(x); i++ {
 		if r.canPushNester(x[i]) {
-			if !r.isFull() {
-				*r = append(*r, x[i])
-				pct++
+			if r.isFull() {
+				r.setErr(wrapErr(ErrCapacityViolation, "failed: capacity violation"))
+				break
+			} else if r.refuseDuplicate(x[i], -1) != nil {
+				continue
 			}
+
+			*r = append(*r, x[i])
+			r.metaInsert(r.ulen()-1, label)
+			r.addUnique(x[i])
+			pct++
+		}
+	}
+}
+
+/*
+SetChangeHook assigns the provided [ChangeHook] closure function to the
+receiver, which shall be executed following each change to the contents
+of the receiver, outside of any mutex lock. The following operations
+(named as shown) trigger the hook:
+
+  - "push", once per slice added by [Stack.Push]
+  - "pop", for the slice removed by [Stack.Pop]
+  - "remove", for the slice removed by [Stack.Remove]
+  - "insert", for the slice added by [Stack.Insert]
+  - "replace", for the slice assigned by [Stack.Replace]
+  - "reset", following [Stack.Reset] or [Stack.ResetKeepCap] (idx -1)
+  - "defrag", following a [Stack.Defrag] that changed the receiver (idx -1)
+  - "transfer", once per slice added to the receiver as the destination of [Stack.Transfer], [Stack.TransferN] or [Stack.Move]
+  - "merge", once per slice added to the receiver by [Stack.Merge]
+  - "expire", for each expired slice removed (see [Stack.SetTTL])
+
+Only operations upon the receiver itself trigger the hook; changes made to
+nested instances do not. Read-only instances, which cannot be changed, will
+never trigger the hook.
+
+Specifying nil shall disable this capability if enabled.
+*/
+func (r Stack) SetChangeHook(fn ChangeHook) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.stack.config()
+			sc.chf = fn
 		}
 	}
+	return r
+}
+
+/*
+changed executes the [ChangeHook], if set, using the provided input
+values. A [LogLevel3] (state) event is also transcribed.
+*/
+func (r *stack) changed(op string, idx int, value any) {
+	sc, _ := r.config()
+	sc.changed(op, idx, value)
+}
+
+/*
+touch advances the version of the receiver. It is deferred by each private
+method which alters the slices of the receiver. See nodeConfig.touch.
+*/
+func (r *stack) touch() {
+	sc, _ := r.config()
+	sc.touch()
+}
+
+/*
+version returns the version of the receiver alongside a Boolean value
+indicative of whether the receiver is initialized. See nodeConfig.touch.
+*/
+func (r *stack) version() (v uint64, ok bool) {
+	if sc, err := r.config(); err == nil {
+		v, ok = sc.version()
+	}
+
+	return
+}
+
+/*
+changed is the configuration-level counterpart of stack.changed, for use
+by callers which must not read the receiver outside of its lock.
+*/
+func (r *nodeConfig) changed(op string, idx int, value any) {
+	if r.chf != nil {
+		r.chf(op, idx, value)
+	}
+	r.logEvent(LogLevel3, op, idx, nil)
+}
+
+/*
+called transcribes a [LogLevel1] (calls) event for the named method (op)
+and the number of arguments (args) supplied, returning the user length of
+the receiver for use with stack.settled.
+*/
+func (r *stack) called(op string, args int) int {
+	sc, _ := r.config()
+	sc.logCall(op, args)
+	return r.ulen()
+}
+
+/*
+settled transcribes a [LogLevel3] (state) event describing the length of
+the receiver prior to (before) and following the named operation (op).
+*/
+func (r *stack) settled(op string, before int) {
+	sc, _ := r.config()
+	sc.logLen(op, before, r.ulen())
+}
+
+/*
+ended clears the operation name noted by stack.called. It is deferred by
+each instrumented method, such that the name does not outlive the method
+along exit paths that do not reach stack.settled.
+*/
+func (r *stack) ended() {
+	sc, _ := r.config()
+	sc.endCall()
+}
+
+/*
+changedFrom executes stack.changed for each user slice at or beyond
+the user index (from).
+*/
+func (r *stack) changedFrom(op string, from int) {
+	for i := from; i < r.ulen(); i++ {
+		r.changed(op, i, (*r)[i+1])
+	}
 }
 
 /*
@@ -3167,6 +8323,26 @@ func (r *stack) setPushPolicy(ppol PushPolicy) *stack {
 	return r
 }
 
+/*
+SetPushPolicyContext assigns the provided [PushPolicyContext] closure
+function to the receiver, thereby enabling protection against undesired
+appends to the [Stack]. The provided function shall be executed by the
+[Stack.Push] method for each individual item being added.
+
+If a [PushPolicy] has also been set via [Stack.SetPushPolicy], it takes
+precedence, and the [PushPolicyContext] is not executed. A nil value
+removes the closure.
+*/
+func (r Stack) SetPushPolicyContext(ppol PushPolicyContext) Stack {
+	if r.IsInit() {
+		if !r.getState(ronly) {
+			sc, _ := r.config()
+			sc.ppc = ppol
+		}
+	}
+	return r
+}
+
 /*
 getPushPolicy is a private method called by [Stack.Push].
 */
@@ -3247,6 +8423,30 @@ func (r *stack) getValidityPolicy() ValidityPolicy {
 	return sc.vpf
 }
 
+/*
+StackCaster is an optional interface which may be implemented by [Stack]
+type aliases. When implemented, the CastStack method is used to obtain the
+native [Stack] in place of reflection-based conversion, such as during
+string representation, equality assertion and traversal.
+*/
+type StackCaster interface {
+	CastStack() Stack
+}
+
+/*
+RegisterStackAlias registers the type of the provided example, which may
+be an instance of -- or pointer to -- a [Stack] type alias, such that
+subsequent conversions of values of that type forgo the reflective
+convertibility check. A Boolean value indicative of whether the type is
+a [Stack] type alias is returned. Types which are not are refused.
+
+Registration is optional: unregistered aliases are converted as always.
+This function is safe for concurrent use.
+*/
+func RegisterStackAlias(example any) bool {
+	return registerAlias(&stackAliases, example, nativeStack)
+}
+
 /*
 ConvertStack returns an instance of [Stack] alongside a Boolean value.
 
@@ -3254,7 +8454,9 @@ If the input value is a native [Stack], it is returned as-is alongside a
 Boolean value of true.
 
 If the input value is a [Stack]-alias, it is converted to a native [Stack]
-instance and returned alongside a Boolean value of true.
+instance and returned alongside a Boolean value of true. Aliases which
+implement [StackCaster], or whose types were registered using the
+[RegisterStackAlias] function, are converted most efficiently.
 
 Any other scenario returns a zero [Stack] alongside a Boolean value of
 false.
@@ -3263,6 +8465,66 @@ func ConvertStack(in any) (Stack, bool) {
 	return stackTypeAliasConverter(in)
 }
 
+/*
+ConvertStacks converts each input value as [ConvertStack] does, returning
+the successfully converted instances in the order of input. The indices of
+input values which could not be converted are returned as well, alongside
+a Boolean value which is true only if all values were converted.
+*/
+func ConvertStacks(in ...any) (stacks []Stack, failed []int, ok bool) {
+	stacks = make([]Stack, 0, len(in))
+	for i := 0; i < len(in); i++ {
+		if S, sok := stackTypeAliasConverter(in[i]); sok {
+			stacks = append(stacks, S)
+		} else {
+			failed = append(failed, i)
+		}
+	}
+	ok = len(failed) == 0
+
+	return
+}
+
+/*
+ConvertMembers returns a new [Stack] of the same kind as the receiver, in
+which each [Stack] and [Condition] type alias slice has been replaced by
+its native conversion, as with [ConvertStack] and [ConvertCondition]. All
+other slices are copied as-is. This may be useful prior to handing the
+structure to code which only recognizes the native types.
+
+The new instance inherits the ordering and presentation configuration of
+the receiver, but not its capacity. Only the receiver's own slices are
+converted; the slices of nested instances are not. The receiver is not
+modified.
+*/
+func (r Stack) ConvertMembers() (S Stack) {
+	if r.IsInit() {
+		r.stack.lock()
+		defer r.stack.unlock()
+
+		S = Stack{r.stack.convertMembers()}
+	}
+	return
+}
+
+/*
+convertMembers is a private method called by [Stack.ConvertMembers].
+*/
+func (r *stack) convertMembers() *stack {
+	sib := r.sibling()
+	for i := 1; i < r.len(); i++ {
+		slice := (*r)[i]
+		if Sa, ok := stackTypeAliasConverter(slice); ok {
+			slice = Sa
+		} else if Ca, ok := conditionTypeAliasConverter(slice); ok {
+			slice = Ca
+		}
+		*sib = append(*sib, slice)
+	}
+
+	return sib
+}
+
 /*
 stackTypeAliasConverter attempts to convert any (u) back to a bonafide
 instance of Stack. This will only work if input value u is a type alias
@@ -3270,19 +8532,23 @@ of Stack.  An instance of Stack is returned along with a Boolean value
 of true.
 */
 func stackTypeAliasConverter(u any) (S Stack, converted bool) {
-	if u != nil {
+	switch tv := u.(type) {
+	case Stack:
 		// If it isn't a Stack alias, but is a
 		// genuine Stack, just pass it back
 		// with a thumbs-up ...
-		if st, isStack := u.(Stack); isStack {
-			S = st
-			converted = isStack
+		S, converted = tv, true
+	case StackCaster:
+		S = tv.CastStack()
+		converted = !S.IsZero()
+	default:
+		if !isAliasCandidate(u) {
 			return
 		}
 
 		a, v, _ := derefPtr(typOf(u), valOf(u))
-		b := typOf(Stack{}) // target (dest) type
-		if a.ConvertibleTo(b) {
+		b := nativeStack // target (dest) type
+		if aliasConvertible(&stackAliases, a, b) {
 			X := v.Convert(b).Interface()
 			if assert, ok := X.(Stack); ok {
 				if !assert.IsZero() {
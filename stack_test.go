@@ -2,7 +2,13 @@ package stackage
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"math/rand"
 	// uncomment for TestStackagePerf runs
 	//"log"
 	//"net/http"
@@ -10,8 +16,9 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
-	_ "time"
+	"time"
 )
 
 // Uncomment this test func (and the http+log imports above)
@@ -676,7 +683,7 @@ func TestStack_Transfer(t *testing.T) {
 	)
 
 	or := Or()
-	if ok := stk.Transfer(or); or.Len() != 3 || !ok {
+	if ct, err := stk.Transfer(or); or.Len() != 3 || ct != 3 || err != nil {
 		t.Errorf("%s failed [post-transfer len comparisons]: want len:%d, got len:%d", t.Name(), stk.Len(), or.Len())
 		return
 	}
@@ -687,6 +694,13 @@ func TestStack_Transfer(t *testing.T) {
 			t.Name(), 0, 3, stk.Len(), or.Len())
 		return
 	}
+
+	// nil slices are neither transferred nor counted
+	holey := List().Push(`a`, nil, `b`)
+	dest := List()
+	if ct, err := holey.Move(dest); ct != 2 || err != nil || dest.Len() != 2 || holey.Len() != 0 {
+		t.Errorf("%s failed [nil slices]: want count:%d, got count:%d (%v)", t.Name(), 2, ct, err)
+	}
 }
 
 func TestStack_Traverse(t *testing.T) {
@@ -1011,6 +1025,73 @@ func TestAnd_004(t *testing.T) {
 	}
 }
 
+func TestStack_SetSymbols(t *testing.T) {
+	// only the outermost stack is configured
+	A := And().Symbol('&').Paren().LeadOnce().Encap(testParens).NoPadding().
+		SetInheritPresentation(true).
+		SetSymbols(map[StackKind]string{
+			KindOr:   `|`,
+			KindNot:  `!`,
+			KindList: `,`, // ignored
+		}).Push(
+		`top_element_number_0`,
+		Or().Push(
+			`sub_element_number_0`,
+			Not().Push(`sub_element_number_1`),
+		),
+		Not().Push(
+			`unwanted_element_number_0`,
+			`unwanted_element_number_1`,
+		),
+	)
+
+	want := `(&(top_element_number_0)(|(sub_element_number_0)(!(sub_element_number_1)))(!(unwanted_element_number_0)(unwanted_element_number_1)))`
+	if got := A.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if got := len(A.Symbols()); got != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, got)
+		return
+	}
+
+	// nested stacks are not modified
+	or, _ := A.Index(1)
+	if got := or.(Stack).String(); got != `sub_element_number_0 OR NOT sub_element_number_1` {
+		t.Errorf("%s failed: unexpected nested string '%s'", t.Name(), got)
+		return
+	}
+
+	// a symbol set within a nested stack takes precedence,
+	// though its presentation is then its own concern.
+	or.(Stack).SetSymbol(`||`).SetParen(true).SetLeadOnce(true)
+	want = `(&(top_element_number_0)(||(sub_element_number_0)(!(sub_element_number_1)))(!(unwanted_element_number_0)(unwanted_element_number_1)))`
+	if got := A.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// symbol-less NOTs beneath a symbol-configured
+	// parent bear single-space padding.
+	for _, tst := range []struct {
+		Stack Stack
+		Want  string
+	}{
+		{And().SetSymbol(`&&`).Push(`a`, Not().Push(`b`), `c`), `a && NOT b && c`},
+		{And().SetSymbol(`&`).SetLeadOnce(true).SetParen(true).SetNoPadding(true).Push(
+			`a`, Not().SetParen(true).Push(`b`)), `(&a NOT ( b ))`},
+		{And().Push(Not().Push(`b`), `c`), `NOT b AND c`},
+	} {
+		if got := tst.Stack.String(); got != tst.Want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), tst.Want, got)
+			return
+		}
+	}
+
+	if A.SetSymbols(nil); A.Symbols() != nil {
+		t.Errorf("%s failed: want nil table, got '%v'", t.Name(), A.Symbols())
+	}
+}
+
 /*
 This example demonstrates ORed stack values using the double pipe (||) symbol
 and custom value encapsulation.
@@ -1133,6 +1214,42 @@ func TestList_001_withNoDelim(t *testing.T) {
 	}
 }
 
+func TestStack_SetDelimiters(t *testing.T) {
+	for idx, tst := range []struct {
+		Stack Stack
+		Want  string
+	}{
+		{List().SetDelimiter(` -> `).Push(`a`, `b`, `c`), `a -> b -> c`},
+		{List().SetDelimiter(` -> `).SetNoPadding(true).Push(`a`, `b`, `c`), `a -> b -> c`},
+		{List().SetDelimiter(`->`).SetNoPadding(true).Push(`a`, `b`, `c`), `a->b->c`},
+		{List().SetDelimiters(`, `, ` and `).SetNoPadding(true).Push(`a`, `b`, `c`), `a, b and c`},
+		{List().SetDelimiters(`, `, `, and `).SetNoPadding(true).Push(`a`, `b`, `c`, `d`), `a, b, c, and d`},
+		{List().SetDelimiters(`, `, ` and `).Push(`a`, `b`, `c`), `a , b and c`},
+		{List().SetDelimiters(`, `, ` and `).SetNoPadding(true).Push(`a`, `b`), `a and b`},
+		{List().SetDelimiters(`; `, `, `, ` or `).SetNoPadding(true).Push(`a`, `b`, `c`, `d`, `e`), `a; b, c, d or e`},
+		{And().SetDelimiters(`, `, ` and `).Push(`a`, `b`), `a AND b`},
+	} {
+		if got := tst.Stack.String(); got != tst.Want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		}
+	}
+
+	L := List().SetDelimiters(`, `, ` and `)
+	if got, want := L.Delimiter(), `, `; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if got := L.Delimiters(); len(got) != 2 || got[1] != ` and ` {
+		t.Errorf("%s failed: unexpected delimiters %v", t.Name(), got)
+		return
+	}
+
+	// SetDelimiter discards positional delimiters
+	if got := L.SetDelimiter(`|`).Delimiters(); len(got) != 1 || got[0] != `|` {
+		t.Errorf("%s failed: unexpected delimiters %v", t.Name(), got)
+	}
+}
+
 func TestCustomStack001(t *testing.T) {
 	A := List().SetDelimiter(`,`).NoPadding().Push(
 		`top_element_number_0`,
@@ -1211,6 +1328,121 @@ This example demonstrates traversing a Stack instance containing Condition
 slice instances. We use a path sequence of []int{1, 1} to target slice #1
 on the first level, and value #1 of the second level.
 */
+func TestStack_TraverseErr(t *testing.T) {
+	stk := And().Push(
+		`this`,
+		Or().Push(
+			Cond(`keyword`, Eq, `value`),
+			Cond(`keyword`, Ne, Not().Push(`that`)),
+		),
+	)
+
+	if slice, err := stk.TraverseErr(1, 1, 0); err != nil || slice != `that` {
+		t.Errorf("%s failed: want '%s', got '%v' (err:%v)", t.Name(), `that`, slice, err)
+		return
+	}
+
+	for idx, tst := range []struct {
+		Path []int
+		Want string
+	}{
+		{[]int{}, `depth 1: empty traversal path`},
+		{[]int{5}, `depth 1: index 5 out of range (len 2)`},
+		{[]int{1, 5}, `depth 2: index 5 out of range (len 2)`},
+		{[]int{0, 1}, `depth 1: string is not traversable`},
+		{[]int{1, 0, 0}, `depth 2: Condition expression is string, not traversable`},
+		{[]int{1, 1, 0, 0}, `depth 3: string is not traversable`},
+	} {
+		slice, err := stk.TraverseErr(tst.Path...)
+		if err == nil || err.Error() != tst.Want || slice != nil {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%v' [%v]",
+				t.Name(), idx, tst.Want, err, slice)
+			return
+		}
+
+		if _, ok := stk.Traverse(tst.Path...); ok {
+			t.Errorf("%s failed [idx:%d]: want '%t', got '%t'", t.Name(), idx, false, ok)
+			return
+		}
+	}
+
+	var zero Stack
+	if _, err := zero.TraverseErr(0); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+	}
+}
+
+func TestStack_At(t *testing.T) {
+	type customStack Stack
+	stk, _ := ConvertStack(customStack(List().SetID(`fruit`).Push(
+		`apple`,
+		Or().Push(`banana`, Cond(`kind`, Eq, And().Push(`cherry`))),
+	)))
+
+	if got := stk.At(0); got != `apple` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `apple`, got)
+		return
+	} else if got = stk.At(5, `none`); got != `none` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `none`, got)
+		return
+	} else if got = stk.At(5); got != nil {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), nil, got)
+		return
+	} else if got = stk.At(-1, `none`); got != `none` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `none`, got)
+		return
+	}
+
+	stk.NegativeIndices(true)
+	if got := stk.At(-2, `none`); got != `apple` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `apple`, got)
+		return
+	}
+
+	if got := stk.TraverseAt([]int{1, 1, 0}, `none`); got != `cherry` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `cherry`, got)
+		return
+	} else if got = stk.TraverseAt([]int{1, 9}, `none`); got != `none` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `none`, got)
+		return
+	} else if got = stk.MustTraverse(1, 0); got != `banana` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `banana`, got)
+		return
+	} else if got = stk.MustIndex(0); got != `apple` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `apple`, got)
+		return
+	}
+
+	for want, fn := range map[string]func(){
+		`stackage: no slice at index 7 in stack 'fruit' (len 2)`: func() {
+			stk.MustIndex(7)
+		},
+		`stackage: traversal of stack 'fruit' failed: depth 2: index 9 out of range (len 2)`: func() {
+			stk.MustTraverse(1, 9)
+		},
+	} {
+		got := catchPanic(fn)
+		if got != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+			return
+		}
+	}
+}
+
+/*
+catchPanic returns the string form of the value recovered from a panic
+raised by fn, or a zero string if no panic occurred.
+*/
+func catchPanic(fn func()) (msg string) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg = sprintf("%v", r)
+		}
+	}()
+	fn()
+	return
+}
+
 func ExampleStack_Traverse() {
 
 	// An optional Stack "maker" for configuring
@@ -1290,6 +1522,115 @@ func ExampleStack_LeadOnce() {
 	// Output: (&(objectClass=employee)(|(objectClass=engineeringLead)(objectClass=shareholder)))
 }
 
+func TestStack_SetErrorAccumulation(t *testing.T) {
+	S := List().SetErrorAccumulation(true).SetPushPolicy(func(x ...any) error {
+		return errorf("refused")
+	})
+
+	S.Push(`a`).PushStack(StackKind(99)).SetErr(errorf("manual"))
+
+	errs := S.Errs()
+	if len(errs) != 3 {
+		t.Errorf("%s failed: want 3 errors, got %d", t.Name(), len(errs))
+		return
+	} else if got := errs[2].Error(); got != `manual` || S.Err().Error() != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `manual`, got)
+		return
+	}
+
+	var rec ErrorRecord
+	if !errors.As(errs[0], &rec) || rec.Op != `push` || rec.Time.IsZero() {
+		t.Errorf("%s failed: want push record, got '%#v'", t.Name(), rec)
+		return
+	} else if errors.As(errs[1], &rec); rec.Op != `` {
+		t.Errorf("%s failed: want uninstrumented record, got '%s'", t.Name(), rec.Op)
+		return
+	}
+
+	// operation names do not outlive their methods
+	T := List().SetErrorAccumulation(true).Push(`a`, `b`)
+	T.Exchange(`c`, 0)
+	T.Transfer(List())
+	T.SetErr(errorf("manual"))
+	if errs = T.Errs(); len(errs) != 1 || !errors.As(errs[0], &rec) || rec.Op != `` {
+		t.Errorf("%s failed: want uninstrumented record, got '%s'", t.Name(), rec.Op)
+		return
+	}
+
+	// ring wraps past its size
+	S.SetErrorHistorySize(2)
+	for i := 0; i < 5; i++ {
+		S.SetErr(errorf("error %d", i))
+	}
+	if errs = S.Errs(); len(errs) != 2 || errs[0].Error() != `error 3` || errs[1].Error() != `error 4` {
+		t.Errorf("%s failed: want [error 3 error 4], got %v", t.Name(), errs)
+		return
+	}
+
+	// disabling keeps the history
+	S.SetErrorAccumulation(false).SetErr(errorf("ignored"))
+	if errs = S.Errs(); len(errs) != 2 || S.IsAccumulatingErrors() {
+		t.Errorf("%s failed: want 2 retained errors, got %d", t.Name(), len(errs))
+		return
+	}
+
+	if errs = S.ClearErrs().Errs(); len(errs) != 0 || S.Err() == nil {
+		t.Errorf("%s failed: want cleared history and retained error, got %v", t.Name(), errs)
+		return
+	}
+
+	// toggling
+	if !S.SetErrorAccumulation().IsAccumulatingErrors() {
+		t.Errorf("%s failed: accumulation not toggled on", t.Name())
+		return
+	} else if S.SetErrorAccumulation().IsAccumulatingErrors() {
+		t.Errorf("%s failed: accumulation not toggled off", t.Name())
+	}
+}
+
+func TestStack_PushCond(t *testing.T) {
+	filter := And().SetSymbol('&').
+		PushCond(`objectClass`, Eq, `employee`).
+		PushStack(KindOr,
+			Cond(`objectClass`, Eq, `engineeringLead`),
+			Cond(`objectClass`, Eq, `shareholder`),
+		)
+
+	// apply the presentation used by ExampleStack_LeadOnce
+	for _, c := range filter.Conditions(true) {
+		c.SetNoPadding(true).SetParen(true)
+	}
+	Ors, _ := ConvertStack(filter.MustIndex(1))
+	for _, stk := range []Stack{filter, Ors.SetSymbol('|')} {
+		stk.SetParen(true).SetLeadOnce(true).SetNoPadding(true)
+	}
+
+	want := `(&(objectClass=employee)(|(objectClass=engineeringLead)(objectClass=shareholder)))`
+	if got := filter.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// invalid conditions are not pushed
+	filter.PushCond(``, Eq, `value`)
+	if filter.Len() != 2 || filter.Err() == nil {
+		t.Errorf("%s failed: invalid condition pushed (len:%d, err:%v)",
+			t.Name(), filter.Len(), filter.Err())
+		return
+	}
+
+	// push policies apply
+	list := List().SetPushPolicy(func(x ...any) error {
+		if _, ok := ConvertCondition(x[0]); ok {
+			return errorf("conditions not permitted")
+		}
+		return nil
+	}).PushCond(`a`, Eq, `b`).PushStack(KindInvalid, `x`).PushStack(KindList, `y`)
+	if list.Len() != 1 || list.Err() == nil {
+		t.Errorf("%s failed: want len 1 and error, got %d and %v", t.Name(), list.Len(), list.Err())
+	}
+}
+
 func TestBasic(t *testing.T) {
 	b := Basic()
 	b.Push(
@@ -1560,6 +1901,158 @@ func ExampleStack_SetID_pointerAddress() {
 	// Output: Address ID has '0x' prefix: true
 }
 
+func TestStack_Config(t *testing.T) {
+	stk := List(8).
+		Mutex().
+		SetID(`config`).
+		SetIDPrefix(`pfx`).
+		SetCategory(`test`).
+		SetDelimiters(`, `, ` and `).
+		SetSymbols(map[StackKind]string{KindNot: `!`}).
+		SetEncap(`"`, []string{`<`, `>`}).
+		SetFIFO(true).
+		SetLogLevel(LogLevel1, LogLevel3).
+		SetOperatorContext(`ctx`).
+		SetMaxStringLength(64).
+		SetParen(true).
+		SetFold(true).
+		SetNoPadding(true).
+		SetNoNesting(true).
+		NegativeIndices(true).
+		SetUnique(true).
+		SetTTL(time.Minute).
+		Push(`a`, `b`)
+
+	getters := func(r Stack) StackConfig {
+		return StackConfig{
+			ID:              r.ID(),
+			IDPrefix:        r.IDPrefix(),
+			Category:        r.Category(),
+			Kind:            r.KindOf(),
+			Symbol:          r.stack.getSymbol(),
+			Symbols:         r.Symbols(),
+			Delimiter:       r.Delimiter(),
+			Delimiters:      r.Delimiters(),
+			Encap:           r.EncapChars(),
+			Cap:             r.Cap(),
+			FIFO:            r.IsFIFO(),
+			Priority:        r.IsPriority(),
+			LogLevels:       r.LogLevels(),
+			OperatorContext: r.OperatorContext(),
+			MaxStringLength: r.MaxStringLength(),
+			Paren:           r.IsParen(),
+			Fold:            r.getState(cfold),
+			Padded:          r.IsPadded(),
+			SymbolPadded:    r.IsSymbolPadded(),
+			LeadOnce:        r.getState(lonce),
+			ReadOnly:        r.IsReadOnly(),
+			NoNesting:       r.getState(nnest),
+			NegativeIndices: r.getState(negidx),
+			ForwardIndices:  r.getState(fwdidx),
+			Mutex:           r.CanMutex(),
+			Unique:          r.IsUnique(),
+			TTL:             r.TTL(),
+		}
+	}
+
+	snap := stk.Config()
+	want := sprintf("%#v", getters(stk))
+	if got := sprintf("%#v", snap); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if snap.Cap != 8 || !snap.FIFO || snap.Padded || len(snap.Delimiters) != 2 {
+		t.Errorf("%s failed: unexpected snapshot %#v", t.Name(), snap)
+		return
+	}
+
+	// mutate the snapshot; the receiver must not be affected
+	snap.Delimiters[0] = `;`
+	snap.Encap[0][0] = `'`
+	if got := stk.Delimiters()[0]; got != `, ` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `, `, got)
+		return
+	}
+	snap.Delimiters[0] = `, `
+	snap.Encap[0][0] = `"`
+
+	// mutate the receiver; the snapshot must not be affected
+	stk.SetID(`changed`).
+		SetCategory(`other`).
+		SetDelimiter(`|`).
+		SetEncap().
+		SetFIFO(false).
+		UnsetLogLevel(LogLevel3).
+		SetParen(false).
+		SetReadOnly(true)
+
+	if got := sprintf("%#v", snap); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	want = sprintf("%#v", getters(stk))
+	if got := sprintf("%#v", stk.Config()); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	var zero Stack
+	if got := sprintf("%#v", zero.Config()); got != sprintf("%#v", StackConfig{}) {
+		t.Errorf("%s failed: want zero snapshot, got '%s'", t.Name(), got)
+	}
+}
+
+func TestStack_SetIDPrefix(t *testing.T) {
+	stk := List().SetIDPrefix(`ldap`).SetID(`_random`)
+	if got := stk.ID(); len(got) != 29 || got[:5] != `ldap-` {
+		t.Errorf("%s failed: want 'ldap-<24 chars>', got '%s'", t.Name(), got)
+		return
+	} else if got = stk.IDPrefix(); got != `ldap` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `ldap`, got)
+		return
+	}
+
+	c := Cond(`cn`, Eq, `x`).SetIDPrefix(`filter`).SetID(`_RANDOM`)
+	if got := c.ID(); len(got) != 31 || got[:7] != `filter-` {
+		t.Errorf("%s failed: want 'filter-<24 chars>', got '%s'", t.Name(), got)
+		return
+	}
+
+	// _addr always reflects the current pointer, including
+	// after the configuration is marshaled into a new stack.
+	src := List().SetID(`_addr`).SetUnmarshalWithConfig(true).Push(`a`, c.SetID(`_addr`))
+	if got, want := src.ID(), src.Addr(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if got, want = c.ID(), c.Addr(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	um, _ := src.Unmarshal()
+	var dst Stack
+	if err := dst.Marshal(um); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got, want := dst.ID(), dst.Addr(); got != want || got == src.ID() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// read-only instances refuse changes
+	stk.SetReadOnly(true).SetIDPrefix(`other`)
+	c.SetReadOnly(true).SetIDPrefix(`other`)
+	if stk.IDPrefix() != `ldap` || c.IDPrefix() != `filter` {
+		t.Errorf("%s failed: read-only prefix was modified", t.Name())
+		return
+	}
+
+	var zero Stack
+	if got := zero.ID(); got != `unspecified` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `unspecified`, got)
+	}
+}
+
 func ExampleStack_Category() {
 	var stk Stack = Basic().Push(1, 2, 3, 4)
 	stk.SetCategory(`basic_stuff`)
@@ -1590,385 +2083,3629 @@ func ExampleStack_Delimiter() {
 	// Output: ,
 }
 
-func TestInterface(t *testing.T) {
-	var elem Interface
-	elem = Cond(`greeting`, Eq, `Hello`)
-	want := `greeting = Hello`
-	got := elem.String()
-	if want != got {
-		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
-		return
-	}
+func ExampleStack_WriteTo() {
+	var buf bytes.Buffer
+	A := And().Paren().Push(
+		`testing1`,
+		`testing2`,
+		Or().Paren().Push(`testing3`, `testing4`),
+	)
+
+	n, err := A.WriteTo(&buf)
+	fmt.Printf("%s (%d bytes; err:%t)", buf.String(), n, err != nil)
+	// Output: ( testing1 AND testing2 AND ( testing3 OR testing4 ) ) (54 bytes; err:false)
 }
 
-func TestStack_Reveal_experimental001(t *testing.T) {
-	custom := Cond(`outer`, Ne, customStack(And().Push(Cond(`keyword`, Eq, "somevalue"))))
+type failWriter struct{}
 
-	thisIsMyNightmare := And().Push(
-		`this1`,
-		Or().Mutex().Push(
-			custom,
-			And().Push(
-				`this4`,
-				Not().Mutex().Push(
-					Or().Push(
-						Cond(`dayofweek`, Ne, "Wednesday"),
-						Cond(`ssf`, Ge, "128"),
-						Cond(`greeting`, Ne, List().Push(List().Push(List().Push(``)))),
-					),
-				),
-			),
-			And().Push(
-				Or().Push(
-					Cond(`keyword2`, Lt, "someothervalue"),
-				),
-			),
-			Cond(`keyword`, Gt, Or().Push(`...`)),
-		),
-		`this2`,
-	)
+func (r failWriter) Write(_ []byte) (int, error) {
+	return 0, errorf("write failure")
+}
 
-	type row struct {
-		Index int
-		Path  [][]int
-		Want  string
-		Value any
+func TestStack_WriteTo(t *testing.T) {
+	for idx, stk := range []Stack{
+		And().Paren().Fold().Push(`a`, Not().Paren().Push(`b`), Or().Push(`c`, `d`)),
+		List().SetDelimiter(`,`).Push(`a`, List().Push(), `b`, `c`),
+		Or().Symbol(`||`).NoPadding().Push(`x`, Cond(`kw`, Eq, `val`)),
+		And().LeadOnce().Symbol('&').Paren().Push(`x`, `y`, And().Push()),
+		List().Push(`héllo`, `wörld`),
+		And().SetPresentationPolicy(func(_ ...any) string { return `custom` }).Push(`a`),
+	} {
+		var buf bytes.Buffer
+		n, err := stk.WriteTo(&buf)
+		if want, got := stk.String(), buf.String(); err != nil || want != got {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s' (err:%v)",
+				t.Name(), idx, want, got, err)
+			return
+		} else if int(n) != len(want) {
+			t.Errorf("%s failed [idx:%d]: want '%d' bytes, got '%d'",
+				t.Name(), idx, len(want), n)
+			return
+		}
 	}
 
-	table := []row{
-		{0, [][]int{{1, 2, 0, 0}, {1, 2}}, ``, nil},
-		{1, [][]int{{1, 1, 1, 0, 0}, {1, 1, 1, 0, 0}}, ``, nil},
-		//{2, [][]int{{1, 1, 1, 0, 2, 0, 0, 0}, {1, 1, 1, 0, 2}}, ``, nil},
+	var buf bytes.Buffer
+	if n, err := Basic().Push(`a`, `b`).WriteTo(&buf); n != 0 || err != nil || buf.Len() != 0 {
+		t.Errorf("%s failed: want '0, <nil>', got '%d, %v'", t.Name(), n, err)
+		return
 	}
 
-	// Scan the target values we'll be using for
-	// comparison after processing completes.
-	for idx, tst := range table {
-		slice, _ := thisIsMyNightmare.Traverse(tst.Path[0]...)
-		var c Condition
-		var ok bool
-		if c, ok = slice.(Condition); !ok {
-			t.Errorf("%s failed [idx:%d;pre-mod:path:%v]: unexpected assertion result:\nwant: %T\ngot:  %s",
-				t.Name(), idx, tst.Path[0], c, slice.(string))
-			return
-		}
-		table[idx].Want = c.String()
-		tst.Value = c
+	if _, err := And().Push(`a`).WriteTo(nil); err == nil {
+		t.Errorf("%s failed: expected error for nil io.Writer, got nil", t.Name())
+		return
 	}
 
-	// save for comparison later
-	var want string = thisIsMyNightmare.String()
+	if _, err := And().Push(`a`, `b`).WriteTo(failWriter{}); err == nil {
+		t.Errorf("%s failed: expected writer error, got nil", t.Name())
+	}
+}
 
-	// do reveal recursion
-	thisIsMyNightmare.Reveal()
+func largeList(n int) Stack {
+	L := List().SetDelimiter(`,`)
+	for i := 0; i < n; i++ {
+		L.Push(`element` + strconv.Itoa(i))
+	}
 
-	// make sure the complete string is identical
-	// before and after.
-	if got := thisIsMyNightmare.String(); want != got {
-		t.Errorf("%s failed [main strcmp]:\nwant '%s'\ngot  '%s',",
-			t.Name(), want, got)
+	return L
+}
+
+func BenchmarkStack_String(b *testing.B) {
+	L := largeList(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = L.String()
+	}
+}
+
+func BenchmarkStack_String_10k(b *testing.B) {
+	L := largeList(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = L.String()
+	}
+}
+
+func BenchmarkStack_IsEqual_10k(b *testing.B) {
+	L, M := largeList(10000), largeList(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = L.IsEqual(M)
+	}
+}
+
+func BenchmarkStack_WriteTo(b *testing.B) {
+	L := largeList(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = L.WriteTo(io.Discard)
+	}
+}
+
+/*
+castStack simulates a user-defined Stack alias which implements
+the StackCaster interface.
+*/
+type castStack Stack
+
+func (r castStack) CastStack() Stack { return Stack(r) }
+
+/*
+aliasList returns a LIST of n nested Stack slices, each of which
+contains a single string, and is wrapped using the alias function.
+*/
+func aliasList(n int, alias func(Stack) any) Stack {
+	L := List().SetDelimiter(`,`)
+	for i := 0; i < n; i++ {
+		L.Push(alias(List().Push(`element` + strconv.Itoa(i))))
+	}
+
+	return L
+}
+
+func converterBenchLists() map[string]func() Stack {
+	return map[string]func() Stack{
+		`plain`: func() Stack { return largeList(1000) },
+		`alias`: func() Stack {
+			return aliasList(1000, func(s Stack) any { return customStack(s) })
+		},
+		`caster`: func() Stack {
+			return aliasList(1000, func(s Stack) any { return castStack(s) })
+		},
+	}
+}
+
+func BenchmarkStack_String_converter(b *testing.B) {
+	for name, fn := range converterBenchLists() {
+		L := fn()
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = L.String()
+			}
+		})
+	}
+}
+
+func BenchmarkStack_IsEqual_converter(b *testing.B) {
+	for name, fn := range converterBenchLists() {
+		L, M := fn(), fn()
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = L.IsEqual(M)
+			}
+		})
+	}
+}
+
+func TestRegisterStackAlias(t *testing.T) {
+	type registeredStack Stack
+	type registeredCondition Condition
+
+	if !RegisterStackAlias(registeredStack{}) || !RegisterStackAlias(new(registeredStack)) {
+		t.Errorf("%s failed: alias registration refused", t.Name())
+		return
+	} else if RegisterStackAlias(`string`) || RegisterStackAlias(registeredCondition{}) {
+		t.Errorf("%s failed: non-alias registration accepted", t.Name())
+		return
+	} else if !RegisterConditionAlias(registeredCondition{}) || RegisterConditionAlias(Stack{}) {
+		t.Errorf("%s failed: unexpected condition alias registration result", t.Name())
 		return
 	}
 
-	// Scan the updated values at the defined paths
-	// and compare to original target values.
-	for idx, tst := range table {
-		slice, _ := thisIsMyNightmare.Traverse(tst.Path[1]...)
-		val, ok := slice.(Condition)
-		if !ok {
-			t.Errorf("%s failed [idx:%d;post-mod]: unexpected assertion result:\nwant: %T\ngot:  %T",
-				t.Name(), idx, val, slice)
+	want := `a AND b = c AND d AND e`
+	outer := And().Push(
+		`a`,
+		registeredCondition(Cond(`b`, Eq, `c`)),
+		registeredStack(And().Push(`d`)),
+		castStack(And().Push(`e`)),
+	)
+	if got := outer.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	for idx, x := range []any{
+		registeredStack(And()),
+		&customStack{},
+		castStack(And()),
+		castStack{},
+	} {
+		_, ok := ConvertStack(x)
+		if want := idx%2 == 0; ok != want {
+			t.Errorf("%s[%d] failed: want '%t', got '%t'", t.Name(), idx, want, ok)
 			return
 		}
+	}
+}
 
-		if gval := val.String(); tst.Want != gval {
-			t.Errorf("%s failed [idx:%d;strcmp]:\nwant '%s'\ngot  '%s',",
-				t.Name(), idx, tst.Want, gval)
+func TestConvertStacks(t *testing.T) {
+	type MyStack Stack
+	in := []any{customStack(And().Push(`a`)), `plain`, MyStack(Or().Push(`b`)), And(), 3}
+
+	stacks, failed, ok := ConvertStacks(in...)
+	if ok || len(stacks) != 3 || sprintf("%v", failed) != `[1 4]` {
+		t.Errorf("%s failed: want 3 stacks and failures [1 4], got %d and %v", t.Name(), len(stacks), failed)
+		return
+	} else if stacks[1].String() != `b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `b`, stacks[1])
+		return
+	}
+
+	if _, failed, ok = ConvertStacks(in[0], in[2]); !ok || failed != nil {
+		t.Errorf("%s failed: want complete conversion, got failures %v", t.Name(), failed)
+	}
+}
+
+func TestStack_ConvertMembers(t *testing.T) {
+	type MyStack Stack
+	type MyCondition Condition
+
+	S := List().SetDelimiter(`,`).Push(
+		customStack(And().Push(`a`)),
+		`plain`,
+		MyCondition(Cond(`b`, Eq, `c`)),
+		MyStack(Or().Push(`d`)),
+	)
+
+	N := S.ConvertMembers()
+	if N.Kind() != S.Kind() || N.String() != S.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), S, N)
+		return
+	}
+
+	for idx, want := range []string{`stackage.Stack`, `string`, `stackage.Condition`, `stackage.Stack`} {
+		slice, _ := N.Index(idx)
+		if got := sprintf("%T", slice); got != want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, want, got)
+			return
 		}
 	}
+
+	// receiver is unmodified
+	if slice, _ := S.Index(0); sprintf("%T", slice) != `stackage.customStack` {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+	}
 }
 
-func TestDefrag_experimental_001(t *testing.T) {
-	// this list contains an assortment of
-	// values mixed in with nils and a couple
-	// hierarchies tossed in, too.
-	var l Stack = List().SetLogLevel(LogLevel(45)).Push(
-		`this`,
-		nil,
-		`that`,
-		nil,
-		`those`,
-		nil,
-		List().Push(
-			nil, nil, nil, `other`, `level`, `of`, nil, nil, `stuff`,
+func TestInterface(t *testing.T) {
+	var elem Interface
+	elem = Cond(`greeting`, Eq, `Hello`)
+	want := `greeting = Hello`
+	got := elem.String()
+	if want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+}
+
+func TestAsStackInterface(t *testing.T) {
+	type aliasStack Stack
+	type aliasCondition Condition
+
+	raw := aliasStack(And().Push(`a`, aliasCondition(Cond(`b`, Ne, Or().Push(`c`)))))
+	stk, ok := AsStackInterface(raw)
+	if !ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), true, ok)
+		return
+	} else if got := stk.Kind(); got != `AND` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `AND`, got)
+		return
+	} else if got, _ := stk.Back(); got != `a` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `a`, got)
+		return
+	} else if stk.IsFIFO() {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, true)
+		return
+	} else if got, _ := stk.Traverse(1, 0); got != `c` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `c`, got)
+		return
+	}
+
+	slice, _ := stk.Front()
+	cond, ok := AsConditionInterface(slice)
+	if !ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), true, ok)
+		return
+	} else if got := cond.Keyword() + ` ` + cond.Operator().String(); got != `b !=` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `b !=`, got)
+		return
+	} else if _, ok = AsStackInterface(cond.Expression()); !ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), true, ok)
+		return
+	}
+
+	if _, ok = AsStackInterface(`string`); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+		return
+	} else if _, ok = AsConditionInterface(raw); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+	}
+}
+
+// nightmareStack returns a deliberately convoluted
+// structure of nested Stack and Condition instances.
+func nightmareStack() Stack {
+	custom := Cond(`outer`, Ne, customStack(And().Push(Cond(`keyword`, Eq, "somevalue"))))
+
+	return And().Push(
+		`this1`,
+		Or().Mutex().Push(
+			custom,
 			And().Push(
-				`yet`, nil, nil, nil, nil, `more`, `JUNK`,
+				`this4`,
+				Not().Mutex().Push(
+					Or().Push(
+						Cond(`dayofweek`, Ne, "Wednesday"),
+						Cond(`ssf`, Ge, "128"),
+						Cond(`greeting`, Ne, List().Push(List().Push(List().Push(``)))),
+					),
+				),
+			),
+			And().Push(
+				Or().Push(
+					Cond(`keyword2`, Lt, "someothervalue"),
+				),
 			),
+			Cond(`keyword`, Gt, Or().Push(`...`)),
 		),
-		nil,
-		Cond(`keyword`, Eq, Basic().Push(1, 2, nil, 3, nil, nil, 4)),
-		nil,
-		nil,
-		3.14159,
-		`moar`,
-		`moar`,
-		`moar`,
-		`moar`,
-		`moar`,
-		`moar`,
-		`moar`,
-		`moar`,
-		nil,
-		`moar`,
-		`moar`,
-		nil,
-		nil,
-		nil,
-		nil,
-	).Paren()
+		`this2`,
+	)
+}
 
-	offset := 13         // number of nil occurrences
-	beforeLen := l.Len() // record preop len
+func TestStack_ValidDeep(t *testing.T) {
+	thisIsMyNightmare := nightmareStack()
+	if err := thisIsMyNightmare.ValidDeep(); err != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
 
-	// verify no errors resulted from the attempt
-	// to defragment our stack
-	if err := l.Defrag(-1).Err(); err != nil {
+	// break two conditions, one of which resides
+	// within another condition's expression.
+	for _, path := range [][]int{{1, 1, 1, 0, 1}, {1, 0, 0}} {
+		slice, _ := thisIsMyNightmare.Traverse(path...)
+		slice.(Condition).SetKeyword(``)
+	}
+
+	err := thisIsMyNightmare.ValidDeep()
+	for _, want := range []string{
+		`slice [1][1][1][0][1]: keyword value is zero`,
+		`slice [1][0][0]: keyword value is zero`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s failed: want '%s', got '%v'", t.Name(), want, err)
+			return
+		}
+	}
+
+	// the receiver's own policy error is reported as-is
+	policyErr := errorf("receiver refused")
+	P := And().SetValidityPolicy(func(_ ...any) error { return policyErr })
+	if err = P.ValidDeep(); err != policyErr {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), policyErr, err)
+		return
+	}
+
+	// validate upon push
+	S := List().SetValidateOnPush(true).Push(
+		Cond(`a`, Eq, `b`),
+		And().Push(Cond(``, Eq, `c`)),
+		`unreached`,
+	)
+	if S.Len() != 1 || S.Err() == nil || !strings.Contains(S.Err().Error(), `slice [0]: keyword`) {
+		t.Errorf("%s failed: want len 1 and error, got %d and %v", t.Name(), S.Len(), S.Err())
+		return
+	}
+
+	// toggling disables validation
+	if S.SetErr(nil).SetValidateOnPush().Push(And().Push(Cond(``, Eq, `c`))); S.Len() != 2 || S.Err() != nil {
+		t.Errorf("%s failed: want len 2 and no error, got %d and %v", t.Name(), S.Len(), S.Err())
+		return
+	}
+
+	// cyclical stacks terminate, directly or by way of an expression
+	a := And().Push(`x`)
+	a.Push(a, Cond(`k`, Eq, Or().Push(a)))
+	err = a.ValidDeep()
+	for _, want := range []string{
+		`slice [1]: stack contains itself`,
+		`slice [2][0]: stack contains itself`,
+	} {
+		if !errors.Is(err, ErrCycle) || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s failed: want '%s', got '%v'", t.Name(), want, err)
+			return
+		}
+	}
+}
+
+func TestStack_SetReadOnlyDeep(t *testing.T) {
+	S := nightmareStack().SetReadOnlyDeep(true)
+	if !S.IsReadOnlyDeep() {
+		t.Errorf("%s failed: want deep read-only, got shallow", t.Name())
+		return
+	}
+
+	slice, _ := S.Traverse(1, 1, 1)
+	not := slice.(Stack)
+	if not.Push(`frozen`); not.Len() != 1 {
+		t.Errorf("%s failed: want len 1, got %d", t.Name(), not.Len())
+		return
+	}
+
+	// the aliased stack within a condition expression
+	slice, _ = S.Traverse(1, 0)
+	inner, _ := slice.(Condition).ExpressionAsStack()
+	if !inner.IsReadOnly() {
+		t.Errorf("%s failed: want read-only condition expression", t.Name())
+		return
+	}
+
+	// a single thawed node breaks the deep state
+	not.SetReadOnly(false)
+	if !S.IsReadOnly() || S.IsReadOnlyDeep() {
+		t.Errorf("%s failed: want shallow-only read-only state", t.Name())
+		return
+	}
+
+	S.SetReadOnlyDeep(false)
+	if S.IsReadOnly() || inner.IsReadOnly() {
+		t.Errorf("%s failed: want thawed tree", t.Name())
+		return
+	}
+
+	if not.Push(`thawed`); not.Len() != 2 {
+		t.Errorf("%s failed: want len 2, got %d", t.Name(), not.Len())
+		return
+	}
+
+	// toggle from the receiver's own state
+	if S.SetReadOnlyDeep(); !S.IsReadOnlyDeep() {
+		t.Errorf("%s failed: want toggled deep read-only", t.Name())
+	}
+}
+
+func TestStack_FreeDeep(t *testing.T) {
+	S := nightmareStack()
+	slice, _ := S.Traverse(1, 1)
+	frozen := slice.(Stack).SetReadOnly(true)
+
+	if err := S.FreeDeep(); !errors.Is(err, ErrReadOnly) || !strings.Contains(err.Error(), `slice [1][1]:`) {
+		t.Errorf("%s failed: want read-only error naming [1][1], got '%v'", t.Name(), err)
+		return
+	} else if !S.IsInit() || !frozen.IsInit() {
+		t.Errorf("%s failed: want nothing freed", t.Name())
+		return
+	}
+
+	// note members to verify after the fact
+	var members []any
+	for _, path := range [][]int{{1}, {1, 0}, {1, 1, 1}, {1, 1, 1, 0, 2}} {
+		slice, _ = S.Traverse(path...)
+		members = append(members, slice)
+	}
+	inner, _ := members[1].(Condition).ExpressionAsStack()
+	members = append(members, inner)
+
+	if err := S.FreeDeep(true); err != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	} else if !S.IsZero() {
+		t.Errorf("%s failed: want zero receiver", t.Name())
+		return
+	}
+
+	for idx, member := range members {
+		if AS, ok := member.(Stack); ok && AS.IsInit() {
+			t.Errorf("%s[%d] failed: stack not freed", t.Name(), idx)
+			return
+		} else if AC, ok := member.(Condition); ok && AC.IsInit() {
+			t.Errorf("%s[%d] failed: condition not freed", t.Name(), idx)
+			return
+		}
+	}
+
+	// closures and auxiliary content are released by Free
+	L := List().SetAuxiliary(Auxiliary{`key`: `value`}).SetPushPolicy(func(x ...any) error {
+		return nil
+	})
+	ref := L
+	if err := L.Free(); err != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+	} else if sc, _ := ref.stack.config(); sc.aux != nil || sc.ppf != nil {
+		t.Errorf("%s failed: want released configuration", t.Name())
+	}
+}
+
+func TestStack_SetOperatorContext(t *testing.T) {
+	S := List().SetOperatorContext(`matchingRule`).Push(
+		`not a condition`,
+		Cond(`cn`, fakeOperator{Str: `:caseExactMatch:=`, Ctx: `matchingRule`}, `jesse`),
+		Cond(`cn`, fakeOperator{Str: `~=`, Ctx: `approx`}, `jesse`),
+		Cond(`cn`, Eq, `jesse`),
+	)
+
+	if S.Len() != 2 || S.Err() == nil || !strings.Contains(S.Err().Error(), `want 'matchingRule'`) {
+		t.Errorf("%s failed: want len 2 and error, got %d and %v", t.Name(), S.Len(), S.Err())
+		return
+	}
+
+	// zero context disables the check
+	if S.SetOperatorContext(``).Push(Cond(`cn`, Eq, `jesse`)); S.Len() != 3 {
+		t.Errorf("%s failed: want len %d, got %d", t.Name(), 3, S.Len())
+	}
+}
+
+func TestStack_SetOperatorRules(t *testing.T) {
+	S := And().SetOperatorRules(OperatorRules{
+		`objectClass`: EqualityOperators(),
+		`age`:         append(EqualityOperators(), OrderingOperators()...),
+	})
+
+	S.Push(
+		Cond(`objectClass`, Eq, `person`),
+		Cond(`age`, Ge, 21),
+		Cond(`objectClass`, Ge, `person`),
+		Cond(`cn`, Eq, `jesse`),
+	)
+
+	if S.Len() != 2 || S.Err() == nil || !strings.Contains(S.Err().Error(), `not permitted`) {
+		t.Errorf("%s failed: want len 2 and error, got %d and %v", t.Name(), S.Len(), S.Err())
+		return
+	}
+
+	// per-condition rules take precedence
+	S.SetErr(nil).Push(Cond(`objectClass`, Ge, `person`).
+		SetOperatorRules(OperatorRules{`objectClass`: OrderingOperators()}))
+	if S.Len() != 3 || S.Err() != nil {
+		t.Errorf("%s failed: want len 3, got %d (%v)", t.Name(), S.Len(), S.Err())
+		return
+	} else if err := S.ValidDeep(); err != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+		return
+	}
+
+	// members altered following their push are caught
+	slice, _ := S.Index(1)
+	slice.(Condition).SetKeyword(`objectClass`)
+	if err := S.ValidDeep(); err == nil || !strings.Contains(err.Error(), `slice [1]`) {
+		t.Errorf("%s failed: want error for slice [1], got %v", t.Name(), err)
+		return
+	}
+
+	// nil rules remove the restriction
+	if err := S.SetOperatorRules(nil).ValidDeep(); err != nil || S.OperatorRules() != nil {
+		t.Errorf("%s failed: unexpected error: %v", t.Name(), err)
+	}
+}
+
+func TestStack_SetMaxDepth(t *testing.T) {
+	S := List().SetMaxDepth(2)
+
+	// three levels deep, one of which is a condition expression
+	S.Push(And().Push(Cond(`a`, Eq, Or().Push(List().Push(`b`)))))
+	if S.Len() != 0 || S.Err() == nil {
+		t.Errorf("%s failed: want len 0 and error, got %d and %v", t.Name(), S.Len(), S.Err())
+		return
+	}
+
+	S.SetErr(nil).Push(And().Push(Cond(`a`, Eq, Or().Push(`b`))))
+	if S.Len() != 1 || S.Err() != nil || S.Depth() != 2 {
+		t.Errorf("%s failed: want len 1 and depth 2, got %d and %d (%v)",
+			t.Name(), S.Len(), S.Depth(), S.Err())
+		return
+	}
+
+	if got := nightmareStack().Depth(); got != 7 {
+		t.Errorf("%s failed: want depth %d, got %d", t.Name(), 7, got)
+		return
+	}
+
+	// zero disables the check
+	S.SetMaxDepth(0).Push(Basic().Push(Basic().Push(Basic().Push(1))))
+	if S.Len() != 2 || S.Depth() != 3 {
+		t.Errorf("%s failed: want len 2 and depth 3, got %d and %d", t.Name(), S.Len(), S.Depth())
+	}
+}
+
+func TestStack_ContainsCycle(t *testing.T) {
+	a := And().Push(`x`)
+	a.Push(a)
+
+	if got, want := a.String(), `x AND <recursive>`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if !a.ContainsCycle() {
+		t.Errorf("%s failed: cycle not detected", t.Name())
+		return
+	} else if err := a.IsEqual(a); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if _, err = a.Unmarshal(); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if _, err = a.DefragReport(); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if err = a.SetErr(nil).Reveal(); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if _, err = a.Select(`**`); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if err = a.SetErr(nil).Normalize().Err(); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if err = a.SetErr(nil).Negate().Err(); !errors.Is(err, ErrCycle) || a.Kind() != `AND` {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	}
+
+	// indirect cycle by way of a condition expression
+	b := Or().Push(`y`)
+	b.Push(Cond(`k`, Eq, List().Push(b)))
+	if got, want := b.String(), `y OR k = <recursive>`; got != want || !b.ContainsCycle() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if _, err := b.Select(`**/Condition`); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	} else if err = b.Normalize().Err(); !errors.Is(err, ErrCycle) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCycle, err)
+		return
+	}
+
+	// shared (but acyclic) instances are fine
+	shared := List().Push(`z`)
+	if c := And().Push(shared, shared); c.ContainsCycle() || c.String() != `z AND z` {
+		t.Errorf("%s failed: unexpected cycle in '%s'", t.Name(), c)
+	}
+}
+
+func TestStack_Reveal_experimental001(t *testing.T) {
+	thisIsMyNightmare := nightmareStack()
+
+	type row struct {
+		Index int
+		Path  [][]int
+		Want  string
+		Value any
+	}
+
+	table := []row{
+		{0, [][]int{{1, 2, 0, 0}, {1, 2}}, ``, nil},
+		{1, [][]int{{1, 1, 1, 0, 0}, {1, 1, 1, 0, 0}}, ``, nil},
+		//{2, [][]int{{1, 1, 1, 0, 2, 0, 0, 0}, {1, 1, 1, 0, 2}}, ``, nil},
+	}
+
+	// Scan the target values we'll be using for
+	// comparison after processing completes.
+	for idx, tst := range table {
+		slice, _ := thisIsMyNightmare.Traverse(tst.Path[0]...)
+		var c Condition
+		var ok bool
+		if c, ok = slice.(Condition); !ok {
+			t.Errorf("%s failed [idx:%d;pre-mod:path:%v]: unexpected assertion result:\nwant: %T\ngot:  %s",
+				t.Name(), idx, tst.Path[0], c, slice.(string))
+			return
+		}
+		table[idx].Want = c.String()
+		tst.Value = c
+	}
+
+	// save for comparison later
+	var want string = thisIsMyNightmare.String()
+
+	// do reveal recursion
+	if err := thisIsMyNightmare.Reveal(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// make sure the complete string is identical
+	// before and after.
+	if got := thisIsMyNightmare.String(); want != got {
+		t.Errorf("%s failed [main strcmp]:\nwant '%s'\ngot  '%s',",
+			t.Name(), want, got)
+		return
+	}
+
+	// Scan the updated values at the defined paths
+	// and compare to original target values.
+	for idx, tst := range table {
+		slice, _ := thisIsMyNightmare.Traverse(tst.Path[1]...)
+		val, ok := slice.(Condition)
+		if !ok {
+			t.Errorf("%s failed [idx:%d;post-mod]: unexpected assertion result:\nwant: %T\ngot:  %T",
+				t.Name(), idx, val, slice)
+			return
+		}
+
+		if gval := val.String(); tst.Want != gval {
+			t.Errorf("%s failed [idx:%d;strcmp]:\nwant '%s'\ngot  '%s',",
+				t.Name(), idx, tst.Want, gval)
+		}
+	}
+
+	// NOT-wrapped singletons shrink by one level
+	// without altering the string value.
+	for idx, tst := range []struct {
+		Stack      Stack
+		Pre, Post  []int
+		WantString string
+	}{
+		{And().Push(`x`, Not().Push(And().Push(Cond(`a`, Eq, `b`)))),
+			[]int{1, 0, 0}, []int{1, 0}, `x AND NOT a = b`},
+		{And().Push(`x`, Not().Push(Or().Push(Cond(`a`, Eq, `b`))).SetParen(true)),
+			[]int{1, 0, 0}, []int{1, 0}, `x AND NOT ( a = b )`},
+		{Or().Push(Not().Push(Cond(`a`, Eq, `b`), And().Push(Cond(`c`, Eq, `d`)))),
+			[]int{0, 1, 0}, []int{0, 1}, `NOT a = b NOT c = d`},
+	} {
+		if _, ok := tst.Stack.Traverse(tst.Pre...); !ok {
+			t.Errorf("%s[%d] failed [pre-mod]: path %v not found", t.Name(), idx, tst.Pre)
+			return
+		}
+
+		if err := tst.Stack.Reveal(); err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		} else if got := tst.Stack.String(); got != tst.WantString {
+			t.Errorf("%s[%d] failed [strcmp]:\nwant '%s'\ngot  '%s',",
+				t.Name(), idx, tst.WantString, got)
+			return
+		}
+
+		if _, ok := tst.Stack.Traverse(tst.Pre...); ok {
+			t.Errorf("%s[%d] failed [post-mod]: path %v still present", t.Name(), idx, tst.Pre)
+			return
+		} else if c, _ := tst.Stack.Traverse(tst.Post...); !isCondition(c) {
+			t.Errorf("%s[%d] failed [post-mod]: want %T at %v, got %T",
+				t.Name(), idx, Condition{}, tst.Post, c)
+			return
+		}
+	}
+
+	// double negation collapses structurally
+	dbl := And().Push(`x`, Not().Push(Not().Push(Cond(`a`, Eq, `b`))))
+	dbl.Reveal()
+	if got, want := dbl.String(), `x AND a = b`; got != want {
+		t.Errorf("%s failed [double negation]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// ... but not when parenthetical
+	dbl = And().Push(`x`, Not().Push(Not().Push(Cond(`a`, Eq, `b`)).SetParen(true)))
+	dbl.Reveal()
+	if got, want := dbl.String(), `x AND NOT NOT ( a = b )`; got != want {
+		t.Errorf("%s failed [double negation]: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestStack_RevealCopy(t *testing.T) {
+	orig := And().Push(`x`, Not().Push(And().Push(Cond(`a`, Eq, `b`)))).SetReadOnly(true)
+	str := orig.String()
+
+	S, err := orig.RevealCopy()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := S.String(); got != str {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), str, got)
+		return
+	} else if c, _ := S.Traverse(1, 0); !isCondition(c) {
+		t.Errorf("%s failed [copy]: want %T at [1 0], got %T", t.Name(), Condition{}, c)
+		return
+	} else if !S.IsReadOnly() {
+		t.Errorf("%s failed: want read-only copy", t.Name())
+		return
+	}
+
+	// the original is untouched
+	if got := orig.String(); got != str {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), str, got)
+		return
+	} else if _, ok := orig.Traverse(1, 0, 0); !ok {
+		t.Errorf("%s failed [orig]: path [1 0 0] not found", t.Name())
+		return
+	}
+
+	// read-only nested instances cannot be disenveloped
+	ro := And().Push(`x`, Or().Push(Cond(`a`, Eq, `b`)).SetReadOnly(true))
+	if _, err = ro.RevealCopy(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+		return
+	} else if err = ro.Reveal(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+		return
+	} else if err = ro.Err(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+		return
+	} else if _, ok := ro.Traverse(1, 0); !ok {
+		t.Errorf("%s failed: read-only instance was disenveloped", t.Name())
+		return
+	}
+
+	// a read-only receiver is refused outright
+	if err = orig.Reveal(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+		return
+	}
+
+	var z Stack
+	if _, err = z.RevealCopy(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+		return
+	} else if err = z.Reveal(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
+
+func isCondition(x any) bool {
+	_, ok := x.(Condition)
+	return ok
+}
+
+func TestStack_Conditions(t *testing.T) {
+	thisIsMyNightmare := nightmareStack()
+	want := thisIsMyNightmare.String()
+
+	if got := len(thisIsMyNightmare.Conditions()); got != 0 {
+		t.Errorf("%s failed [shallow]: want '%d', got '%d'", t.Name(), 0, got)
+		return
+	}
+
+	conds := thisIsMyNightmare.Conditions(true)
+	if got := len(conds); got != 7 {
+		t.Errorf("%s failed [deep]: want '%d', got '%d'", t.Name(), 7, got)
+		return
+	}
+
+	// verify encounter ordering
+	for idx, kw := range []string{
+		`outer`, `keyword`, `dayofweek`, `ssf`,
+		`greeting`, `keyword2`, `keyword`,
+	} {
+		if got := conds[idx].Keyword(); got != kw {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, kw, got)
+			return
+		}
+	}
+
+	if got := thisIsMyNightmare.String(); got != want {
+		t.Errorf("%s failed [mutation]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	var s Stack
+	if s.Conditions(true) != nil || s.Keywords(true) != nil {
+		t.Errorf("%s failed [zero]: want nil slices", t.Name())
+	}
+}
+
+func TestStack_Keywords(t *testing.T) {
+	want := `outer,keyword,dayofweek,ssf,greeting,keyword2`
+	if got := join(nightmareStack().Keywords(true), `,`); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	shallow := And().Push(
+		Cond(`b`, Eq, `1`),
+		Or().Push(Cond(`c`, Eq, `2`)),
+		Cond(`a`, Ne, `3`),
+		Cond(`b`, Ne, `4`),
+	)
+	if got := join(shallow.Keywords(), `,`); got != `b,a` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `b,a`, got)
+	}
+}
+
+func TestStack_Normalize(t *testing.T) {
+	thisIsMyNightmare := nightmareStack()
+	want := thisIsMyNightmare.String()
+
+	if got := thisIsMyNightmare.Normalize().String(); want != got {
+		t.Errorf("%s failed [nightmare strcmp]:\nwant '%s'\ngot  '%s'", t.Name(), want, got)
+		return
+	}
+
+	A := And().Push(
+		`a`,
+		And().Push(`b`, And().Push(`c`)),
+		Or().Push(And().Push(`d`, `e`)),
+		Or().Paren().Push(`f`),
+		Cond(`keyword`, Eq, Or().Paren().Push(Or().Push(`g`, `h`), `i`)),
+	)
+	want = A.String()
+
+	if A.Normalize(MergeSameKind, CollapseSingletons); A.String() != want {
+		t.Errorf("%s failed [strcmp]:\nwant '%s'\ngot  '%s'", t.Name(), want, A)
+		return
+	}
+
+	if A.Len() != 7 {
+		t.Errorf("%s failed [len]: want '%d', got '%d'", t.Name(), 7, A.Len())
+		return
+	}
+
+	for idx, val := range []string{`a`, `b`, `c`, `d`, `e`} {
+		if slice, _ := A.Index(idx); slice != val {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%v'", t.Name(), idx, val, slice)
+			return
+		}
+	}
+
+	slice, _ := A.Index(6)
+	if got := slice.(Condition).Expression().(Stack).Len(); got != 3 {
+		t.Errorf("%s failed [expr len]: want '%d', got '%d'", t.Name(), 3, got)
+		return
+	}
+
+	// only singletons should be collapsed here
+	O := Or().Push(Or().Push(`a`, `b`), And().Push(`c`))
+	if O.Normalize(CollapseSingletons); O.Len() != 2 {
+		t.Errorf("%s failed [collapse]: want '%d', got '%d'", t.Name(), 2, O.Len())
+		return
+	}
+
+	// read-only receivers are not processed
+	R := And().Push(`a`, And().Push(`b`, `c`)).ReadOnly()
+	if R.Normalize(); R.Len() != 2 {
+		t.Errorf("%s failed [ronly]: want '%d', got '%d'", t.Name(), 2, R.Len())
+	}
+}
+
+func defragFixture() Stack {
+	// this list contains an assortment of
+	// values mixed in with nils and a couple
+	// hierarchies tossed in, too.
+	return List().SetLogLevel(LogLevel(45)).Push(
+		`this`,
+		nil,
+		`that`,
+		nil,
+		`those`,
+		nil,
+		List().Push(
+			nil, nil, nil, `other`, `level`, `of`, nil, nil, `stuff`,
+			And().Push(
+				`yet`, nil, nil, nil, nil, `more`, `JUNK`,
+			),
+		),
+		nil,
+		Cond(`keyword`, Eq, Basic().Push(1, 2, nil, 3, nil, nil, 4)),
+		nil,
+		nil,
+		3.14159,
+		`moar`,
+		`moar`,
+		`moar`,
+		`moar`,
+		`moar`,
+		`moar`,
+		`moar`,
+		`moar`,
+		nil,
+		`moar`,
+		`moar`,
+		nil,
+		nil,
+		nil,
+		nil,
+	).Paren()
+}
+
+func TestStack_Stats(t *testing.T) {
+	l := defragFixture().SetReadOnly(true)
+
+	for idx, tst := range []struct {
+		Recurse bool
+		Want    StackStats
+	}{
+		{false, StackStats{27, 1, 1, 11, 1, map[string]int{
+			`string`: 13, `<nil>`: 11, `float64`: 1,
+			`stackage.Stack`: 1, `stackage.Condition`: 1,
+		}}},
+		{true, StackStats{51, 1, 3, 23, 3, map[string]int{
+			`string`: 20, `<nil>`: 23, `float64`: 1, `int`: 4,
+			`stackage.Stack`: 2, `stackage.Condition`: 1,
+		}}},
+	} {
+		got := l.Stats(tst.Recurse)
+		if want := sprintf("%v", tst.Want); sprintf("%v", got) != want {
+			t.Errorf("%s[%d] failed:\nwant '%s'\ngot  '%v'", t.Name(), idx, want, got)
+			return
+		}
+	}
+
+	// aliases count under the native kinds
+	got := List().Push(customStack(And().Push(`a`))).Stats(true)
+	if got.NestedStacks != 1 || got.Total != 2 || got.ByType[`stackage.customStack`] != 1 {
+		t.Errorf("%s failed [alias]: unexpected stats %v", t.Name(), got)
+	}
+}
+
+func TestDefrag_experimental_001(t *testing.T) {
+	var l Stack = defragFixture()
+
+	offset := 11         // number of nil occurrences
+	beforeLen := l.Len() // record preop len
+
+	// verify no errors resulted from the attempt
+	// to defragment our stack
+	if err := l.Defrag(-1).Err(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// verify starting length minus offset is equal
+	// to the result length, meaning <offset> slices
+	// were truncated.
+	offsetLen := beforeLen - offset
+	if afterLen := l.Len(); offsetLen != afterLen {
+		t.Errorf("%s failed: unexpected length; want '%d', got '%d'",
+			t.Name(), offsetLen, afterLen)
+		return
+	}
+
+}
+
+func TestStack_Defrag_patterns(t *testing.T) {
+	for idx, tst := range []struct {
+		In   []any
+		Max  int
+		Want string
+	}{
+		{[]any{nil, nil, `a`, `b`}, 0, `a b`},
+		{[]any{`a`, `b`, nil, nil}, 0, `a b`},
+		{[]any{nil, `a`, nil, `b`, nil, `c`, nil}, 0, `a b c`},
+		{[]any{nil, `a`, nil, nil, `b`, nil, nil, nil, `c`}, 0, `a b c`},
+		{[]any{`a`, nil, `b`, nil, nil, `c`, `d`, nil, `e`}, 0, `a b c d e`},
+		{[]any{nil, nil, nil, nil}, 0, ``},
+		{[]any{nil}, 0, ``},
+		{[]any{`a`, `b`, `c`}, 0, `a b c`},
+		{[]any{`a`, nil, `b`, nil, `c`, nil, `d`, nil, `e`, nil, `f`}, 0, `a b c d e f`},
+		{[]any{nil, nil, nil, `a`, `b`, nil, `c`, nil, nil, `d`}, 0, `a b c d`},
+		{[]any{`a`, nil, nil, nil, `b`, nil, `c`}, 2, `a <nil> <nil> <nil> b <nil> c`},
+		{[]any{nil, `a`, nil, nil, nil, `b`}, 3, `a <nil> <nil> <nil> b`},
+		{[]any{`a`, nil, nil, `b`, nil, nil, nil, `c`}, 3, `a b <nil> <nil> <nil> c`},
+	} {
+		S := List().Push(tst.In...)
+
+		nils := S.stack.nils()
+		stats, err := S.DefragReport(tst.Max)
+		if err != nil {
+			t.Errorf("%s[%d] failed: %v", t.Name(), idx, err)
+			return
+		}
+
+		var vals []string
+		for i := 0; i < S.Len(); i++ {
+			slice, _ := S.Index(i)
+			vals = append(vals, sprintf("%v", slice))
+		}
+
+		if got := join(vals, ` `); got != tst.Want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		} else if stats.RemovedCount != nils-S.stack.nils() {
+			t.Errorf("%s[%d] failed: want removed %d, got %d", t.Name(), idx,
+				nils-S.stack.nils(), stats.RemovedCount)
+			return
+		}
+	}
+}
+
+func TestStack_DefragReport(t *testing.T) {
+	var l Stack = List().Push(
+		`this`,
+		`that`,
+		List().Push(`other`, `level`),
+		Cond(`keyword`, Eq, Basic().Push(1, 2, 3)),
+		And().ReadOnly().Push(`skipped`),
+	)
+
+	stats, err := l.DefragReport()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if stats.RemovedCount != 0 || stats.NestedProcessed != 2 || stats.Truncated {
+		t.Errorf("%s failed: want '0/2/false', got '%d/%d/%t'", t.Name(),
+			stats.RemovedCount, stats.NestedProcessed, stats.Truncated)
+		return
+	}
+
+	for idx, want := range [][]int{{2}, {3}} {
+		if got := stats.Details[idx].Path; fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%s failed [idx:%d]: want '%v', got '%v'", t.Name(), idx, want, got)
+			return
+		}
+	}
+
+	// The consecutive nil maximum will be reached
+	// before the final slice is reached, and the
+	// stats should tell us so.
+	l = List().Push(`this`, `that`, nil, nil, nil, `those`)
+	if stats, _ = l.DefragReport(2); !stats.Truncated || stats.RemovedCount != 0 {
+		t.Errorf("%s failed: want truncated '%t', got '%t'", t.Name(), true, stats.Truncated)
+		return
+	}
+
+	var s Stack
+	if stats, err = s.DefragReport(); err != nil || stats.NestedProcessed != 0 {
+		t.Errorf("%s failed: want '<nil>', got '%v'", t.Name(), err)
+	}
+}
+
+func TestStack_Defrag_conditionAlias(t *testing.T) {
+	c := Cond(`keyword`, Eq, customStack(List().Push(nil, 1, nil, nil, 2, nil)))
+	l := List().Push(`this`, c)
+
+	str := c.String() // prime the cache
+	if l.Defrag(); c.Len() != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, c.Len())
+		return
+	}
+
+	if got := l.MustTraverse(1, 1); got != 2 {
+		t.Errorf("%s failed: want '%d', got '%v'", t.Name(), 2, got)
+		return
+	} else if _, err := l.TraverseErr(1, 2); err == nil {
+		t.Errorf("%s failed: expected error, got nil", t.Name())
+		return
+	}
+
+	if _, ok := c.Expression().(Stack); !ok {
+		t.Errorf("%s failed: want '%T', got '%T'", t.Name(), Stack{}, c.Expression())
+		return
+	}
+
+	if got := c.String(); got == str || got != `keyword = 1 2` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `keyword = 1 2`, got)
+	}
+}
+
+func TestStack_TransferN(t *testing.T) {
+	src := List().Push(`a`, `b`, `c`, `d`)
+
+	// destination with existing content
+	dst := List(4).Push(`x`)
+	if ct, err := src.TransferN(dst, 2); err != nil || ct != 2 {
+		t.Errorf("%s failed: want '%d', got '%d' (%v)", t.Name(), 2, ct, err)
+		return
+	} else if got := dst.String(); got != `x a b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `x a b`, got)
+		return
+	}
+
+	// exact fit
+	if ct, err := src.TransferN(dst, 1); err != nil || ct != 1 {
+		t.Errorf("%s failed: want '%d', got '%d' (%v)", t.Name(), 1, ct, err)
+		return
+	}
+
+	// over capacity
+	if ct, err := src.Transfer(List(5).Push(`x`, `y`)); !errors.Is(err, ErrCapacityViolation) || ct != 0 {
+		t.Errorf("%s failed: want '%v', got '%v' (%d)", t.Name(), ErrCapacityViolation, err, ct)
+		return
+	}
+
+	// read-only destination
+	if _, err := src.Transfer(List().SetReadOnly(true)); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+	}
+}
+
+func TestStack_Move(t *testing.T) {
+	src := List().Push(`a`, `b`, `c`)
+	if _, err := src.Move(List(2)); !errors.Is(err, ErrCapacityViolation) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCapacityViolation, err)
+		return
+	} else if src.Len() != 3 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 3, src.Len())
+		return
+	}
+
+	dst := List(4).Push(`x`)
+	if ct, err := src.Move(dst); err != nil || ct != 3 {
+		t.Errorf("%s failed: want '%d', got '%d' (%v)", t.Name(), 3, ct, err)
+		return
+	} else if src.Len() != 0 || dst.Len() != 4 {
+		t.Errorf("%s failed: want '%d/%d', got '%d/%d'", t.Name(), 0, 4, src.Len(), dst.Len())
+	}
+}
+
+func TestStack_withCap(t *testing.T) {
+	src := Basic().Push(
+		`element0`,
+		`element1`,
+		`element2`,
+		`element3`,
+		`element4`,
+		`element5`,
+		`element6`,
+		`element7`,
+		`element8`,
+		`element9`,
+	)
+
+	dst := Basic(3).Push(
+		`element0a`,
+		`element0b`,
+		`element0c`,
+	)
+
+	src.Transfer(dst)
+
+	if dst.Len() != 3 {
+		t.Errorf("%s failed; transfer process bypassed capacity constraints; want len:%d, got len:%d",
+			t.Name(), 3, dst.Len())
+		return
+	}
+
+	dst.Insert(struct{}{}, 2)
+	if dst.Len() != 3 {
+		t.Errorf("%s failed; insert process bypassed capacity constraints; want len:%d, got len:%d",
+			t.Name(), 3, dst.Len())
+		return
+	}
+}
+
+func TestStack_Reset(t *testing.T) {
+	for idx, reset := range []func(Stack){
+		Stack.Reset,
+		Stack.ResetKeepCap,
+	} {
+		stk := List(5).SetDelimiter(`,`).Push(`a`, `b`, `c`, `d`, `e`)
+		reset(stk)
+
+		if stk.Len() != 0 || stk.Delimiter() != `,` {
+			t.Errorf("%s failed [idx:%d]: want len '%d', got '%d'", t.Name(), idx, 0, stk.Len())
+			return
+		}
+
+		// capacity must still be enforced
+		stk.Push(`f`, `g`, `h`, `i`, `j`, `k`)
+		if stk.Len() != 5 {
+			t.Errorf("%s failed [idx:%d]: want len '%d', got '%d'", t.Name(), idx, 5, stk.Len())
+			return
+		}
+
+		if want, got := `f , g , h , i , j`, stk.String(); want != got {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, want, got)
+			return
+		}
+	}
+}
+
+func TestStack_Remove(t *testing.T) {
+	stk := List().SetDelimiter(`,`).Push(`a`, `b`, `c`, `d`, `e`)
+
+	if slice, ok := stk.Remove(2); !ok || slice != `c` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `c`, slice)
+		return
+	}
+
+	if slice, ok := stk.Remove(0); !ok || slice != `a` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `a`, slice)
+		return
+	}
+
+	if slice, ok := stk.Remove(2); !ok || slice != `e` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `e`, slice)
+		return
+	}
+
+	if want, got := `b , d`, stk.String(); want != got || stk.Len() != 2 {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if _, ok := stk.Remove(2); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+	}
+}
+
+func TestStack_RemoveRange(t *testing.T) {
+	stk := List().SetDelimiter(`,`).SetNoPadding(true).
+		Push(`0`, `1`, `2`, `3`, `4`, `5`, `6`, `7`, `8`, `9`)
+
+	removed, ok := stk.RemoveRange(3, 6)
+	if want, got := `[3 4 5 6]`, sprintf("%v", removed); !ok || want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if want, got = `0,1,2,7,8,9`, stk.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// out of range, reversed and negative bounds are refused by default
+	for idx, bounds := range [][]int{{4, 9}, {3, 1}, {-2, -1}} {
+		if _, ok = stk.RemoveRange(bounds[0], bounds[1]); ok {
+			t.Errorf("%s[%d] failed: want '%t', got '%t'", t.Name(), idx, false, ok)
+			return
+		}
+	}
+
+	stk.NegativeIndices(true).ForwardIndices(true)
+	if removed, ok = stk.RemoveRange(-2, 99); !ok || len(removed) != 2 {
+		t.Errorf("%s failed: want 2 removed, got %d (ok:%t)", t.Name(), len(removed), ok)
+		return
+	} else if want, got := `0,1,2,7`, stk.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	stk.SetReadOnly(true)
+	if _, ok = stk.RemoveRange(0, 1); ok || stk.Len() != 4 {
+		t.Errorf("%s failed: read-only receiver was modified", t.Name())
+	}
+}
+
+func TestStack_RemoveIf(t *testing.T) {
+	nested := List().Push(`keep`, `drop`)
+	stk := List().SetDelimiter(`,`).SetNoPadding(true).
+		Push(`a`, `drop`, nested, `b`, `drop`)
+
+	removed := stk.RemoveIf(func(x any) bool { return x == `drop` })
+	if len(removed) != 2 || stk.Len() != 3 {
+		t.Errorf("%s failed: want 2 removed and len 3, got %d and %d",
+			t.Name(), len(removed), stk.Len())
+		return
+	} else if nested.Len() != 2 {
+		t.Errorf("%s failed: nested stack was modified", t.Name())
+		return
+	}
+
+	removed = stk.RemoveIf(func(x any) bool {
+		_, ok := ConvertStack(x)
+		return ok
+	})
+	if len(removed) != 1 || removed[0].(Stack).Len() != 2 {
+		t.Errorf("%s failed: nested stack not removed whole", t.Name())
+		return
+	} else if want, got := `a,b`, stk.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if removed = stk.RemoveIf(func(any) bool { return true }); len(removed) != 2 || stk.Len() != 0 {
+		t.Errorf("%s failed: want 2 removed and len 0, got %d and %d",
+			t.Name(), len(removed), stk.Len())
+		return
+	} else if removed = stk.RemoveIf(nil); removed != nil {
+		t.Errorf("%s failed: want nil, got '%v'", t.Name(), removed)
+	}
+}
+
+func BenchmarkStackReset(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		L := largeList(10000)
+		b.StartTimer()
+		L.Reset()
+	}
+}
+
+func BenchmarkStackRemove(b *testing.B) {
+	L := largeList(10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		L.Remove(0)
+		L.Push(`element`)
+	}
+}
+
+func BenchmarkStack_Front(b *testing.B) {
+	L := largeList(100000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		L.Front()
+		L.Back()
+	}
+}
+
+func TestStack_Peek(t *testing.T) {
+	for _, fifo := range []bool{false, true} {
+		S := List().SetFIFO(fifo).Push(nil, `a`, `b`, nil)
+
+		// nil ends are returned, not skipped
+		if slice, ok := S.Front(); ok || slice != nil {
+			t.Errorf("%s failed [fifo:%t]: want nil front, got '%v'", t.Name(), fifo, slice)
+			return
+		} else if slice, ok = S.Back(); ok || slice != nil {
+			t.Errorf("%s failed [fifo:%t]: want nil back, got '%v'", t.Name(), fifo, slice)
+			return
+		}
+
+		// Peek reports what Pop removes
+		peeked, pok := S.Peek()
+		popped, ok := S.Pop()
+		if pok != ok || peeked != popped {
+			t.Errorf("%s failed [fifo:%t]: want '%v', got '%v'", t.Name(), fifo, popped, peeked)
+			return
+		}
+
+		S.Push(nil).Defrag(-1)
+		front, back := `b`, `a`
+		if fifo {
+			front, back = back, front
+		}
+
+		if slice, _ := S.Peek(); slice != front {
+			t.Errorf("%s failed [fifo:%t]: want '%s', got '%v'", t.Name(), fifo, front, slice)
+			return
+		} else if slice, _ = S.Back(); slice != back {
+			t.Errorf("%s failed [fifo:%t]: want '%s', got '%v'", t.Name(), fifo, back, slice)
+			return
+		}
+	}
+
+	if _, ok := List().Peek(); ok {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, ok)
+	}
+}
+
+func TestStack_SetTypeStringer(t *testing.T) {
+	RegisterStringer(noStringer{}, func(x any) string { return `global` })
+	defer RegisterStringer(noStringer{}, nil)
+
+	stk := List().SetDelimiter(`,`).NoPadding().Push(noStringer{`value`})
+	other := List().Push(noStringer{`value`})
+
+	stk.SetTypeStringer(&noStringer{}, func(x any) string {
+		return `local:` + x.(noStringer).Field
+	})
+
+	if want, got := `local:value`, stk.String(); want != got {
+		t.Errorf("%s failed [local]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if want, got := `global`, other.String(); want != got {
+		t.Errorf("%s failed [global]: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	stk.SetTypeStringer(noStringer{}, nil)
+	if want, got := `global`, stk.String(); want != got {
+		t.Errorf("%s failed [unset]: want '%s', got '%s'", t.Name(), want, got)
+	}
+}
+
+func TestStack_SwapOK(t *testing.T) {
+	stk := List().SetDelimiter(`,`).NoPadding().Push(`a`, `b`, `c`)
+
+	if stk.SwapOK(-1, 0) {
+		t.Errorf("%s failed [-1,0]: want '%t', got '%t'", t.Name(), false, true)
+		return
+	}
+
+	if stk.SwapOK(0, stk.Len()) {
+		t.Errorf("%s failed [0,len]: want '%t', got '%t'", t.Name(), false, true)
+		return
+	}
+
+	stk.NegativeIndices(true)
+	if !stk.SwapOK(-1, 0) || stk.String() != `c,b,a` {
+		t.Errorf("%s failed [negidx]: want '%s', got '%s'", t.Name(), `c,b,a`, stk)
+		return
+	}
+
+	if stk.SwapOK(-4, 0) {
+		t.Errorf("%s failed [-4,0]: want '%t', got '%t'", t.Name(), false, true)
+		return
+	}
+
+	stk.ReadOnly(true)
+	if stk.Swap(0, 1); stk.SwapOK(0, 1) || stk.String() != `c,b,a` {
+		t.Errorf("%s failed [ronly]: want '%s', got '%s'", t.Name(), `c,b,a`, stk)
+	}
+}
+
+func TestStack_SetChangeHook(t *testing.T) {
+	var events []string
+	hook := func(op string, idx int, value any) {
+		events = append(events, sprintf("%s:%d:%v", op, idx, value))
+	}
+
+	stk := List().SetChangeHook(hook)
+	stk.Push(`a`, `b`, `c`)
+	stk.Insert(`d`, 1)
+	stk.Replace(`e`, 0)
+	stk.Remove(2)
+	stk.Pop()
+	Basic().Push(`f`).Transfer(stk)
+	stk.Reset()
+
+	// changes to nested stacks do not count
+	inner := List().Push(`g`)
+	stk.Push(inner)
+	inner.Push(`h`)
+
+	stk.SetChangeHook(nil).Push(`i`)
+
+	want := join([]string{
+		`push:0:a`, `push:1:b`, `push:2:c`,
+		`insert:1:d`,
+		`replace:0:e`,
+		`remove:2:b`,
+		`pop:2:c`,
+		`transfer:2:f`,
+		`reset:-1:<nil>`,
+		`push:0:g`,
+	}, `,`)
+
+	if got := join(events, `,`); want != got {
+		t.Errorf("%s failed:\nwant '%s'\ngot  '%s'", t.Name(), want, got)
+		return
+	}
+
+	// nil slices are reported as removed, while nothing is reported
+	// if the only slice expires before it could be removed
+	events = nil
+	var armed, late bool
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	exp := List().SetChangeHook(hook).SetClock(func() time.Time {
+		if late {
+			return clock.Add(time.Hour)
+		}
+		late = armed
+		return clock
+	})
+	exp.Push(`k`, nil)
+	exp.SetTTL(time.Minute)
+	if _, ok := exp.Pop(); !ok {
+		armed = true
+		if _, ok = exp.Pop(); ok || exp.stack.ulen() != 1 {
+			t.Errorf("%s failed: want unsuccessful pop, got %t", t.Name(), ok)
+			return
+		}
+	}
+	if got := join(events, `,`); got != `push:0:k,push:1:<nil>,pop:1:<nil>` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `push:0:k,push:1:<nil>,pop:1:<nil>`, got)
+		return
+	}
+
+	events = nil
+	stk.SetChangeHook(hook).ReadOnly(true)
+	stk.Push(`j`)
+	stk.Reset()
+	if len(events) != 0 {
+		t.Errorf("%s failed [ronly]: want '%d' events, got '%d'", t.Name(), 0, len(events))
+	}
+}
+
+func TestStack_SortedInsert(t *testing.T) {
+	names := List().SetDelimiter(`,`).SetNoPadding(true).Push(
+		`Alice`, `Carol`, `Eve`, `Mallory`,
+	)
+	if !names.IsSorted() {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), true, false)
+		return
+	}
+
+	for idx, tst := range []struct {
+		Value string
+		Index int
+	}{
+		{`Bob`, 1},
+		{`Aaron`, 0},   // before first
+		{`Zed`, 6},     // after last
+		{`Carol`, 4},   // duplicate follows its equal
+		{`Mallory`, 7}, // duplicate of the former last
+	} {
+		i, ok := names.SortedInsert(tst.Value)
+		if !ok || i != tst.Index {
+			t.Errorf("%s failed [idx:%d]: want '%d', got '%d' (ok:%t)", t.Name(), idx, tst.Index, i, ok)
+			return
+		} else if !names.IsSorted() {
+			t.Errorf("%s failed [idx:%d]: stack no longer sorted: %s", t.Name(), idx, names)
+			return
+		}
+	}
+
+	want := `Aaron,Alice,Bob,Carol,Carol,Eve,Mallory,Mallory,Zed`
+	if got := names.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// unsorted stacks fall back to appending
+	unsorted := List().SetDelimiter(`,`).SetNoPadding(true).Push(`c`, `a`, `b`)
+	if unsorted.IsSorted() {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, true)
+		return
+	} else if i, ok := unsorted.SortedInsert(`a`); ok || i != 3 || unsorted.String() != `c,a,b,a` {
+		t.Errorf("%s failed: want '%s', got '%s' (idx:%d, ok:%t)", t.Name(), `c,a,b,a`, unsorted, i, ok)
+		return
+	}
+
+	// capacity, read-only state and push policies are honored
+	for idx, stk := range []Stack{
+		List(2).Push(`a`, `b`),
+		List().Push(`a`).SetReadOnly(true),
+		List().SetPushPolicy(func(...any) error { return errorf("refused") }),
+	} {
+		if i, ok := stk.SortedInsert(`b`); ok || i != -1 {
+			t.Errorf("%s failed [idx:%d]: want '%d', got '%d' (ok:%t)", t.Name(), idx, -1, i, ok)
+			return
+		}
+	}
+
+	// concurrent callers cannot disturb the order
+	locking := List().SetMutex()
+	done := make(chan bool)
+	for i := 0; i < 8; i++ {
+		go func(i int) {
+			for j := 0; j < 25; j++ {
+				locking.SortedInsert(strconv.Itoa((i*25 + j) % 10))
+			}
+			done <- true
+		}(i)
+	}
+	for i := 0; i < 8; i++ {
+		<-done
+	}
+	if locking.Len() != 200 || !locking.IsSorted() {
+		t.Errorf("%s failed: want 200 sorted slices, got %d (sorted:%t)", t.Name(), locking.Len(), locking.IsSorted())
+	}
+}
+
+func TestStack_InsertAfter(t *testing.T) {
+	for idx, tst := range []struct {
+		Neg   bool
+		After bool
+		Index int
+		Want  string
+	}{
+		{false, false, 0, `x,a,b,c`},
+		{false, false, -1, `x,a,b,c`},
+		{false, false, 3, `a,b,c,x`},
+		{false, false, 100, `a,b,c,x`},
+		{true, false, -1, `a,b,x,c`},
+		{true, false, -3, `x,a,b,c`},
+		{true, false, -4, `x,a,b,c`},
+		{false, true, 0, `a,x,b,c`},
+		{false, true, 2, `a,b,c,x`},
+		{false, true, -1, `x,a,b,c`},
+		{true, true, -1, `a,b,x,c`},
+		{true, true, -2, `a,x,b,c`},
+		{true, true, -3, `x,a,b,c`},
+		{true, true, -4, `x,a,b,c`},
+	} {
+		stk := List().SetDelimiter(`,`).NoPadding().Push(`a`, `b`, `c`)
+		stk.NegativeIndices(tst.Neg)
+
+		var ok bool
+		if tst.After {
+			ok = stk.InsertAfter(`x`, tst.Index)
+		} else {
+			ok = stk.Insert(`x`, tst.Index)
+		}
+
+		if got := stk.String(); !ok || got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s' (ok:%t)",
+				t.Name(), idx, tst.Want, got, ok)
+			return
+		}
+	}
+
+	// capacity enforcement
+	stk := List(3).SetDelimiter(`,`).NoPadding().Push(`a`, `b`)
+	if !stk.InsertAfter(`x`, -1) || stk.InsertAfter(`y`, 0) || stk.Insert(`z`, 0) {
+		t.Errorf("%s failed [cap]: want '%s', got '%s'", t.Name(), `x,a,b`, stk)
+		return
+	}
+
+	if got := stk.String(); got != `x,a,b` {
+		t.Errorf("%s failed [cap]: want '%s', got '%s'", t.Name(), `x,a,b`, got)
+		return
+	}
+
+	// before-last placement is subject to the same capacity
+	stk = List(3).SetDelimiter(`,`).NoPadding().NegativeIndices(true).Push(`a`, `b`)
+	if !stk.InsertAfter(`x`, -1) || stk.InsertAfter(`y`, -1) {
+		t.Errorf("%s failed [cap]: want '%s', got '%s'", t.Name(), `a,x,b`, stk)
+		return
+	}
+
+	if got := stk.String(); got != `a,x,b` {
+		t.Errorf("%s failed [cap]: want '%s', got '%s'", t.Name(), `a,x,b`, got)
+	}
+}
+
+func TestStack_codecov(t *testing.T) {
+	var s Stack
+	// panic checks
+	s.Len()
+	s.IsZero()
+	s.IsInit()
+	s.Valid()
+	s.Pop()
+
+	SetDefaultStackLogger(nil)
+	_ = lonce.String()
+
+	var ll logLevels
+	ll.shift(`trace`)
+	ll.shift(nil)
+	ll.shift('a')
+	ll.shift()
+	ll.shift(0)
+	ll.shift(65535)
+
+	ll.positive(`trace`)
+	ll.positive(nil)
+	ll.positive('a')
+	ll.positive(0)
+	ll.positive(65535)
+
+	ll.unshift(`trace`)
+	ll.unshift(nil)
+	ll.unshift('a')
+	ll.unshift()
+	ll.unshift(0)
+	ll.unshift(65535)
+
+	var lsys *logSystem = newLogSystem(sLogDefault)
+	if lsys.isZero() {
+		t.Errorf("%s failed: nil %T",
+			t.Name(), lsys.logger())
+	}
+
+	s.CanMutex()
+	s.Avail()
+	s.string(nil)
+	s.IsEncap()
+	s.SetAuxiliary(nil)
+	s.SetLogger(nil)
+	s.NoNesting()
+	s.NoNesting(true)
+	s.NoNesting(false)
+	s.Logger()
+	s.IsEqual(nil)
+
+	s = List()
+	s.SetUnmarshaler()
+	s.SetMarshaler()
+	s.Marshal()
+	s.Unmarshal()
+	s.IsEqual(struct{}{})
+	s.IsEqual(s)
+	s.Pop()
+	s.Push(-1, -2, -3, -4, -5)
+	s.Front()
+	s.Back()
+	s.NegativeIndices(true)
+
+	for i := 0; i < s.Len(); i++ {
+		offset := i - (i + 1)
+
+		slice, _ := s.Index(i)
+		want, _ := slice.(int)
+
+		slice, _ = s.Index(offset - want)
+		got, _ := slice.(int)
+
+		if want != got {
+			t.Errorf("%s failed [loop:%d]: want %d, got %d",
+				t.Name(), i, want, got)
+			return
+		}
+	}
+
+	s.config()
+	s.IsEncap()
+	s.ReadOnly()
+	s.SetReadOnly(true)
+	s.SetReadOnly(false)
+	s.IsReadOnly()
+	s.Avail()
+	s.traverse(1)
+	s.string(nil)
+	s.Paren()
+	s.Paren(true)
+	s.Paren(false)
+	s.NoNesting()
+	s.NoNesting(true)
+	s.CanNest()
+	s.NoNesting(false)
+	s.CanMutex()
+
+	s.SetReadOnly()
+	s.SetReadOnly(true)
+	s.Free()
+	s.SetReadOnly(false)
+
+	s.Push(customStruct{`keyword`, `vaLUE`})
+	_ = s.String()
+
+	s.SetAuxiliary(nil)
+	s.SetLogger(nil)
+	s.Logger()
+
+	SetDefaultStackLogLevel(`none`)
+	SetDefaultStackLogLevel(0)
+	SetDefaultStackLogLevel(nil)
+	SetDefaultStackLogLevel('a')
+	s.SetLogger(`stderr`)
+	s.SetLogger(2)
+	s.SetLogger(`stdout`)
+	s.SetLogger(1)
+	s.SetLogger(sLogDefault)
+
+	s.Less(3, 6)
+	s.Less(6, 3)
+	s.Less(1, 2)
+	s.Swap(101, 48)
+	s.Swap(-1, 480)
+	s.Push(
+		customStack(List().Push(1, `&`)),
+		customStack(List().Push(`_n`, `?!?`, 5)),
+		``)
+	sort.Stable(s)
+	s.Less(0, 1)
+	s.Less(0, 2)
+	s.SetLessFunc(nil)
+	s.SetLessFunc()
+
+	s.SetLogLevel(8, 16)
+	s.UnsetLogLevel(8, 16)
+
+	s.SetLogLevel(LogLevel4, LogLevel5)
+	s.LogLevels()
+	s.UnsetLogLevel(LogLevel4, LogLevel5)
+	s.Logger()
+
+	s.SetErr(errorf(`this is a serious error`))         // txt err
+	s.SetErr(errorf(errorf(`this is a serious error`))) // err err
+	s.Err()
+	s.SetErr(nil)
+
+	k := List(8).Push(`1`, `2`, `3`, `4`).SetFIFO(true)
+	k.Front()
+	k.Back()
+
+	l := List().Push(`1`, `2`, `3`, `4`)
+	l.IsEqual(k)
+	l.SetEqualityPolicy(func(x, y any) error {
+		return nil
+	})
+	l.IsEqual(k)
+
+	m := And().Push(`1`, `2`, `3`, `4`)
+	m.SetEqualityPolicy()
+	m.IsEqual(l)
+	m.Marshal()
+
+	var ak Stack
+	ak.Marshal([]any{`CONDITION`, `Keyword`, Eq, `value`})
+
+	ak = List()
+	ak.Marshal([]any{`CONDITION`, `Keyword`, Eq, `value`})
+	ak.Marshal([]any{`other`, `stack`})
+	ak.Marshal([]any{`CONDITION`, `Keyword`, Eq}) // missing value
+	ak.Marshal()
+	ak.Marshal([]any{5, `bogus`})
+	marshalDefault([]any{}, false)
+
+	ak = stackByWord(`NOT`)
+	ak = stackByWord(`blarg`)
+
+	s.SetLogger(`off`)
+	s.SetLogger(0)
+}
+
+func init() {
+	// just used for testing, etc.
+	//SetDefaultStackLogger(`stdout`)
+	//SetDefaultConditionLogger(`stdout`)
+}
+
+func TestStack_errorSentinels(t *testing.T) {
+	full := List(1).Push(`this`, `that`)
+	if err := full.Err(); !errors.Is(err, ErrCapacityViolation) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCapacityViolation, err)
+		return
+	} else if err.Error() != `failed: capacity violation` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(),
+			`failed: capacity violation`, err)
+		return
+	}
+
+	ro := List().Push(`this`).SetReadOnly(true)
+	if err := ro.Free(); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+		return
+	}
+
+	var m Stack
+	if err := m.Marshal(); !errors.Is(err, ErrMarshalInput) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrMarshalInput, err)
+		return
+	}
+
+	if err := And().Push(`this`).IsEqual(Or().Push(`this`)); !errors.Is(err, ErrEqualityMismatch) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrEqualityMismatch, err)
+		return
+	} else if err.Error() != `Stack kind mismatch` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `Stack kind mismatch`, err)
+		return
+	}
+
+	var zero Stack
+	if _, err := zero.TraverseErr(0); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+		return
+	}
+
+	if !errors.Is(unexpectedReceiverState, ErrNoConfig) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNoConfig, unexpectedReceiverState)
+	}
+}
+
+func TestStack_SetPaddingStyle(t *testing.T) {
+	for idx, tst := range []struct {
+		Stack Stack
+		Want  string
+	}{
+		{And().SetSymbol(`&&`).SetPaddingStyle(PadAroundOperator), `a && b`},
+		{And().SetSymbol(`&&`).SetNoPadding(true).SetPaddingStyle(0), `a&&b`},
+		{Or().SetParen(true).SetNoPadding(true).SetPaddingStyle(PadAroundOperator), `(a OR b)`},
+		{Or().SetParen(true).SetNoPadding(true).SetPaddingStyle(PadAroundOperator | PadInsideParens), `( a OR b )`},
+		{Or().SetPaddingStyle(PadAroundOperator).SetNoPadding(false), `a OR b`},
+	} {
+		if got := tst.Stack.Push(`a`, `b`).String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		}
+	}
+}
+
+func TestStack_SetSymbolPadding(t *testing.T) {
+	for idx, tst := range []struct {
+		ValuePad, SymbolPad bool
+		Want                string
+	}{
+		{true, true, `( a && b && c )`},
+		{true, false, `( a&&b&&c )`},
+		{false, true, `(a && b && c)`},
+		{false, false, `(a&&b&&c)`},
+	} {
+		S := And().SetSymbol(`&&`).SetParen(true).
+			SetNoPadding(!tst.ValuePad).
+			SetSymbolPadding(tst.SymbolPad).
+			Push(`a`, `b`, `c`)
+		if got := S.String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		} else if S.IsSymbolPadded() != tst.SymbolPad {
+			t.Errorf("%s failed [idx:%d]: want '%t', got '%t'", t.Name(), idx, tst.SymbolPad, !tst.SymbolPad)
+			return
+		}
+	}
+
+	// unset, the symbol follows the no-padding bit
+	S := And().SetSymbol(`&&`).SetNoPadding(true).Push(`a`, `b`)
+	if S.IsSymbolPadded() || S.String() != `a&&b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `a&&b`, S)
+		return
+	}
+
+	// toggle
+	if S.SetSymbolPadding(); !S.IsSymbolPadded() || S.String() != `a && b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `a && b`, S)
+		return
+	}
+
+	// the operator word is governed likewise
+	if got := Or().SetSymbolPadding(false).Push(`a`, `b`).String(); got != `aORb` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `aORb`, got)
+	}
+}
+
+func TestStack_SetLogger_slog(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	s := List().SetID(`my_stack`).SetLogger(logger).SetLogLevel(LogLevel3)
+	if _, ok := s.Logger().(*slog.Logger); !ok {
+		t.Errorf("%s failed: want '%T', got '%T'", t.Name(), logger, s.Logger())
+		return
+	}
+
+	s.Push(`this`)
+	got := buf.String()
+	for _, want := range []string{`"id":"my_stack"`, `"op":"push"`, `"index":0`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+			return
+		}
+	}
+
+	// slog.Handler instances are accepted as well
+	buf.Reset()
+	c := Cond(`keyword`, Eq, `value`).
+		SetLogger(slog.NewJSONHandler(&buf, nil)).
+		SetLogLevel(LogLevel3)
+	c.SetExpression(`value2`)
+	if got = buf.String(); !strings.Contains(got, `"op":"expression"`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `"op":"expression"`, got)
+	}
+}
+
+func TestStack_SetLoggerDeep(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.New(&buf, ``, 0)
+
+	grandchild := List().SetID(`grandchild`)
+	frozen := List().SetReadOnly(true)
+	cond := Cond(`keyword`, Eq, And().Push(frozen))
+	parent := And().Push(
+		Or().Push(grandchild),
+		cond,
+	)
+
+	parent.SetLoggerDeep(logger).SetLogLevelDeep(LogLevel1)
+	grandchild.Push(`this`)
+	if got := buf.String(); !strings.Contains(got, `id=grandchild`) ||
+		!strings.Contains(got, `op=push args=1`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `id=grandchild ... op=push args=1`, got)
+		return
+	}
+
+	// conditions and their stack expressions are reached
+	if cond.Logger() != logger || cond.LogLevels() != `CALLS` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `CALLS`, cond.LogLevels())
+		return
+	} else if S, _ := cond.ExpressionAsStack(); S.LogLevels() != `CALLS` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `CALLS`, S.LogLevels())
+		return
+	}
+
+	// read-only members are skipped
+	if got := frozen.LogLevels(); got != `NONE` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `NONE`, got)
+		return
+	}
+
+	// no other configuration is altered
+	if grandchild.ID() != `grandchild` || grandchild.Len() != 1 {
+		t.Errorf("%s failed: unexpected configuration change", t.Name())
+		return
+	}
+
+	buf.Reset()
+	parent.UnsetLogLevelDeep(LogLevel1)
+	grandchild.Push(`that`)
+	if got := buf.String(); got != `` {
+		t.Errorf("%s failed: want '', got '%s'", t.Name(), got)
+	}
+}
+
+func TestStack_SetLogLevel_events(t *testing.T) {
+	var buf bytes.Buffer
+	logger := log.New(&buf, ``, 0)
+
+	s := List().SetID(`events`).SetLogger(logger).SetLogLevel(LogLevel1)
+	s.Push(`this`, `that`)
+
+	got := buf.String()
+	if !strings.Contains(got, `id=events`) || !strings.Contains(got, `op=push args=2`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `id=events ... op=push args=2`, got)
+		return
+	}
+
+	// state events describe the length before and after
+	buf.Reset()
+	s.SetLogLevel(LogLevel3)
+	s.Pop()
+	if got = buf.String(); !strings.Contains(got, `op=pop len_before=2 len_after=1`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `op=pop len_before=2 len_after=1`, got)
+		return
+	}
+
+	// errors are transcribed at the ERRORS level
+	buf.Reset()
+	s.SetLogLevel(NoLogLevels).SetLogLevel(LogLevel5)
+	s.stack.setErr(errorf("synthetic"))
+	if got = buf.String(); !strings.Contains(got, `op=error index=-1 error=synthetic`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `error=synthetic`, got)
+		return
+	}
+
+	// nothing is written when the level is off
+	buf.Reset()
+	s.SetLogLevel(NoLogLevels)
+	s.Reset()
+	if got = buf.String(); got != `` {
+		t.Errorf("%s failed: want '', got '%s'", t.Name(), got)
+		return
+	}
+
+	c := Cond(`keyword`, Eq, `value`).SetLogger(logger).SetLogLevel(LogLevel1, LogLevel3)
+	c.SetKeyword(`other`)
+	if got = buf.String(); !strings.Contains(got, `op=keyword args=1`) ||
+		!strings.Contains(got, `op=keyword index=-1`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `op=keyword`, got)
+		return
+	}
+
+	// the _addr keyword is resolved, as with ID
+	for idx, tst := range []struct {
+		Do func()
+		ID string
+	}{
+		{func() { s.SetID(`_addr`).SetLogLevel(LogLevel1).Push(`x`) }, s.Addr()},
+		{func() { c.SetID(`_addr`).SetKeyword(`again`) }, c.Addr()},
+	} {
+		buf.Reset()
+		if tst.Do(); !strings.Contains(buf.String(), `id=`+tst.ID+` `) {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, `id=`+tst.ID, buf.String())
+			return
+		}
+	}
+
+	clone := Stack{s.stack.clone(make(map[*stack]*stack))}
+	buf.Reset()
+	if clone.Push(`y`); !strings.Contains(buf.String(), `id=`+clone.Addr()+` `) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `id=`+clone.Addr(), buf.String())
+	}
+}
+
+func TestStack_Merge(t *testing.T) {
+	dest := Or().Push(`a`, `b`, `c`)
+	src := Or().Push(`c`, `d`, `a`, `e`)
+
+	added, err := dest.Merge(src, nil)
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if added != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, added)
+		return
+	} else if want, got := `a OR b OR c OR d OR e`, dest.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// custom policy: replace matches in place, never add
+	type customStack Stack
+	added, _ = dest.Merge(customStack(Or().Push(`b`, `z`)), func(existing, incoming any) (any, bool) {
+		return `B`, existing == nil
+	})
+	if want, got := `a OR B OR c OR d OR e`, dest.String(); want != got || added != 0 {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	full := Or(3).Push(`a`, `b`)
+	if added, err = full.Merge(src, nil); !errors.Is(err, ErrCapacityViolation) || added != 1 {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCapacityViolation, err)
+		return
+	}
+
+	ro := Or().SetReadOnly(true)
+	if _, err = ro.Merge(src, nil); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+		return
+	}
+
+	if _, err = dest.Merge(`bogus`, nil); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+	}
+}
+
+func TestStack_LockStats(t *testing.T) {
+	var buf bytes.Buffer
+	s := List().SetMutex().
+		SetLogger(slog.New(slog.NewJSONHandler(&buf, nil))).
+		SetLogLevel(LogLevel3).
+		SetLockWarnThreshold(1)
+
+	for i := 0; i < 100; i++ {
+		s.Push(i)
+	}
+
+	ls := s.LockStats()
+	if ls.TotalLocks < 100 {
+		t.Errorf("%s failed: want '>=%d', got '%d'", t.Name(), 100, ls.TotalLocks)
+		return
+	} else if ls.MaxHoldTime <= 0 || ls.CurrentHoldDuration != 0 {
+		t.Errorf("%s failed: unexpected hold times: %#v", t.Name(), ls)
+		return
+	} else if !strings.Contains(buf.String(), `"op":"lock_hold"`) {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `"op":"lock_hold"`, buf.String())
+		return
+	}
+
+	if ls = List().LockStats(); ls != (LockStats{}) {
+		t.Errorf("%s failed: want zero %T, got '%#v'", t.Name(), ls, ls)
+	}
+}
+
+func TestStack_Exchange(t *testing.T) {
+	s := List().SetMutex().Push(`a`, `b`, `c`)
+
+	for idx, tst := range []struct {
+		Idx  int
+		In   string
+		Prev string
+	}{
+		{0, `A`, `a`},
+		{1, `B`, `b`},
+		{2, `C`, `c`},
+	} {
+		prev, ok := s.Exchange(tst.In, tst.Idx)
+		if !ok || prev != tst.Prev {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%v'", t.Name(), idx, tst.Prev, prev)
+			return
+		}
+	}
+
+	if want, got := `A B C`, s.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	for _, idx := range []int{3, -1} {
+		if prev, ok := s.Exchange(`X`, idx); ok || prev != nil {
+			t.Errorf("%s failed [idx:%d]: want '(nil, false)', got '(%v, %t)'", t.Name(), idx, prev, ok)
+			return
+		}
+	}
+
+	if want, got := `A B C`, s.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	s.SetNegativeIndices(true)
+	if prev, _ := s.Exchange(`c`, -1); prev != `C` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `C`, prev)
+		return
+	}
+
+	// the hook fires only upon success, here thwarted
+	// by a policy which shortens the receiver
+	var events []string
+	shrink := List().Push(`a`, `b`).SetChangeHook(func(op string, idx int, _ any) {
+		events = append(events, sprintf("%s:%d", op, idx))
+	})
+	shrink.SetPushPolicy(func(...any) error {
+		shrink.Remove(1)
+		return nil
+	})
+	if _, ok := shrink.Exchange(`c`, 1); ok || join(events, `,`) != `remove:1` {
+		t.Errorf("%s failed: want '%s', got '%s' (ok:%t)", t.Name(), `remove:1`, join(events, `,`), ok)
+	}
+}
+
+func TestStack_SetSkipInvalid(t *testing.T) {
+	var bad Condition
+	bad.Init()
+	bad.SetKeyword(`sn`).SetOperator(Eq)
+
+	S := And().Push(
+		Cond(`cn`, Eq, `jesse`),
+		bad,
+		Cond(`uid`, Eq, `jc`),
+	)
+
+	want := `cn = jesse AND ` + badCond + ` AND uid = jc`
+	if got := S.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	want = `cn = jesse AND uid = jc`
+	if got := S.SetSkipInvalid(true).String(); got != want || !S.IsSkippingInvalid() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if S.Len() != 3 {
+		t.Errorf("%s failed: want len %d, got %d", t.Name(), 3, S.Len())
+		return
+	}
+
+	// repaired conditions are rendered once more
+	bad.SetExpression(`coretta`)
+	want = `cn = jesse AND sn = coretta AND uid = jc`
+	if got := S.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if S.SetSkipInvalid(); S.IsSkippingInvalid() {
+		t.Errorf("%s failed: toggle did not disable setting", t.Name())
+	}
+}
+
+func TestStack_SetPolicyExemptReplace(t *testing.T) {
+	S := List().Push(`a`, `b`).SetPushPolicy(func(x ...any) error {
+		if _, ok := x[0].(string); !ok {
+			return errorf("%T not allowed", x[0])
+		}
+		return nil
+	})
+
+	if S.Insert(42, 0) || S.Replace(42, 1) || S.Len() != 2 || S.Err() == nil {
+		t.Errorf("%s failed: want refused mutations, got '%s'", t.Name(), S)
+		return
+	}
+
+	if !S.SetErr(nil).Insert(`z`, 0) || !S.Replace(`y`, 1) || S.String() != `z y b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `z y b`, S)
+		return
+	}
+
+	// context-aware policies are consulted likewise
+	var idxs []int
+	C := List().Push(`a`, `b`).SetPushPolicyContext(func(ctx PushContext, x any) error {
+		idxs = append(idxs, ctx.Index)
+		return nil
+	})
+	if C.Insert(`c`, 1); !C.Replace(`d`, 0) || sprintf("%v", idxs) != `[1 0]` {
+		t.Errorf("%s failed: want indices [1 0], got %v", t.Name(), idxs)
+		return
+	}
+
+	// exemption restores the former behavior
+	if !S.SetPolicyExemptReplace(true).IsPolicyExemptReplace() {
+		t.Errorf("%s failed: want exemption", t.Name())
+		return
+	} else if !S.Insert(42, 0) || !S.Replace(43, 1) || S.String() != `42 43 y b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `42 43 y b`, S)
+	}
+}
+
+func TestStack_SetUnique(t *testing.T) {
+	S := List().SetUnique(true).Push(`a`, `b`, `a`, 1, `c`, 1)
+	if got, want := S.String(), `a b 1 c`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if !errors.Is(S.Err(), ErrDuplicate) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrDuplicate, S.Err())
+		return
+	}
+
+	// distinct types are distinct values
+	if S.SetErr(nil).Push(`1`, int64(1)); S.Len() != 6 || S.Err() != nil {
+		t.Errorf("%s failed: want '%d', got '%d' (%v)", t.Name(), 6, S.Len(), S.Err())
+		return
+	}
+
+	// the index must reflect removals and replacements
+	S.Remove(0)
+	if !S.Insert(`a`, 0) || S.Replace(`c`, 1) || !S.Replace(`b`, 1) || !S.Replace(`z`, 1) {
+		t.Errorf("%s failed: unexpected result: '%s' (%v)", t.Name(), S, S.Err())
+		return
+	} else if S.Push(`b`); S.Len() != 7 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 7, S.Len())
+		return
+	}
+
+	// equal content, distinct instances
+	C := List().SetUnique(true).Push(Cond(`cn`, Eq, `Jesse`), And().Push(`x`))
+	if C.Push(Cond(`cn`, Eq, `Jesse`)); C.Len() != 2 || !errors.Is(C.Err(), ErrDuplicate) {
+		t.Errorf("%s failed: want '%d', got '%d' (%v)", t.Name(), 2, C.Len(), C.Err())
+		return
+	} else if C.SetErr(nil).Insert(And().Push(`x`), 0) || C.Len() != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, C.Len())
+		return
+	} else if C.Push(Cond(`cn`, Eq, `Courtney`)); C.Len() != 3 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 3, C.Len())
+		return
+	}
+
+	// toggling off restores the default behavior
+	if C.SetUnique(); C.IsUnique() {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, true)
+		return
+	} else if C.Push(Cond(`cn`, Eq, `Jesse`)); C.Len() != 4 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 4, C.Len())
+	}
+}
+
+func TestStack_Chunk(t *testing.T) {
+	s := List(10).SetDelimiter(`,`).SetNoPadding(true).SetFIFO(true).
+		Push(`a`, `b`, `c`, `d`, `e`, `f`, `g`, `h`)
+
+	chunks := s.Chunk(3)
+	if len(chunks) != 3 {
+		t.Errorf("%s failed: want '%d' chunks, got '%d'", t.Name(), 3, len(chunks))
+		return
+	}
+
+	var strs []string
+	for _, chunk := range chunks {
+		if chunk.Cap() != -1 || !chunk.IsFIFO() {
+			t.Errorf("%s failed: unexpected chunk configuration", t.Name())
+			return
+		}
+		strs = append(strs, chunk.String())
+	}
+
+	if want, got := s.String(), strings.Join(strs, s.Delimiter()); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if chunks = s.Chunk(0); chunks != nil {
+		t.Errorf("%s failed: want 'nil', got '%v'", t.Name(), chunks)
+		return
+	}
+
+	if chunks = List().Chunk(2); chunks == nil || len(chunks) != 0 {
+		t.Errorf("%s failed: want '0' chunks, got '%d'", t.Name(), len(chunks))
+	}
+}
+
+func TestStack_Split(t *testing.T) {
+	s := Or().SetParen(true).Push(`a`, `b`, `c`, `d`)
+
+	left, right, ok := s.Split(1)
+	if !ok {
+		t.Errorf("%s failed: want 'true', got '%t'", t.Name(), ok)
+		return
+	} else if want, got := `( a )`, left.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if want, got = `( b OR c OR d )`, right.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if s.Len() != 4 {
+		t.Errorf("%s failed: receiver was modified", t.Name())
+		return
+	}
+
+	if _, right, ok = s.Split(4); !ok || right.Len() != 0 {
+		t.Errorf("%s failed: want '0' right slices, got '%d'", t.Name(), right.Len())
+		return
+	}
+
+	for _, idx := range []int{-1, 5} {
+		if _, _, ok = s.Split(idx); ok {
+			t.Errorf("%s failed [idx:%d]: want 'false', got '%t'", t.Name(), idx, ok)
+			return
+		}
+	}
+}
+
+func TestStack_GroupBy(t *testing.T) {
+	S := And().Paren().Push(
+		Cond(`cn`, Eq, `a`),
+		Cond(`sn`, Eq, `x`),
+		Cond(`cn`, Eq, `b`),
+		`raw`,
+		Cond(`sn`, Eq, `y`),
+		Cond(`mail`, Eq, `z`),
+		Cond(`cn`, Eq, `c`),
+	)
+	before := S.String()
+
+	byKeyword := func(x any) string {
+		if c, ok := x.(Condition); ok {
+			return c.Keyword()
+		}
+		return ``
+	}
+
+	G := S.GroupBy(byKeyword, KindOr)
+	want := `( ( cn = a OR cn = b OR cn = c ) AND ( sn = x OR sn = y ) AND raw AND ( mail = z ) )`
+	if got := G.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if G.Len() != 4 || S.String() != before {
+		t.Errorf("%s failed: want 4 members and unmodified receiver, got %d", t.Name(), G.Len())
+		return
+	}
+
+	for _, tst := range []struct {
+		Path []int
+		Want string
+	}{
+		{[]int{0, 2}, `cn = c`},
+		{[]int{1, 1}, `sn = y`},
+		{[]int{2}, `raw`},
+		{[]int{3, 0}, `mail = z`},
+	} {
+		slice, ok := G.Traverse(tst.Path...)
+		if got := sprintf("%v", slice); !ok || got != tst.Want {
+			t.Errorf("%s failed %v: want '%s', got '%s'", t.Name(), tst.Path, tst.Want, got)
+			return
+		}
+	}
+
+	if slice, _ := G.Index(0); slice.(Stack).Kind() != `OR` {
+		t.Errorf("%s failed: want OR group, got '%s'", t.Name(), slice.(Stack).Kind())
+		return
+	}
+
+	if G = S.GroupBy(byKeyword, KindInvalid); G.IsInit() {
+		t.Errorf("%s failed: want uninitialized result for invalid kind", t.Name())
+		return
+	}
+
+	// groups of another kind do not bear the receiver's symbol
+	byLen := func(x any) string { return sprintf("%d", len(x.(string))) }
+	for idx, tst := range []struct {
+		S    Stack
+		Kind StackKind
+		Want string
+	}{
+		{And().SetSymbol(`&`), KindOr, `a OR c & bb OR dd`},
+		{And().SetSymbol(`&`), KindAnd, `a & c & bb & dd`},
+		{And().SetSymbol(`&`).SetSymbols(map[StackKind]string{KindOr: `|`}), KindOr, `a | c & bb | dd`},
+	} {
+		if got := tst.S.Push(`a`, `bb`, `c`, `dd`).GroupBy(byLen, tst.Kind).String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+		}
+	}
+}
+
+func TestStack_Union(t *testing.T) {
+	a := List().SetDelimiter(`,`).SetNoPadding(true).Push(`a`, `b`, `c`, `b`)
+	b := List().Push(`c`, `d`, `a`, `e`)
+
+	for _, tst := range []struct {
+		Op   func(any) Stack
+		Want string
+	}{
+		{a.Union, `a,b,c,d,e`},
+		{a.Intersection, `a,c`},
+		{a.Difference, `b`},
+	} {
+		if got := tst.Op(b).String(); got != tst.Want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), tst.Want, got)
+			return
+		}
+	}
+
+	// inputs are untouched
+	if got, want := a.String(), `a,b,c,b`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if b.Len() != 4 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 4, b.Len())
+		return
+	}
+
+	// equal-but-distinct conditions are one and the same member,
+	// and the receiver's kind and presentation are preserved.
+	x := Or().SetParen(true).Push(Cond(`cn`, Eq, `x`), Cond(`sn`, Eq, `y`))
+	y := And().Push(Cond(`cn`, Eq, `x`), Cond(`mail`, Eq, `z`))
+
+	U := x.Union(y)
+	if got, want := U.String(), `( cn = x OR sn = y OR mail = z )`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if U.KindOf() != KindOr {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), KindOr, U.KindOf())
+		return
+	}
+
+	if got, want := x.Intersection(y).String(), `( cn = x )`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if got, want = y.Difference(x).String(), `mail = z`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	var z Stack
+	if bad := a.Union(z); bad.Len() != 0 || !errors.Is(bad.Err(), ErrNotInitialized) {
+		t.Errorf("%s failed: want %v, got %v", t.Name(), ErrNotInitialized, bad.Err())
+		return
+	} else if z.Difference(a).IsInit() {
+		t.Errorf("%s failed: want uninitialized result", t.Name())
+	}
+}
+
+func TestStack_Shuffle(t *testing.T) {
+	vals := []any{`a`, `b`, `c`, `d`, `e`, `f`}
+	r := List().Push(vals...)
+
+	if got, want := r.Shuffle(rand.NewSource(1)).String(), `a b e d c f`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// nothing lost, nothing duplicated
+	for i := 0; i < 50; i++ {
+		r.Shuffle()
+		if r.Len() != len(vals) {
+			t.Errorf("%s failed: want '%d', got '%d'", t.Name(), len(vals), r.Len())
+			return
+		}
+		for _, v := range vals {
+			if _, _, found := r.FindFirst(func(x any) bool { return x == v }); !found {
+				t.Errorf("%s failed: '%v' lost", t.Name(), v)
+				return
+			}
+		}
+	}
+
+	ro := List().Push(`a`, `b`, `c`).SetReadOnly(true)
+	if ro.Shuffle(rand.NewSource(1)); ro.String() != `a b c` || !errors.Is(ro.Err(), ErrReadOnly) {
+		t.Errorf("%s failed: want '%s' (%v), got '%s' (%v)", t.Name(),
+			`a b c`, ErrReadOnly, ro.String(), ro.Err())
+		return
+	}
+
+	var z Stack
+	z.Shuffle() // must not panic
+}
+
+func TestStack_Sample(t *testing.T) {
+	r := List().Push(`a`, `b`, `c`, `d`, `e`, `f`)
+
+	for idx, tst := range []struct {
+		N    int
+		Want string
+	}{
+		{3, `f d a`},
+		{0, ``},
+		{-1, ``},
+	} {
+		S := r.Sample(tst.N, rand.NewSource(1))
+		if got := S.String(); got != tst.Want {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		} else if S.Kind() != r.Kind() {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, r.Kind(), S.Kind())
+			return
+		}
+	}
+
+	if got, want := List().Push(`a`, `b`, `c`).Sample(5, rand.NewSource(1)).String(), `c a b`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	if r.String() != `a b c d e f` {
+		t.Errorf("%s failed: receiver modified: '%s'", t.Name(), r)
+		return
+	}
+
+	var z Stack
+	if z.Sample(1).IsInit() {
+		t.Errorf("%s failed: want uninitialized, got initialized", t.Name())
+	}
+}
+
+func TestStack_Negate(t *testing.T) {
+	s := And().Push(
+		Cond(`a`, Eq, 1),
+		Or().SetParen(true).Push(
+			Cond(`b`, Lt, 2),
+			Not().Push(Cond(`c`, Ge, 3)),
+		),
+		Cond(`d`, fakeOperator{Str: `~`, Ctx: `fake`}, 4),
+	)
+
+	want := `a != 1 OR ( b >= 2 AND c >= 3 ) OR NOT d ~ 4`
+	if got := s.Negate().String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// double negation of a NOT
+	inner := Or().Push(`x`, `y`)
+	if got := Not().Push(inner).Negate().String(); got != `x OR y` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `x OR y`, got)
+		return
+	}
+
+	if got := List().Push(`x`).Negate().Kind(); got != `NOT` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `NOT`, got)
+	}
+}
+
+func TestStack_SetAuxKey(t *testing.T) {
+	s := List().SetMutex()
+	c := Cond(`keyword`, Eq, `value`)
+
+	done := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		go func(i int) {
+			for j := 0; j < 50; j++ {
+				key := strconv.Itoa(j % 5)
+				s.SetAuxKey(key, i)
+				s.GetAuxKey(key)
+				c.SetAuxKey(key, i)
+				c.GetAuxKey(key)
+				if j%10 == 0 {
+					s.UnsetAuxKey(key)
+					c.UnsetAuxKey(key)
+				}
+			}
+			done <- struct{}{}
+		}(i)
+	}
+	for i := 0; i < 4; i++ {
+		<-done
+	}
+
+	s.SetAuxKey(`str`, `value`).
+		SetAuxKey(`int`, 3).
+		SetAuxKey(`bool`, true).
+		SetAuxKey(`stack`, And().Push(`x`))
+
+	aux := s.Auxiliary()
+	if v, ok := aux.GetString(`str`); !ok || v != `value` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `value`, v)
+		return
+	} else if n, ok := aux.GetInt(`int`); !ok || n != 3 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 3, n)
+		return
+	} else if b, ok := aux.GetBool(`bool`); !ok || !b {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), true, b)
+		return
+	} else if S, ok := aux.GetStack(`stack`); !ok || S.String() != `x` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `x`, S)
+		return
+	} else if _, ok = aux.GetInt(`str`); ok {
+		t.Errorf("%s failed: want 'false', got '%t'", t.Name(), ok)
+	}
+}
+
+func TestStack_SetEqualityOrderInsensitive(t *testing.T) {
+	a := Or().Push(
+		Cond(`cn`, Eq, `a`),
+		`this`,
+		And().Push(`x`, `y`),
+		`this`,
+	)
+	b := Or().Push(
+		`this`,
+		And().Push(`x`, `y`),
+		`this`,
+		Cond(`cn`, Eq, `a`),
+	)
+
+	if err := a.IsEqual(b); !errors.Is(err, ErrEqualityMismatch) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrEqualityMismatch, err)
+		return
+	}
+
+	a.SetEqualityOrderInsensitive(true)
+	if err := a.IsEqual(b); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// multiset semantics: duplicates must be matched one-for-one
+	c := Or().Push(`this`, `this`, `this`, Cond(`cn`, Eq, `a`))
+	if err := a.IsEqual(c); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+		return
+	}
+
+	// nested stacks are governed by their own configuration
+	d := Or().Push(Cond(`cn`, Eq, `a`), `this`, And().Push(`y`, `x`), `this`)
+	if err := a.IsEqual(d); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+		return
+	}
+
+	// NOT stacks remain order-sensitive
+	n1 := Not().SetEqualityOrderInsensitive(true).Push(`x`, `y`)
+	if err := n1.IsEqual(Not().Push(`y`, `x`)); err == nil {
+		t.Errorf("%s failed: want 'error', got '%v'", t.Name(), err)
+	}
+}
+
+func TestStack_PushFlatten(t *testing.T) {
+	or := Or().Push(`b`, Cond(`c`, Eq, And().Push(`d`, `e`)))
+
+	type customStack Stack
+	got := And().PushFlatten(`a`, customStack(or), `f`)
+	want := And().Push(`a`, `b`, Cond(`c`, Eq, And().Push(`d`, `e`)), `f`)
+
+	if got.Len() != want.Len() {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), want.Len(), got.Len())
+		return
+	} else if got.String() != want.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	full := And(3).PushFlatten(`a`, or, `f`)
+	if err := full.Err(); !errors.Is(err, ErrCapacityViolation) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrCapacityViolation, err)
+		return
+	} else if want := `failed: capacity violation; 3 of 4 elements appended`; err.Error() != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, err)
+	}
+}
+
+func TestStack_SetTrackMetadata(t *testing.T) {
+	r := List().Push(`untracked`)
+	if _, ok := r.Metadata(0); ok {
+		t.Errorf("%s failed: unexpected metadata while untracked", t.Name())
+		return
+	}
+
+	r.SetTrackMetadata(true).
+		PushLabeled(`alpha`, `a`).
+		PushLabeled(`beta`, `b`, `c`)
+
+	r.Reverse()
+	for idx, want := range []string{`beta`, `beta`, `alpha`, ``} {
+		if meta, ok := r.Metadata(idx); !ok {
+			t.Errorf("%s failed: no metadata for slice %d", t.Name(), idx)
+			return
+		} else if meta.Label != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, meta.Label)
+			return
+		} else if want != `` && meta.Time.IsZero() {
+			t.Errorf("%s failed: zero time for slice %d", t.Name(), idx)
+			return
+		}
+	}
+
+	r.Remove(0)
+	r.Swap(0, 2)
+	r.Insert(`z`, 1)
+	for idx, want := range []string{``, ``, `alpha`, `beta`} {
+		if meta, _ := r.Metadata(idx); meta.Label != want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, meta.Label)
+			return
+		}
+	}
+
+	if r.SetTrackMetadata(false); r.stack.metaConfig() != nil {
+		t.Errorf("%s failed: metadata retained after disabling", t.Name())
+		return
+	}
+
+	// toggling
+	if r.SetTrackMetadata(); r.stack.metaConfig() == nil {
+		t.Errorf("%s failed: tracking not toggled on", t.Name())
+		return
+	} else if r.SetTrackMetadata(); r.stack.metaConfig() != nil {
+		t.Errorf("%s failed: tracking not toggled off", t.Name())
+	}
+}
+
+func TestStack_SetTTL(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tick := func(d time.Duration) { clock = clock.Add(d) }
+
+	r := List().SetFIFO(true).SetClock(func() time.Time { return clock })
+
+	var hooked []int
+	r.SetChangeHook(func(op string, idx int, _ any) {
+		if op == `expire` {
+			hooked = append(hooked, idx)
+		}
+	})
+
+	// existing slices are fresh as of the moment of enablement
+	r.Push(`a`, nil, `b`)
+	tick(time.Hour)
+	r.SetTTL(10 * time.Millisecond)
+	if r.Len() != 3 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 3, r.Len())
+		return
+	}
+
+	tick(5 * time.Millisecond)
+	r.Push(`c`, `d`)
+	if r.Expire() != 0 {
+		t.Errorf("%s failed: premature expiry: '%s'", t.Name(), r)
+		return
+	}
+
+	// defragmentation preserves alignment of remaining times;
+	// reads treat expired slices as absent without removing them
+	tick(5 * time.Millisecond)
+	if r.Defrag(); r.String() != `c d` || r.Len() != 2 {
+		t.Errorf("%s failed: want '%s', got '%s' (%d)", t.Name(), `c d`, r, r.Len())
+		return
+	} else if len(hooked) != 0 || r.stack.ulen() != 4 {
+		t.Errorf("%s failed: read removed expired slices: %v", t.Name(), hooked)
+		return
+	} else if n := r.Expire(); n != 2 || fmt.Sprint(hooked) != `[0 1]` {
+		t.Errorf("%s failed: want hooked indices '%s', got '%v'", t.Name(), `[0 1]`, hooked)
+		return
+	}
+
+	r.Insert(`e`, 0)
+	tick(5 * time.Millisecond)
+	if slice, ok := r.Back(); !ok || slice != `e` {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), `e`, slice)
+		return
+	} else if n := r.Expire(); n != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, n)
+		return
+	}
+
+	tick(10 * time.Millisecond)
+	if _, ok := r.Index(0); ok {
+		t.Errorf("%s failed: expired slice still present", t.Name())
+		return
+	} else if _, ok = r.Pop(); ok || r.Len() != 0 || r.stack.ulen() != 1 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 0, r.Len())
+		return
+	} else if r.Expire(); fmt.Sprint(hooked) != `[0 1 1 2 0]` {
+		t.Errorf("%s failed: want hooked indices '%s', got '%v'", t.Name(), `[0 1 1 2 0]`, hooked)
+		return
+	}
+
+	// reads do not lock, and may thus be made while the lock is held
+	m := List().SetMutex().SetTTL(time.Minute)
+	m.SetPushPolicy(func(...any) error { _ = m.Len(); return nil })
+	if m.Push(`x`); m.Len() != 1 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 1, m.Len())
+		return
+	}
+
+	// read-only receivers suspend expiry
+	r.Push(`f`).SetReadOnly(true)
+	tick(time.Hour)
+	if r.Len() != 1 || r.Expire() != 0 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 1, r.Len())
+		return
+	}
+
+	if r.SetReadOnly(false).SetTTL(0); r.TTL() != 0 || r.Len() != 1 || r.stack.metaConfig() != nil {
+		t.Errorf("%s failed: want disabled TTL, got '%s'", t.Name(), r.TTL())
+		return
+	}
+
+	var z Stack
+	if z.SetTTL(time.Second).TTL() != 0 || z.Expire() != 0 {
+		t.Errorf("%s failed: unexpected result for zero stack", t.Name())
+	}
+}
+
+func TestStack_Strings(t *testing.T) {
+	if vals, err := List().Strings(); err != nil || len(vals) != 0 {
+		t.Errorf("%s failed: want '%d', got '%d' (%v)", t.Name(), 0, len(vals), err)
+		return
+	}
+
+	fifo := ListFromStrings([]string{`a`, `b`}).SetFIFO(true).Push(nil, `c`)
+	if vals, err := fifo.Strings(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := join(vals, ``); got != `abc` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `abc`, got)
+		return
+	}
+
+	mixed := List().Push(`a`, 1)
+	if _, err := mixed.Strings(); err == nil {
+		t.Errorf("%s failed: expected error for mixed types", t.Name())
+		return
+	} else if want := `slice 1 is of unexpected type int`; err.Error() != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, err)
+		return
+	}
+
+	if vals, err := ListFromInts([]int{1, 2, 3}).Ints(); err != nil || len(vals) != 3 || vals[2] != 3 {
+		t.Errorf("%s failed: want '%v', got '%v' (%v)", t.Name(), []int{1, 2, 3}, vals, err)
+		return
+	} else if _, err = mixed.Ints(); err == nil {
+		t.Errorf("%s failed: expected error for mixed types", t.Name())
+		return
+	}
+
+	if vals, err := ListFromFloat64s([]float64{1.5}).Float64s(); err != nil || vals[0] != 1.5 {
+		t.Errorf("%s failed: want '%v', got '%v' (%v)", t.Name(), 1.5, vals, err)
+		return
+	}
+
+	if vals, err := ListFromBools([]bool{true, false}).Bools(); err != nil || len(vals) != 2 || !vals[0] {
+		t.Errorf("%s failed: want '%v', got '%v' (%v)", t.Name(), []bool{true, false}, vals, err)
+	}
+}
+
+func TestStack_FindFirst(t *testing.T) {
+	isCond := func(x any) bool {
+		_, ok := x.(Condition)
+		return ok
+	}
+
+	newStack := func(fifo bool) Stack {
+		return List().SetFIFO(fifo).Push(
+			`a`,
+			Cond(`b`, Eq, `1`),
+			And().Push(`c`),
+			Cond(`d`, Ne, `2`),
+			`e`,
+		)
+	}
+
+	for _, tst := range []struct {
+		FIFO  bool
+		First bool
+		Match func(any) bool
+		Want  string
+		Index int
+	}{
+		{false, true, nil, `e`, 4},
+		{true, true, nil, `a`, 0},
+		{false, false, nil, `a`, 0},
+		{true, false, nil, `e`, 4},
+		{false, true, isCond, `d != 2`, 3},
+		{true, true, isCond, `b = 1`, 1},
+		{false, false, isCond, `b = 1`, 1},
+		{true, false, isCond, `d != 2`, 3},
+	} {
+		stk := newStack(tst.FIFO)
+		find := stk.FindLast
+		if tst.First {
+			find = stk.FindFirst
+		}
+
+		slice, idx, ok := find(tst.Match)
+		if !ok || idx != tst.Index {
+			t.Errorf("%s failed: want '%d', got '%d'", t.Name(), tst.Index, idx)
+			return
+		} else if got := sprintf("%s", slice); got != tst.Want {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), tst.Want, got)
+			return
+		}
+	}
+
+	stk := newStack(false).SetReadOnly(true)
+	if _, idx, ok := stk.FindFirst(nil); !ok || idx != 4 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 4, idx)
+		return
+	} else if _, idx, ok = stk.FindFirst(func(x any) bool { return x == `z` }); ok || idx != -1 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), -1, idx)
+		return
+	}
+
+	var bogus Stack
+	if slice, idx, ok := bogus.FindLast(nil); ok || idx != -1 || slice != nil {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), -1, idx)
+	}
+}
+
+func TestStack_SetUnmarshalWithConfig(t *testing.T) {
+	enabler := func(r Stack) Stack {
+		return r.Paren().LeadOnce().Encap(testParens).NoPadding()
+	}
+
+	A := enabler(And().Symbol('&')).SetID(`top`).SetCategory(`filter`).Push(
+		`top_element_number_0`,
+		enabler(Or().Symbol('|')).Push(
+			`sub_element_number_0`,
+			`sub_element_number_1`,
+		),
+		Cond(`objectClass`, Eq, `employee`).SetParen(true).SetNoPadding(true),
+		List().SetDelimiter(`,`).Push(`a`, `b`),
+	).SetUnmarshalWithConfig(true)
+
+	slices, err := A.Unmarshal()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if _, ok := slices[1].(MarshalSettings); !ok {
+		t.Errorf("%s failed: want '%T', got '%T'", t.Name(), MarshalSettings{}, slices[1])
+		return
+	}
+
+	var B Stack
+	if err = B.MarshalStrict(slices...); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if want, got := A.String(), B.String(); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	} else if B.ID() != `top` || B.Category() != `filter` {
+		t.Errorf("%s failed: want '%s/%s', got '%s/%s'", t.Name(),
+			`top`, `filter`, B.ID(), B.Category())
+		return
+	}
+
+	var C Stack
+	if err = C.MarshalStrict(`bogus`, `a`); !errors.Is(err, ErrMarshalInput) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrMarshalInput, err)
+		return
+	} else if err = C.Marshal(`bogus`, `a`); err != nil || C.stackType() != basic {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), basic, C.stackType(), err)
+	}
+}
+
+func TestStack_SetPriority(t *testing.T) {
+	S := List().SetPriority(true)
+	S.SetLessFunc(func(i, j int) bool {
+		x, _ := S.Index(i)
+		y, _ := S.Index(j)
+		return x.(int) < y.(int)
+	})
+	S.Push(5, 3, 9, 1, 7)
+
+	if front, _ := S.Front(); front != 1 {
+		t.Errorf("%s failed: want '%d', got '%v'", t.Name(), 1, front)
+		return
+	}
+
+	for idx, want := range []struct {
+		popped int
+		remain string
+	}{
+		{1, `5 3 9 7`},
+		{3, `5 9 7`},
+		{5, `9 7`},
+		{7, `9`},
+		{9, ``},
+	} {
+		if popped, ok := S.Pop(); !ok || popped != want.popped {
+			t.Errorf("%s failed [idx:%d]: want '%d', got '%v'", t.Name(), idx, want.popped, popped)
+			return
+		} else if got := S.String(); got != want.remain {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, want.remain, got)
+			return
+		}
+	}
+
+	// the left-most of equally least slices, by way of the default lesser
+	L := List().SetPriority(true).Push(`b`, `a`, `c`, `a`)
+	if popped := L.PopWhile(nil, 2); len(popped) != 2 || popped[0] != `a` || L.String() != `b c` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `b c`, L)
+		return
+	}
+
+	// mutually exclusive with FIFO
+	if L.SetFIFO(true); L.IsFIFO() || L.Err() == nil {
+		t.Errorf("%s failed: FIFO enabled alongside priority", t.Name())
+		return
+	}
+
+	F := List().SetFIFO(true).SetPriority(true)
+	if F.IsPriority() || F.Err() == nil {
+		t.Errorf("%s failed: priority enabled alongside FIFO", t.Name())
+		return
+	}
+
+	if L.SetPriority(); L.IsPriority() {
+		t.Errorf("%s failed: toggle did not disable setting", t.Name())
+	}
+}
+
+func TestStack_lockers(t *testing.T) {
+	S := List().SetMutex()
+	if _, found := lockers.Load(S.stack.addr()); !found {
+		t.Errorf("%s failed: locking-enabled stack not registered", t.Name())
+		return
+	}
+
+	C := S.stack.clone(make(map[*stack]*stack))
+	if _, found := lockers.Load(C.addr()); !found {
+		t.Errorf("%s failed: locking-enabled clone not registered", t.Name())
+		return
+	}
+
+	addr := S.stack.addr()
+	if S.FreeDeep(); S.stack != nil {
+		t.Errorf("%s failed: stack not freed", t.Name())
+		return
+	} else if _, found := lockers.Load(addr); found {
+		t.Errorf("%s failed: freed stack still registered", t.Name())
+	}
+}
+
+func TestStack_PopIf(t *testing.T) {
+	isCond := func(x any) bool {
+		_, ok := x.(Condition)
+		return ok
+	}
+
+	lifo := List().Push(`a`, Cond(`b`, Eq, `c`))
+	if popped, ok := lifo.PopIf(isCond); !ok || !isCond(popped) {
+		t.Errorf("%s failed: want '%T', got '%T'", t.Name(), Condition{}, popped)
+		return
+	} else if _, ok = lifo.PopIf(isCond); ok || lifo.Len() != 1 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 1, lifo.Len())
+		return
+	}
+
+	fifo := List().SetFIFO(true).Push(`a`, `b`, Cond(`c`, Eq, `d`), `e`)
+	isStr := func(x any) bool {
+		_, ok := x.(string)
+		return ok
+	}
+	if popped := fifo.PopWhile(isStr); len(popped) != 2 || popped[1] != `b` {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, len(popped))
+		return
+	} else if popped = fifo.PopWhile(nil, 1); len(popped) != 1 || !isCond(popped[0]) {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 1, len(popped))
+		return
+	} else if popped = fifo.PopWhile(func(_ any) bool { return false }); len(popped) != 0 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 0, len(popped))
+		return
+	}
+
+	ro := List().Push(`a`).SetReadOnly(true)
+	if _, ok := ro.PopIf(nil); ok || !errors.Is(ro.Err(), ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, ro.Err())
+		return
+	}
+
+	// concurrent producer/consumer
+	queue := List().SetFIFO(true).SetMutex()
+	done := make(chan int)
+	go func() {
+		var ct int
+		for ct < 100 {
+			ct += len(queue.PopWhile(isStr, 10))
+		}
+		done <- ct
+	}()
+
+	for i := 0; i < 100; i++ {
+		queue.Push(`x`)
+	}
+
+	if ct := <-done; ct != 100 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 100, ct)
+	}
+}
+
+func TestStack_KindOf(t *testing.T) {
+	for idx, tst := range []struct {
+		Ctor func(...int) Stack
+		Kind StackKind
+		Want string
+	}{
+		{And, KindAnd, `AND`},
+		{Or, KindOr, `OR`},
+		{Not, KindNot, `NOT`},
+		{List, KindList, `LIST`},
+		{Basic, KindBasic, `BASIC`},
+	} {
+		if got := tst.Ctor().KindOf(); got != tst.Kind {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tst.Kind, got)
+			return
+		} else if got.String() != tst.Want {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, tst.Want, got)
+			return
+		}
+
+		want, got := tst.Ctor(2).Push(`a`, `b`), NewStack(tst.Kind, 2).Push(`a`, `b`)
+		if got.KindOf() != tst.Kind || got.Cap() != want.Cap() || got.String() != want.String() {
+			t.Errorf("%s[%d] failed: want '%s', got '%s'", t.Name(), idx, want, got)
+			return
+		}
+	}
+
+	var bogus Stack
+	if got := bogus.KindOf(); got != KindInvalid {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), KindInvalid, got)
+		return
+	} else if NewStack(KindInvalid).IsInit() || NewStack(StackKind(99)).IsInit() {
+		t.Errorf("%s failed: unexpected initialized stack for invalid kind", t.Name())
+		return
+	}
+
+	// symbols and folding do not influence KindOf
+	if got := And().SetSymbol('&').SetFold(true).KindOf(); got != KindAnd {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), KindAnd, got)
+	}
+}
+
+func TestStack_SetPushPolicyContext(t *testing.T) {
+	limits := map[string]int{`leaf`: 2, `branch`: 3}
+	policy := func(ctx PushContext, x any) (err error) {
+		S, _ := ctx.Receiver.(Stack)
+		if cat := S.Category(); cat == `leaf` && (ctx.IsStack || ctx.IsCondition) {
+			err = errorf("%T not permitted in %s stack", x, cat)
+		} else if ctx.Len >= limits[cat] {
+			err = errorf("%s stack limit reached at index %d", cat, ctx.Index)
+		}
+		return
+	}
+
+	leaf := List().SetCategory(`leaf`).SetPushPolicyContext(policy)
+	branch := List().SetCategory(`branch`).SetPushPolicyContext(policy)
+
+	if leaf.Push(Cond(`a`, Eq, `b`)); leaf.Len() != 0 || leaf.Err() == nil {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 0, leaf.Len())
+		return
+	}
+
+	if leaf.SetErr(nil).Push(`a`, `b`, `c`); leaf.Len() != 2 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 2, leaf.Len())
+		return
+	} else if want := `leaf stack limit reached at index 2`; leaf.Err().Error() != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, leaf.Err())
+		return
+	}
+
+	if branch.Push(leaf, List(), `x`, `y`); branch.Len() != 3 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 3, branch.Len())
+		return
+	}
+
+	// legacy PushPolicy takes precedence
+	branch.SetErr(nil).SetPushPolicy(func(_ ...any) error { return nil })
+	if branch.Push(`z`); branch.Len() != 4 || branch.Err() != nil {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 4, branch.Len())
+	}
+}
+
+func TestStack_SetOrderLock(t *testing.T) {
+	locked := List().SetFIFO(true).SetOrderLock(true).Push(`a`, `b`, `c`)
+	if locked.Reverse(); locked.String() != `a b c` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `a b c`, locked)
+		return
+	} else if !errors.Is(locked.Err(), ErrOrderLocked) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrOrderLocked, locked.Err())
+		return
+	} else if locked.SetErr(nil).SwapOK(0, 2) || locked.Err() == nil {
+		t.Errorf("%s failed: unexpected swap of order-locked stack", t.Name())
+		return
+	}
+
+	locked.Push(`d`)
+	if popped, _ := locked.Pop(); popped != `a` || locked.String() != `b c d` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `b c d`, locked)
+		return
+	}
+
+	// LIFO and unlocked FIFO stacks are unaffected
+	for _, r := range []Stack{
+		List().SetOrderLock(true).Push(`a`, `b`, `c`),
+		List().SetFIFO(true).Push(`a`, `b`, `c`),
+	} {
+		if r.Reverse(); r.String() != `c b a` || r.Err() != nil {
+			t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `c b a`, r)
+			return
+		}
+	}
+
+	// toggling releases the lock
+	if locked.SetOrderLock().Reverse(); locked.String() != `d c b` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `d c b`, locked)
+	}
+}
+
+func TestStack_EncapChars(t *testing.T) {
+	O := Or().Paren().Encap(`"`, []string{`<`, `>`}).Push(
+		`sub_element_number_0`,
+		`sub_element_number_1`,
+	)
+
+	pairs := O.EncapChars()
+	if want, got := `[[" "] [< >]]`, sprintf("%v", pairs); want != got {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	D := Or().Push(
+		`sub_element_number_0`,
+		`sub_element_number_1`,
+	)
+	if err := O.CopyPresentation(D); err != nil {
 		t.Errorf("%s failed: %v", t.Name(), err)
 		return
+	} else if O.String() != D.String() {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), O, D)
+		return
 	}
 
-	// verify starting length minus offset is equal
-	// to the result length, meaning <offset> slices
-	// were truncated.
-	offsetLen := beforeLen - offset
-	if afterLen := l.Len(); offsetLen != afterLen {
-		t.Errorf("%s failed: unexpected length; want '%d', got '%d'",
-			t.Name(), offsetLen, afterLen)
+	if err := O.CopyPresentation(Or().SetReadOnly(true)); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
 		return
 	}
 
+	c := Cond(`cn`, Eq, `jesse`).Encap([]string{`<`, `>`})
+	if pairs = c.EncapChars(); len(pairs) != 1 || pairs[0] != [2]string{`<`, `>`} {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), [][2]string{{`<`, `>`}}, pairs)
+	}
 }
 
-func TestStack_withCap(t *testing.T) {
-	src := Basic().Push(
-		`element0`,
-		`element1`,
-		`element2`,
-		`element3`,
-		`element4`,
-		`element5`,
-		`element6`,
-		`element7`,
-		`element8`,
-		`element9`,
-	)
-
-	dst := Basic(3).Push(
-		`element0a`,
-		`element0b`,
-		`element0c`,
-	)
-
-	src.Transfer(dst)
-
-	if dst.Len() != 3 {
-		t.Errorf("%s failed; transfer process bypassed capacity constraints; want len:%d, got len:%d",
-			t.Name(), 3, dst.Len())
+func TestStack_PushWait(t *testing.T) {
+	ctx := context.Background()
+	if err := List(10).PushWait(ctx, `a`); err == nil {
+		t.Errorf("%s failed: expected error for non-locking stack", t.Name())
+		return
+	} else if err = List().SetMutex().PushWait(ctx, `a`); err == nil {
+		t.Errorf("%s failed: expected error for unlimited stack", t.Name())
+		return
+	} else if _, err = List().PopWait(ctx); err == nil {
+		t.Errorf("%s failed: expected error for non-locking stack", t.Name())
 		return
 	}
 
-	dst.Insert(struct{}{}, 2)
-	if dst.Len() != 3 {
-		t.Errorf("%s failed; insert process bypassed capacity constraints; want len:%d, got len:%d",
-			t.Name(), 3, dst.Len())
+	// a canceled context unblocks a full stack
+	full := List(1).SetMutex().Push(`a`)
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := full.PushWait(cctx, `b`); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), context.Canceled, err)
+		return
+	} else if _, err = List().SetMutex().PopWait(cctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), context.Canceled, err)
 		return
 	}
-}
 
-func TestStack_codecov(t *testing.T) {
-	var s Stack
-	// panic checks
-	s.Len()
-	s.IsZero()
-	s.IsInit()
-	s.Valid()
-	s.Pop()
+	// one producer, two consumers
+	queue := List(10).SetFIFO(true).SetMutex()
+	results := make(chan int)
+	done, finish := context.WithCancel(ctx)
+	var consumed atomic.Int64
+	for i := 0; i < 2; i++ {
+		go func() {
+			var ct int
+			for {
+				if _, err := queue.PopWait(done); err != nil {
+					break
+				}
+				consumed.Add(1)
+				ct++
+			}
+			results <- ct
+		}()
+	}
 
-	SetDefaultStackLogger(nil)
-	_ = lonce.String()
+	for i := 0; i < 1000; i++ {
+		if err := queue.PushWait(ctx, i); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			break
+		}
+	}
 
-	var ll logLevels
-	ll.shift(`trace`)
-	ll.shift(nil)
-	ll.shift('a')
-	ll.shift()
-	ll.shift(0)
-	ll.shift(65535)
+	for consumed.Load() < 1000 {
+		time.Sleep(time.Millisecond)
+	}
+	finish()
 
-	ll.positive(`trace`)
-	ll.positive(nil)
-	ll.positive('a')
-	ll.positive(0)
-	ll.positive(65535)
+	if ct := <-results + <-results; ct != 1000 {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), 1000, ct)
+	}
+}
 
-	ll.unshift(`trace`)
-	ll.unshift(nil)
-	ll.unshift('a')
-	ll.unshift()
-	ll.unshift(0)
-	ll.unshift(65535)
+func TestStack_SetFoldKeywordComparison(t *testing.T) {
+	A := And().Push(Cond(`CN`, Eq, `x`), Or().Push(Cond(`sn`, Eq, Cond(`UID`, Eq, `y`))))
+	B := And().Push(Cond(`cn`, Eq, `x`), Or().Push(Cond(`SN`, Eq, Cond(`uid`, Eq, `y`))))
 
-	var lsys *logSystem = newLogSystem(sLogDefault)
-	if lsys.isZero() {
-		t.Errorf("%s failed: nil %T",
-			t.Name(), lsys.logger())
+	for _, pair := range [][]Stack{{A, B}, {B, A}} {
+		if err := pair[0].IsEqual(pair[1]); err == nil {
+			t.Errorf("%s failed: want mismatch, got nil", t.Name())
+			return
+		}
+
+		pair[0].SetFoldKeywordComparison(true)
+		if err := pair[0].IsEqual(pair[1]); err != nil {
+			t.Errorf("%s failed: %v", t.Name(), err)
+			return
+		}
 	}
 
-	s.CanMutex()
-	s.Avail()
-	s.string()
-	s.IsEncap()
-	s.SetAuxiliary(nil)
-	s.SetLogger(nil)
-	s.NoNesting()
-	s.NoNesting(true)
-	s.NoNesting(false)
-	s.Logger()
-	s.IsEqual(nil)
+	// value mismatches are still reported
+	inner, _ := B.Index(1)
+	if err := A.IsEqual(And().Push(Cond(`cn`, Eq, `X`), inner)); err == nil {
+		t.Errorf("%s failed: want mismatch, got nil", t.Name())
+	}
+}
 
-	s = List()
-	s.SetUnmarshaler()
-	s.SetMarshaler()
-	s.Marshal()
-	s.Unmarshal()
-	s.IsEqual(struct{}{})
-	s.IsEqual(s)
-	s.Pop()
-	s.Push(-1, -2, -3, -4, -5)
-	s.Front()
-	s.Back()
-	s.NegativeIndices(true)
+func TestStack_Checksum(t *testing.T) {
+	build := func(leaf string) Stack {
+		return And().Push(
+			Cond(`cn`, Eq, leaf),
+			Or().Push(`a`, 1, Cond(`sn`, Ne, List().Push(`x`, `y`))),
+			nil,
+		)
+	}
 
-	for i := 0; i < s.Len(); i++ {
-		offset := i - (i + 1)
+	A, _ := build(`jesse`).Checksum()
+	B, err := build(`jesse`).Checksum()
+	if err != nil || A != B {
+		t.Errorf("%s failed: want '%d', got '%d' (err:%v)", t.Name(), A, B, err)
+		return
+	}
 
-		slice, _ := s.Index(i)
-		want, _ := slice.(int)
+	if C, _ := build(`jessie`).Checksum(); C == A {
+		t.Errorf("%s failed: changed leaf produced identical checksum '%d'", t.Name(), C)
+		return
+	}
 
-		slice, _ = s.Index(offset - want)
-		got, _ := slice.(int)
+	// presentation-only settings have no bearing
+	D := build(`jesse`).Paren().SetSymbol(`&&`).SetPaddingStyle(PadAroundOperator)
+	D.SetEncap(`"`)
+	if sum, _ := D.Checksum(); sum != A {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), A, sum)
+		return
+	}
 
-		if want != got {
-			t.Errorf("%s failed [loop:%d]: want %d, got %d",
-				t.Name(), i, want, got)
-			return
-		}
+	// comments have no bearing, as with IsEqual
+	G := And().Push(
+		Comment(`leading`),
+		Cond(`cn`, Eq, `jesse`),
+		Or().Push(`a`, Comment(`nested`), 1, Cond(`sn`, Ne, List().Push(`x`, `y`))),
+		nil,
+	)
+	if sum, _ := G.Checksum(); sum != A || G.IsEqual(build(`jesse`)) != nil {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), A, sum)
+		return
 	}
 
-	s.config()
-	s.IsEncap()
-	s.ReadOnly()
-	s.SetReadOnly(true)
-	s.SetReadOnly(false)
-	s.IsReadOnly()
-	s.Avail()
-	s.traverse()
-	s.string()
-	s.Paren()
-	s.Paren(true)
-	s.Paren(false)
-	s.NoNesting()
-	s.NoNesting(true)
-	s.CanNest()
-	s.NoNesting(false)
-	s.CanMutex()
+	// order is significant unless the stack is order-insensitive
+	E, _ := List().Push(`a`, `b`).Checksum()
+	F, _ := List().Push(`b`, `a`).Checksum()
+	if E == F {
+		t.Errorf("%s failed: reordered stack produced identical checksum '%d'", t.Name(), E)
+		return
+	}
 
-	s.SetReadOnly()
-	s.SetReadOnly(true)
-	s.Free()
-	s.SetReadOnly(false)
+	E, _ = List().SetEqualityOrderInsensitive(true).Push(`a`, `b`).Checksum()
+	F, _ = List().SetEqualityOrderInsensitive(true).Push(`b`, `a`).Checksum()
+	if E != F {
+		t.Errorf("%s failed: want '%d', got '%d'", t.Name(), E, F)
+		return
+	}
 
-	s.Push(customStruct{`keyword`, `vaLUE`})
-	_ = s.String()
+	// kind is significant
+	if G, _ := Or().Push(`a`, `b`).Checksum(); G == E {
+		t.Errorf("%s failed: differing kinds produced identical checksum '%d'", t.Name(), G)
+		return
+	}
 
-	s.SetAuxiliary(nil)
-	s.SetLogger(nil)
-	s.Logger()
+	want := `slice [1][1]: unhashable type chan int`
+	if _, err = List().Push(`a`, And().Push(`b`, make(chan int))).Checksum(); err == nil || err.Error() != want {
+		t.Errorf("%s failed: want '%s', got '%v'", t.Name(), want, err)
+		return
+	}
 
-	SetDefaultStackLogLevel(`none`)
-	SetDefaultStackLogLevel(0)
-	SetDefaultStackLogLevel(nil)
-	SetDefaultStackLogLevel('a')
-	s.SetLogger(`stderr`)
-	s.SetLogger(2)
-	s.SetLogger(`stdout`)
-	s.SetLogger(1)
-	s.SetLogger(sLogDefault)
+	var zero Stack
+	if _, err = zero.Checksum(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
 
-	s.Less(3, 6)
-	s.Less(6, 3)
-	s.Less(1, 2)
-	s.Swap(101, 48)
-	s.Swap(-1, 480)
-	s.Push(
-		customStack(List().Push(1, `&`)),
-		customStack(List().Push(`_n`, `?!?`, 5)),
-		``)
-	sort.Stable(s)
-	s.Less(0, 1)
-	s.Less(0, 2)
-	s.SetLessFunc(nil)
-	s.SetLessFunc()
+func TestStack_SetInheritPresentation(t *testing.T) {
+	child := List().Push(`b`, `c`)
+	explicit := List().SetDelimiter(` | `).Push(`e`, `f`)
+	parent := List().SetDelimiter(`, `).SetNoPadding(true).SetEncap(`'`).
+		Push(`a`, child, `d`, explicit)
 
-	s.SetLogLevel(8, 16)
-	s.UnsetLogLevel(8, 16)
+	want := `'a', b c, 'd', e | f`
+	if got := parent.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
 
-	s.SetLogLevel(LogLevel4, LogLevel5)
-	s.LogLevels()
-	s.UnsetLogLevel(LogLevel4, LogLevel5)
-	s.Logger()
+	parent.SetInheritPresentation(true)
+	if !parent.IsInheritingPresentation() {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), true, false)
+		return
+	}
 
-	s.SetErr(errorf(`this is a serious error`))         // txt err
-	s.SetErr(errorf(errorf(`this is a serious error`))) // err err
-	s.Err()
-	s.SetErr(nil)
+	// an explicit delimiter wins, while unset encapsulation
+	// and padding settings are still inherited
+	want = `'a', 'b', 'c', 'd', 'e' | 'f'`
+	if got := parent.String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
 
-	k := List(8).Push(`1`, `2`, `3`, `4`).SetFIFO(true)
-	k.Front()
-	k.Back()
+	// the child's stored configuration is untouched
+	if got := child.Delimiter(); got != `` {
+		t.Errorf("%s failed: want '', got '%s'", t.Name(), got)
+		return
+	} else if got = child.String(); got != `b c` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `b c`, got)
+		return
+	}
 
-	l := List().Push(`1`, `2`, `3`, `4`)
-	l.IsEqual(k)
-	l.SetEqualityPolicy(func(x, y any) error {
-		return nil
-	})
-	l.IsEqual(k)
+	parent.SetInheritPresentation()
+	if parent.IsInheritingPresentation() {
+		t.Errorf("%s failed: want '%t', got '%t'", t.Name(), false, true)
+	}
+}
 
-	m := And().Push(`1`, `2`, `3`, `4`)
-	m.SetEqualityPolicy()
-	m.IsEqual(l)
-	m.Marshal()
+func TestStack_SetMaxStringLength(t *testing.T) {
+	L := List()
+	for i := 0; i < 10000; i++ {
+		L.Push(sprintf("value%d", i))
+	}
+	full := L.String()
 
-	var ak Stack
-	ak.Marshal([]any{`CONDITION`, `Keyword`, Eq, `value`})
+	if got := L.SetMaxStringLength(200).String(); !strings.HasSuffix(got, `…`) {
+		t.Errorf("%s failed: want marker suffix, got '%s'", t.Name(), got)
+		return
+	} else if body := strings.TrimSuffix(got, `…`); len(body) > 200 || !strings.HasPrefix(full, body) {
+		t.Errorf("%s failed: want prefix of at most 200 bytes, got %d bytes", t.Name(), len(body))
+		return
+	}
 
-	ak = List()
-	ak.Marshal([]any{`CONDITION`, `Keyword`, Eq, `value`})
-	ak.Marshal([]any{`other`, `stack`})
-	ak.Marshal([]any{`CONDITION`, `Keyword`, Eq}) // missing value
-	ak.Marshal()
-	ak.Marshal([]any{5, `bogus`})
-	marshalDefault([]any{})
+	if got := L.SetTruncationMarker(` [...]`).String(); !strings.HasSuffix(got, ` [...]`) {
+		t.Errorf("%s failed: want custom marker suffix, got '%s'", t.Name(), got)
+		return
+	}
 
-	ak = stackByWord(`NOT`)
-	ak = stackByWord(`blarg`)
+	A := And().Paren().Push(`a`, Or().Paren().Push(`b`, Not().Paren().Push(`c`)), `d`)
+	want := A.String()
+	for n := 1; n < len(want); n++ {
+		got := A.SetMaxStringLength(n).String()
+		if strings.Count(got, `(`) != strings.Count(got, `)`) || !strings.Contains(got, `…`) {
+			t.Errorf("%s failed: want balanced and truncated output, got '%s'", t.Name(), got)
+			return
+		}
+	}
 
-	s.SetLogger(`off`)
-	s.SetLogger(0)
-}
+	if got := A.SetMaxStringLength(len(want)).String(); got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
 
-func init() {
-	// just used for testing, etc.
-	//SetDefaultStackLogger(`stdout`)
-	//SetDefaultConditionLogger(`stdout`)
+	// unlimited
+	if L.SetMaxStringLength(0); L.MaxStringLength() != 0 || L.String() != full {
+		t.Errorf("%s failed: want unchanged output for unlimited stack", t.Name())
+	}
 }
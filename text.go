@@ -0,0 +1,143 @@
+package stackage
+
+/*
+text.go contains the encoding.TextMarshaler and TextUnmarshaler
+implementations extended by the Stack and Condition types.
+*/
+
+import "unicode"
+
+/*
+MarshalText implements the [encoding.TextMarshaler] interface, returning
+the string representation of the receiver, as with [Stack.String]. This
+allows the receiver to be used directly within text-based encodings and
+templates.
+
+An error is returned if the receiver is invalid (see [Stack.Valid]), or
+if it is a [Basic] [Stack], which bears no string representation.
+*/
+func (r Stack) MarshalText() (text []byte, err error) {
+	if err = r.Valid(); err == nil {
+		if r.stack.stackType() == basic {
+			err = errorf("%s %T has no text representation", basic, r)
+		} else {
+			text = []byte(r.String())
+		}
+	}
+
+	return
+}
+
+/*
+UnmarshalText implements the [encoding.TextUnmarshaler] interface. Text
+beginning with an opening parenthesis is parsed using [ParseFilterLike],
+in which case the receiver is replaced with the result.
+
+All other text is parsed as a simple list, and each value delimited as
+described by [Stack.SetDelimiter] becomes a string slice, with leading
+and trailing whitespace removed. If the receiver is an initialized [List]
+[Stack], its configuration is retained and its delimiter is used, while
+its slices are replaced; otherwise, the receiver is replaced with a new
+[List] [Stack]. Whitespace delimits the values of lists lacking a delimiter.
+
+For example, the text "a, b, c" produces a [List] bearing three (3) slices
+when received by a [List] whose delimiter is a comma.
+*/
+func (r *Stack) UnmarshalText(text []byte) (err error) {
+	s := trimS(string(text))
+	if hasPfx(s, `(`) {
+		var S Stack
+		if S, err = ParseFilterLike(s); err == nil {
+			*r = S
+		}
+		return
+	}
+
+	S := *r
+	if !S.IsInit() || S.stack.stackType() != list {
+		S = List()
+	} else if S.IsReadOnly() {
+		err = wrapErr(ErrReadOnly, "%T is read-only; cannot unmarshal", S)
+		return
+	}
+
+	var vals []any
+	if delim := trimS(S.Delimiter()); len(delim) == 0 {
+		for _, val := range fields(s) {
+			vals = append(vals, val)
+		}
+	} else if len(s) > 0 {
+		for _, val := range split(s, delim) {
+			vals = append(vals, trimS(val))
+		}
+	}
+
+	S.Reset()
+	if S.Push(vals...); S.Len() != len(vals) {
+		err = errorf("%d of %d values pushed: %v", S.Len(), len(vals), S.Err())
+		return
+	}
+
+	*r = S
+	return
+}
+
+/*
+MarshalText implements the [encoding.TextMarshaler] interface, returning
+the string representation of the receiver, as with [Condition.String].
+An error is returned if the receiver is invalid (see [Condition.Valid]).
+*/
+func (r Condition) MarshalText() (text []byte, err error) {
+	if err = r.Valid(); err == nil {
+		text = []byte(r.String())
+	}
+
+	return
+}
+
+/*
+UnmarshalText implements the [encoding.TextUnmarshaler] interface. The
+receiver is replaced with the [Condition] described by text, which must
+take the form of a keyword, a [ComparisonOperator] and a value, such as:
+
+	cn = Jesse
+
+The leftmost (and longest) [ComparisonOperator] found within the text is
+used, thus custom strings set via [SetComparisonOperatorStrings] are
+honored. The keyword and the value, which is stored as a string, are
+taken verbatim apart from leading and trailing whitespace.
+
+Text enclosed within parentheses results in a parenthetical [Condition],
+while text lacking whitespace about the operator results in an unpadded
+[Condition], thereby preserving the string representation produced by
+[Condition.MarshalText].
+*/
+func (r *Condition) UnmarshalText(text []byte) (err error) {
+	s := trimS(string(text))
+
+	var paren bool
+	if L := len(s); L > 1 && s[0] == '(' && s[L-1] == ')' {
+		paren = true
+		s = s[1 : L-1]
+	}
+
+	i, op := findOperator(s, comparisonOperators())
+	if op == nil {
+		err = errorf("no recognized operator in '%s'", s)
+		return
+	}
+
+	kw, val := s[:i], s[i+len(op.String()):]
+	padded := len(kw) > 0 && unicode.IsSpace(rune(kw[len(kw)-1]))
+	if kw, val = trimS(kw), trimS(val); len(kw) == 0 {
+		err = errorf("missing keyword in '%s'", s)
+	} else if len(val) == 0 {
+		err = errorf("missing value in '%s'", s)
+	} else {
+		*r = Cond(kw, op, val).
+			SetParen(paren).
+			SetNoPadding(!padded)
+	}
+
+	return
+}
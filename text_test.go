@@ -0,0 +1,111 @@
+package stackage
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = Stack{}
+	_ encoding.TextUnmarshaler = &Stack{}
+	_ encoding.TextMarshaler   = Condition{}
+	_ encoding.TextUnmarshaler = &Condition{}
+)
+
+func TestCondition_MarshalText(t *testing.T) {
+	for idx, orig := range []Condition{
+		Cond(`cn`, Eq, `Jesse`),
+		Cond(`age`, Ge, `21`).SetNoPadding(true),
+		Cond(`sn`, Ne, `Coretta`).SetParen(true),
+		Cond(`uid`, Le, `x=y`).SetParen(true).SetNoPadding(true),
+	} {
+		text, err := orig.MarshalText()
+		if err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		}
+
+		var C Condition
+		if err = C.UnmarshalText(text); err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		} else if got := C.String(); got != string(text) {
+			t.Errorf("%s failed [idx:%d]: want '%s', got '%s'", t.Name(), idx, text, got)
+			return
+		} else if err = C.IsEqual(orig); err != nil {
+			t.Errorf("%s failed [idx:%d]: %v", t.Name(), idx, err)
+			return
+		}
+	}
+
+	var C Condition
+	for idx, text := range []string{
+		`cn Jesse`,
+		` = Jesse`,
+		`cn = `,
+	} {
+		if err := C.UnmarshalText([]byte(text)); err == nil {
+			t.Errorf("%s failed [idx:%d]: expected error, got nil", t.Name(), idx)
+			return
+		}
+	}
+
+	if _, err := C.MarshalText(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+	}
+}
+
+func TestStack_MarshalText(t *testing.T) {
+	orig := List().SetDelimiter(`,`).Push(`a`, `b`, `c`)
+	text, err := orig.MarshalText()
+	if err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	S := List().SetDelimiter(`,`)
+	if err = S.UnmarshalText(text); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	} else if got := S.String(); got != string(text) || S.Len() != 3 {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), text, got)
+		return
+	} else if err = S.IsEqual(orig); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	// lists lacking a delimiter split upon whitespace
+	var L Stack
+	if err = L.UnmarshalText([]byte(` a  b c `)); err != nil || L.String() != `a b c` {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), `a b c`, L, err)
+		return
+	}
+
+	// filter-like text
+	filter := `(&(objectClass=employee)(|(cn=a)(cn=b)))`
+	if err = L.UnmarshalText([]byte(filter)); err != nil || L.String() != filter {
+		t.Errorf("%s failed: want '%s', got '%s' (%v)", t.Name(), filter, L, err)
+		return
+	} else if _, err = L.MarshalText(); err != nil {
+		t.Errorf("%s failed: %v", t.Name(), err)
+		return
+	}
+
+	if _, err = Basic().Push(`a`).MarshalText(); err == nil {
+		t.Errorf("%s failed: expected error for basic stack, got nil", t.Name())
+		return
+	}
+
+	var z Stack
+	if _, err = z.MarshalText(); !errors.Is(err, ErrNotInitialized) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrNotInitialized, err)
+		return
+	}
+
+	ro := List().SetReadOnly(true)
+	if err = ro.UnmarshalText([]byte(`a b`)); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("%s failed: want '%v', got '%v'", t.Name(), ErrReadOnly, err)
+	}
+}
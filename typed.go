@@ -0,0 +1,125 @@
+package stackage
+
+/*
+typed.go contains the TypedStack generic adapter type.
+*/
+
+import "reflect"
+
+/*
+TypedStack is a thin generic adapter of [Stack], through which values of
+type T are pushed and retrieved without the need for type assertions.
+
+No storage is maintained apart from the embedded [Stack], thus all of its
+presentation, policy and locking capabilities remain available. The type
+shadows the [Stack.Push], [Stack.Pop], [Stack.Index] and [Stack.Front]
+methods with variants bearing T in place of any.
+
+Instances should be created using the [NewTypedList] function, which
+ensures the embedded [Stack] remains homogeneous even when accessed
+directly.
+*/
+type TypedStack[T any] struct {
+	Stack
+}
+
+/*
+NewTypedList initializes and returns a new instance of [TypedStack] which
+embeds a [List] [Stack]. The optional capacity is handled in the manner
+described by the [List] function.
+
+A [PushPolicy] is installed which rejects any value that is not of type T,
+including those pushed by way of the embedded [Stack] directly. Replacing
+said policy via [Stack.SetPushPolicy] removes this protection.
+*/
+func NewTypedList[T any](capacity ...int) TypedStack[T] {
+	return TypedStack[T]{List(capacity...).SetPushPolicy(typedPushPolicy[T])}
+}
+
+/*
+typedPushPolicy is the [PushPolicy] installed by [NewTypedList]. Any value
+that is not of type T is rejected.
+*/
+func typedPushPolicy[T any](x ...any) (err error) {
+	for i := 0; i < len(x) && err == nil; i++ {
+		if _, ok := x[i].(T); !ok {
+			err = errorf("TypedStack[%s]: unexpected %T value",
+				reflect.TypeOf((*T)(nil)).Elem(), x[i])
+		}
+	}
+	return
+}
+
+/*
+Push appends one (1) or more values of type T to the receiver in the
+manner described by the [Stack.Push] method.
+*/
+func (r TypedStack[T]) Push(x ...T) TypedStack[T] {
+	y := make([]any, len(x))
+	for i := 0; i < len(x); i++ {
+		y[i] = x[i]
+	}
+
+	r.Stack.Push(y...)
+	return r
+}
+
+/*
+Pop removes and returns the next slice from the receiver in the manner
+described by the [Stack.Pop] method. A Boolean value of false is returned
+if nothing was removed, or if the removed slice is not of type T.
+*/
+func (r TypedStack[T]) Pop() (T, bool) {
+	return typedSlice[T](r.Stack.Pop())
+}
+
+/*
+Index returns the slice found at the specified index in the manner
+described by the [Stack.Index] method. A Boolean value of false is
+returned if nothing was found, or if the slice is not of type T.
+*/
+func (r TypedStack[T]) Index(idx int) (T, bool) {
+	return typedSlice[T](r.Stack.Index(idx))
+}
+
+/*
+Front returns the "front" slice of the receiver in the manner described
+by the [Stack.Front] method. A Boolean value of false is returned if the
+receiver is empty, or if the slice is not of type T.
+*/
+func (r TypedStack[T]) Front() (T, bool) {
+	return typedSlice[T](r.Stack.Front())
+}
+
+/*
+SetLess assigns the provided closure to the receiver by way of the
+[Stack.SetLessFunc] method, thereby allowing the receiver to be sorted
+(e.g.: using [sort.Sort]) by comparing the values of type T directly.
+
+A nil function restores the package-default sorting mechanism.
+*/
+func (r TypedStack[T]) SetLess(less func(a, b T) bool) TypedStack[T] {
+	if less == nil {
+		r.Stack.SetLessFunc(nil)
+		return r
+	}
+
+	r.Stack.SetLessFunc(func(i, j int) bool {
+		a, _ := r.Index(i)
+		b, _ := r.Index(j)
+		return less(a, b)
+	})
+
+	return r
+}
+
+/*
+typedSlice asserts x as T, returning the result alongside a Boolean value
+indicative of both the success of the assertion and the input ok value.
+*/
+func typedSlice[T any](x any, ok bool) (t T, tok bool) {
+	if ok {
+		t, tok = x.(T)
+	}
+	return
+}
@@ -0,0 +1,63 @@
+package stackage
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestTypedStack_string(t *testing.T) {
+	ts := NewTypedList[string]().Push(`charlie`, `alpha`, `bravo`)
+	if got, want := ts.String(), `charlie alpha bravo`; got != want {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), want, got)
+		return
+	}
+
+	// a heterogeneous push through the embedded
+	// Stack is rejected by the push policy.
+	ts.Stack.Push(3)
+	if ts.Len() != 3 || ts.Err() == nil {
+		t.Errorf("%s failed: heterogeneous push not rejected", t.Name())
+		return
+	}
+
+	sort.Sort(ts.SetLess(func(a, b string) bool { return a < b }))
+	if got, _ := ts.Index(0); got != `alpha` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `alpha`, got)
+		return
+	}
+
+	if got, _ := ts.Front(); got != `charlie` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `charlie`, got)
+		return
+	}
+
+	if got, ok := ts.Pop(); !ok || got != `charlie` || ts.Len() != 2 {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `charlie`, got)
+	}
+}
+
+func TestTypedStack_Condition(t *testing.T) {
+	ts := NewTypedList[Condition]().Push(Cond(`a`, Eq, `b`))
+	if c, ok := ts.Index(0); !ok || c.Keyword() != `a` {
+		t.Errorf("%s failed: want '%s', got '%s'", t.Name(), `a`, c.Keyword())
+		return
+	}
+
+	// aliases are not of type Condition
+	type customCondition Condition
+	ts.Stack.Push(customCondition(Cond(`c`, Eq, `d`)))
+	if ts.Len() != 1 {
+		t.Errorf("%s failed: alias push not rejected", t.Name())
+		return
+	}
+
+	if _, ok := ts.Index(1); ok {
+		t.Errorf("%s failed: unexpected index success", t.Name())
+		return
+	}
+
+	var zero TypedStack[Condition]
+	if _, ok := zero.Pop(); ok {
+		t.Errorf("%s failed: unexpected pop from zero instance", t.Name())
+	}
+}